@@ -0,0 +1,331 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// ReadModeSingle sends each read to the selected node only
+	ReadModeSingle = "Single"
+	// ReadModeHedged sends each read to the selected node, and to a second node if the first has not answered within
+	// the P95 latency of previous reads
+	ReadModeHedged = "Hedged"
+	// ReadModeQuorum sends each read to every alive node, and requires a quorum of identical responses
+	ReadModeQuorum = "Quorum"
+)
+
+const (
+	// hedgeLatencyWindow is the number of most recent read latencies the hedge delay is computed from
+	hedgeLatencyWindow = 100
+	// hedgeMinSamples is the number of reads needed before the hedge delay is based on their latency
+	hedgeMinSamples = 20
+	// defaultHedgeDelay is used until enough reads have been observed
+	defaultHedgeDelay = time.Second
+)
+
+var (
+	promMultiNodeHedgedReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads",
+		Help: "The number of reads which were sent to a second node after the first did not answer in time",
+	}, []string{"network", "chainId", "method"})
+	promMultiNodeReadDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_read_disagreements",
+		Help: "The number of quorum reads for which the given RPC node returned a response different from the quorum",
+	}, []string{"network", "chainId", "method", "nodeName"})
+)
+
+// CriticalReader performs reads which feed on-chain decisions, protecting them from a single lagging or lying RPC
+// according to the configured ReadMode.
+type CriticalReader[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+] struct {
+	lggr      logger.SugaredLogger
+	multiNode *MultiNode[CHAIN_ID, RPC]
+	method    string
+	mode      string
+	quorum    int
+	equal     func(a, b RESULT) bool
+
+	latenciesMu sync.Mutex // protects latencies and nextLatency
+	latencies   []time.Duration
+	nextLatency int
+}
+
+func NewCriticalReader[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+](
+	lggr logger.Logger,
+	multiNode *MultiNode[CHAIN_ID, RPC],
+	method string, // name of the read, used in logs and metrics
+	mode string,
+	quorum uint32, // number of identical responses required in ReadModeQuorum
+	equal func(a, b RESULT) bool,
+) *CriticalReader[CHAIN_ID, RPC, RESULT] {
+	switch mode {
+	case ReadModeSingle, ReadModeHedged, ReadModeQuorum:
+	default:
+		panic(fmt.Sprintf("unsupported ReadMode: %s", mode))
+	}
+	return &CriticalReader[CHAIN_ID, RPC, RESULT]{
+		lggr:      logger.Sugared(lggr).Named("CriticalReader").With("chainID", multiNode.chainID.String(), "method", method, "readMode", mode),
+		multiNode: multiNode,
+		method:    method,
+		mode:      mode,
+		quorum:    max(int(quorum), 1),
+		equal:     equal,
+	}
+}
+
+// Read calls read on one or more nodes at blockNumber, depending on the ReadMode. A nil blockNumber reads the latest
+// block, which ReadModeQuorum pins to the lowest head of the nodes, so that nodes a few blocks apart still agree.
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) Read(ctx context.Context, blockNumber *big.Int, read func(ctx context.Context, rpc RPC, blockNumber *big.Int) (RESULT, error)) (result RESULT, err error) {
+	switch r.mode {
+	case ReadModeHedged:
+		return r.hedgedRead(ctx, blockNumber, read)
+	case ReadModeQuorum:
+		return r.quorumRead(ctx, blockNumber, read)
+	default:
+		rpc, err := r.multiNode.SelectRPC()
+		if err != nil {
+			return result, err
+		}
+		return read(ctx, rpc, blockNumber)
+	}
+}
+
+type readResult[CHAIN_ID types.ID, RPC any, RESULT any] struct {
+	node   Node[CHAIN_ID, RPC]
+	result RESULT
+	err    error
+	errKey string // identifies err, if it is an error response of the RPC
+}
+
+// hedgedRead sends the read to the selected node, and to a second node if the first fails, or has not answered within
+// the P95 latency of previous reads. The first successful response wins.
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) hedgedRead(ctx context.Context, blockNumber *big.Int, read func(ctx context.Context, rpc RPC, blockNumber *big.Int) (RESULT, error)) (result RESULT, err error) {
+	nodes, err := r.multiNode.aliveNodes()
+	if err != nil {
+		return result, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abort the slower call
+
+	// buffered, so that the slower call does not block once we returned
+	results := make(chan readResult[CHAIN_ID, RPC, RESULT], 2)
+	send := func(n Node[CHAIN_ID, RPC]) {
+		go func() {
+			start := time.Now()
+			res, err := read(ctx, n.RPC(), blockNumber)
+			if err == nil {
+				r.recordLatency(time.Since(start))
+			}
+			results <- readResult[CHAIN_ID, RPC, RESULT]{node: n, result: res, err: err}
+		}()
+	}
+
+	send(nodes[0])
+	inFlight, hedged := 1, len(nodes) < 2
+	hedge := time.NewTimer(r.hedgeDelay())
+	defer hedge.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-hedge.C:
+			if !hedged {
+				hedged = true
+				inFlight++
+				promMultiNodeHedgedReads.WithLabelValues(r.multiNode.chainFamily, r.multiNode.chainID.String(), r.method).Inc()
+				r.lggr.Debugw("Hedging read to a second node", "node", nodes[1].String())
+				send(nodes[1])
+			}
+		case res := <-results:
+			inFlight--
+			if res.err == nil {
+				return res.result, nil
+			}
+			r.lggr.Debugw("Read failed", "node", res.node.String(), "err", res.err)
+			err = res.err
+			if !hedged {
+				hedged = true
+				inFlight++
+				send(nodes[1])
+			}
+			if inFlight == 0 {
+				return result, err
+			}
+		}
+	}
+}
+
+// quorumRead sends the read to every alive node, and returns the response at least quorum of them agree on as soon as
+// it is reached, aborting the slower reads. Identical error responses, e.g. the same revert of a call, count as votes
+// too, so that a deterministic error is returned as such instead of failing the quorum. Transport errors never do.
+// Nodes whose response differs from the quorum are reported.
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) quorumRead(ctx context.Context, blockNumber *big.Int, read func(ctx context.Context, rpc RPC, blockNumber *big.Int) (RESULT, error)) (result RESULT, err error) {
+	nodes, err := r.multiNode.aliveNodes()
+	if err != nil {
+		return result, err
+	}
+	if len(nodes) < r.quorum {
+		return result, fmt.Errorf("quorum read requires %d alive nodes, only %d available", r.quorum, len(nodes))
+	}
+	if blockNumber == nil {
+		blockNumber = lowestHead(nodes)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abort the slower reads
+
+	// buffered, so that the slower reads do not block once we returned
+	results := make(chan readResult[CHAIN_ID, RPC, RESULT], len(nodes))
+	for _, n := range nodes {
+		go func() {
+			res, err := read(ctx, n.RPC(), blockNumber)
+			results <- readResult[CHAIN_ID, RPC, RESULT]{node: n, result: res, err: err}
+		}()
+	}
+
+	// group the responses by value, in the order they were received
+	var groups [][]readResult[CHAIN_ID, RPC, RESULT]
+	var errs []error
+	best := -1
+	for received := 1; received <= len(nodes); received++ {
+		var res readResult[CHAIN_ID, RPC, RESULT]
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case res = <-results:
+		}
+		var isResponse bool
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", res.node.Name(), res.err))
+			res.errKey, isResponse = responseErrorKey(res.err)
+		}
+		if res.err == nil || isResponse {
+			i := slices.IndexFunc(groups, func(g []readResult[CHAIN_ID, RPC, RESULT]) bool {
+				return r.sameResponse(g[0], res)
+			})
+			if i < 0 {
+				groups = append(groups, []readResult[CHAIN_ID, RPC, RESULT]{res})
+				i = len(groups) - 1
+			} else {
+				groups[i] = append(groups[i], res)
+			}
+			if best < 0 || len(groups[i]) > len(groups[best]) {
+				best = i
+			}
+		}
+		if best >= 0 && len(groups[best]) >= r.quorum {
+			r.reportDisagreements(groups, best)
+			return groups[best][0].result, groups[best][0].err
+		}
+		// stop waiting once the pending reads can't complete a quorum
+		bestSize := 0
+		if best >= 0 {
+			bestSize = len(groups[best])
+		}
+		if bestSize+len(nodes)-received < r.quorum {
+			break
+		}
+	}
+
+	if len(groups) > 1 {
+		responses := 0
+		for _, g := range groups {
+			responses += len(g)
+		}
+		r.lggr.Errorw("RPC nodes disagree, and no quorum was reached", "quorum", r.quorum, "responses", responses, "distinctResponses", len(groups), "blockNumber", blockNumber)
+	}
+	return result, fmt.Errorf("quorum of %d identical responses not reached from %d nodes: %w", r.quorum, len(nodes), errors.Join(errs...))
+}
+
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) sameResponse(a, b readResult[CHAIN_ID, RPC, RESULT]) bool {
+	if a.err != nil || b.err != nil {
+		return a.err != nil && b.err != nil && a.errKey == b.errKey
+	}
+	return r.equal(a.result, b.result)
+}
+
+// responseErrorKey returns a key identifying err if it is an error response of the RPC, which nodes in sync return
+// deterministically, or false if it is a transport error.
+func responseErrorKey(err error) (string, bool) {
+	var rpcErr jsonRPCError
+	if !errors.As(err, &rpcErr) {
+		return "", false
+	}
+	key := fmt.Sprintf("%d: %v", rpcErr.ErrorCode(), rpcErr)
+	var dataErr interface{ ErrorData() interface{} }
+	if errors.As(err, &dataErr) {
+		key += fmt.Sprintf(": %v", dataErr.ErrorData())
+	}
+	return key, true
+}
+
+// reportDisagreements reports the nodes whose response differs from the one of groups[best].
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) reportDisagreements(groups [][]readResult[CHAIN_ID, RPC, RESULT], best int) {
+	for i, g := range groups {
+		if i == best {
+			continue
+		}
+		for _, res := range g {
+			promMultiNodeReadDisagreements.WithLabelValues(r.multiNode.chainFamily, r.multiNode.chainID.String(), r.method, res.node.Name()).Inc()
+			r.lggr.Errorw("RPC node returned a response which disagrees with the quorum", "node", res.node.String(), "quorum", r.quorum, "agreeingNodes", len(groups[best]))
+		}
+	}
+}
+
+// lowestHead returns the lowest latest block number observed among nodes, or nil if none was observed yet.
+func lowestHead[CHAIN_ID types.ID, RPC any](nodes []Node[CHAIN_ID, RPC]) *big.Int {
+	var lowest int64
+	for _, n := range nodes {
+		_, chainInfo := n.StateAndLatest()
+		if chainInfo.BlockNumber > 0 && (lowest == 0 || chainInfo.BlockNumber < lowest) {
+			lowest = chainInfo.BlockNumber
+		}
+	}
+	if lowest == 0 {
+		return nil
+	}
+	return big.NewInt(lowest)
+}
+
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) recordLatency(d time.Duration) {
+	r.latenciesMu.Lock()
+	defer r.latenciesMu.Unlock()
+	if len(r.latencies) < hedgeLatencyWindow {
+		r.latencies = append(r.latencies, d)
+		return
+	}
+	r.latencies[r.nextLatency] = d
+	r.nextLatency = (r.nextLatency + 1) % hedgeLatencyWindow
+}
+
+// hedgeDelay returns the P95 latency of the most recent successful reads.
+func (r *CriticalReader[CHAIN_ID, RPC, RESULT]) hedgeDelay() time.Duration {
+	r.latenciesMu.Lock()
+	if len(r.latencies) < hedgeMinSamples {
+		r.latenciesMu.Unlock()
+		return defaultHedgeDelay
+	}
+	sorted := slices.Clone(r.latencies)
+	r.latenciesMu.Unlock()
+	slices.Sort(sorted)
+	return sorted[(len(sorted)*95+99)/100-1]
+}
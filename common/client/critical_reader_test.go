@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// readRPC answers reads with a fixed result after a delay. If results is set, the result is the one at the block read.
+type readRPC struct {
+	result  int
+	results map[int64]int
+	err     error
+	delay   time.Duration
+	head    int64
+}
+
+func (r *readRPC) read(ctx context.Context, blockNumber *big.Int) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(r.delay):
+		if r.results != nil {
+			if blockNumber == nil {
+				return r.results[r.head], r.err
+			}
+			return r.results[blockNumber.Int64()], r.err
+		}
+		return r.result, r.err
+	}
+}
+
+// revertError is a reverted call, as returned in a JSON-RPC response.
+type revertError struct {
+	data string
+}
+
+func (revertError) Error() string            { return "execution reverted" }
+func (revertError) ErrorCode() int           { return 3 }
+func (e revertError) ErrorData() interface{} { return e.data }
+
+func newTestCriticalReader(t *testing.T, mode string, quorum uint32, rpcs ...*readRPC) *CriticalReader[types.ID, *readRPC, int] {
+	var nodes []Node[types.ID, *readRPC]
+	for i, rpc := range rpcs {
+		node := newMockNode[types.ID, *readRPC](t)
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("Order").Return(int32(i)).Maybe()
+		node.On("String").Return("node").Maybe()
+		node.On("Name").Return("node").Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("StateAndLatest").Return(nodeStateAlive, ChainInfo{BlockNumber: rpc.head}).Maybe()
+		nodes = append(nodes, node)
+	}
+	mn := NewMultiNode[types.ID, *readRPC](logger.Test(t), NodeSelectionModePriorityLevel, 0, nodes, nil, types.RandomID(), "test", 0)
+	return NewCriticalReader[types.ID, *readRPC, int](logger.Test(t), mn, "test_read", mode, quorum, func(a, b int) bool { return a == b })
+}
+
+func readFn(ctx context.Context, rpc *readRPC, blockNumber *big.Int) (int, error) {
+	return rpc.read(ctx, blockNumber)
+}
+
+func TestCriticalReader_Single(t *testing.T) {
+	t.Parallel()
+
+	r := newTestCriticalReader(t, ReadModeSingle, 1, &readRPC{result: 1}, &readRPC{result: 2})
+	result, err := r.Read(tests.Context(t), nil, readFn)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	t.Run("panics on unknown mode", func(t *testing.T) {
+		assert.Panics(t, func() {
+			newTestCriticalReader(t, "unknown", 1)
+		})
+	})
+}
+
+func TestCriticalReader_Hedged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the first node's response when it is fast", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeHedged, 1, &readRPC{result: 1}, &readRPC{result: 2})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("hedges to a second node when the first is slow", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeHedged, 1, &readRPC{result: 1, delay: time.Minute}, &readRPC{result: 2})
+		for i := 0; i < hedgeMinSamples; i++ {
+			r.recordLatency(time.Millisecond)
+		}
+		assert.Equal(t, time.Millisecond, r.hedgeDelay())
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("tries a second node when the first fails", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeHedged, 1, &readRPC{err: errors.New("boom")}, &readRPC{result: 2})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("returns an error when all nodes fail", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeHedged, 1, &readRPC{err: errors.New("boom")}, &readRPC{err: errors.New("bang")})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.Error(t, err)
+	})
+}
+
+func TestCriticalReader_hedgeDelay(t *testing.T) {
+	t.Parallel()
+
+	r := newTestCriticalReader(t, ReadModeHedged, 1)
+	assert.Equal(t, defaultHedgeDelay, r.hedgeDelay())
+
+	for i := 1; i <= 2*hedgeLatencyWindow; i++ {
+		r.recordLatency(time.Duration(i) * time.Millisecond)
+	}
+	// only the last 100 reads, 101ms to 200ms, are kept
+	assert.Equal(t, 195*time.Millisecond, r.hedgeDelay())
+}
+
+func TestCriticalReader_Quorum(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the response of the quorum", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{result: 1}, &readRPC{result: 2}, &readRPC{result: 2})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("ignores failed nodes", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{err: errors.New("boom")}, &readRPC{result: 2}, &readRPC{result: 2})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("fails without quorum", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{result: 1}, &readRPC{result: 2}, &readRPC{err: errors.New("boom")})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.ErrorContains(t, err, "quorum of 2 identical responses not reached from 3 nodes")
+		require.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("returns as soon as the quorum is reached", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{result: 2}, &readRPC{result: 2}, &readRPC{result: 1, delay: time.Minute})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("fails as soon as the quorum can't be reached", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 3, &readRPC{result: 2}, &readRPC{err: errors.New("boom")}, &readRPC{result: 2, delay: time.Minute})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.ErrorContains(t, err, "quorum of 3 identical responses not reached from 3 nodes")
+	})
+
+	t.Run("reads the latest block at the lowest head of the nodes", func(t *testing.T) {
+		results := map[int64]int{10: 1, 11: 2}
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{results: results, head: 10}, &readRPC{results: results, head: 11})
+		result, err := r.Read(tests.Context(t), nil, readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+
+		result, err = r.Read(tests.Context(t), big.NewInt(11), readFn)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("returns the error response of the quorum", func(t *testing.T) {
+		reverted := &readRPC{err: fmt.Errorf("call failed: %w", revertError{data: "0x01"})}
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{result: 1}, reverted, reverted)
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		var revertErr revertError
+		require.ErrorAs(t, err, &revertErr)
+		assert.Equal(t, "0x01", revertErr.data)
+	})
+
+	t.Run("does not group different error responses", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{err: revertError{data: "0x01"}}, &readRPC{err: revertError{data: "0x02"}}, &readRPC{result: 1})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.ErrorContains(t, err, "quorum of 2 identical responses not reached from 3 nodes")
+	})
+
+	t.Run("does not count transport errors as votes", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 2, &readRPC{err: errors.New("connection refused")}, &readRPC{err: errors.New("connection refused")}, &readRPC{result: 1})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.ErrorContains(t, err, "quorum of 2 identical responses not reached from 3 nodes")
+	})
+
+	t.Run("fails with too few alive nodes", func(t *testing.T) {
+		r := newTestCriticalReader(t, ReadModeQuorum, 3, &readRPC{result: 1}, &readRPC{result: 1})
+		_, err := r.Read(tests.Context(t), nil, readFn)
+		require.EqualError(t, err, "quorum read requires 3 alive nodes, only 2 available")
+	})
+}
@@ -157,6 +157,52 @@ func (_c *mockNode_HighestUserObservations_Call[CHAIN_ID, RPC]) RunAndReturn(run
 	return _c
 }
 
+// LatencyStats provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) LatencyStats() LatencyStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatencyStats")
+	}
+
+	var r0 LatencyStats
+	if rf, ok := ret.Get(0).(func() LatencyStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(LatencyStats)
+	}
+
+	return r0
+}
+
+// mockNode_LatencyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatencyStats'
+type mockNode_LatencyStats_Call[CHAIN_ID types.ID, RPC any] struct {
+	*mock.Call
+}
+
+// LatencyStats is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) LatencyStats() *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	return &mockNode_LatencyStats_Call[CHAIN_ID, RPC]{Call: _e.mock.On("LatencyStats")}
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) Return(_a0 LatencyStats) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockNode_LatencyStats_Call[CHAIN_ID, RPC]) RunAndReturn(run func() LatencyStats) *mockNode_LatencyStats_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function with given fields:
 // Name provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, RPC]) Name() string {
 	ret := _m.Called()
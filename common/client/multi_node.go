@@ -239,6 +239,21 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	return c.activeNode, err
 }
 
+// aliveNodes returns the active node, followed by the other alive primary nodes in their configured order.
+func (c *MultiNode[CHAIN_ID, RPC]) aliveNodes() ([]Node[CHAIN_ID, RPC], error) {
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node[CHAIN_ID, RPC]{active}
+	for _, n := range c.primaryNodes {
+		if n != active && n.State() == nodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes, nil
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
@@ -21,6 +21,9 @@ const QueryTimeout = 10 * time.Second
 
 var errInvalidChainID = errors.New("invalid chain id")
 
+// latencyEWMAWeight is the weight given to each new call in the moving averages of an RPC's latency and error rate.
+const latencyEWMAWeight = 0.2
+
 var (
 	promPoolRPCNodeVerifies = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "pool_rpc_node_verifies",
@@ -34,6 +37,10 @@ var (
 		Name: "pool_rpc_node_verifies_success",
 		Help: "The total number of successful chain ID verifications for the given RPC node",
 	}, []string{"network", "chainID", "nodeName"})
+	promPoolRPCNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_ms",
+		Help: "The moving average of the round-trip time in milliseconds of calls made to the given RPC node",
+	}, []string{"network", "chainID", "nodeName"})
 )
 
 type NodeConfig interface {
@@ -79,6 +86,8 @@ type Node[
 	ConfiguredChainID() CHAIN_ID
 	// Order - returns priority order configured for the RPC
 	Order() int32
+	// LatencyStats - returns the moving averages of round-trip time and error rate of the calls made by the node to the RPC
+	LatencyStats() LatencyStats
 	// Start - starts health checks
 	Start(context.Context) error
 	Close() error
@@ -109,6 +118,9 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	latencyMu sync.RWMutex // protects latency
+	latency   LatencyStats
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -116,6 +128,16 @@ type node[
 	healthCheckSubs []types.Subscription
 }
 
+// LatencyStats holds exponentially weighted moving averages of the calls made to an RPC.
+type LatencyStats struct {
+	// Latency is the average round-trip time of successful calls
+	Latency time.Duration
+	// ErrorRate is the average fraction of calls which failed
+	ErrorRate float64
+	// Calls is the total number of calls recorded
+	Calls uint64
+}
+
 func NewNode[
 	CHAIN_ID types.ID,
 	HEAD Head,
@@ -268,7 +290,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) verifyChainID(callerCtx context.Context, lgg
 
 	var chainID CHAIN_ID
 	var err error
-	if chainID, err = n.rpc.ChainID(callerCtx); err != nil {
+	start := time.Now()
+	chainID, err = n.rpc.ChainID(callerCtx)
+	n.recordCall(time.Since(start), err)
+	if err != nil {
 		promFailed()
 		lggr.Errorw("Failed to verify chain ID for node", "err", err, "nodeState", n.getCachedState())
 		return nodeStateUnreachable
@@ -310,7 +335,9 @@ func (n *node[CHAIN_ID, HEAD, RPC]) verifyConn(ctx context.Context, lggr logger.
 	}
 
 	if n.nodePoolCfg.NodeIsSyncingEnabled() {
+		start := time.Now()
 		isSyncing, err := n.rpc.IsSyncing(ctx)
+		n.recordCall(time.Since(start), err)
 		if err != nil {
 			lggr.Errorw("Unexpected error while verifying RPC node synchronization status", "err", err, "nodeState", n.getCachedState())
 			return nodeStateUnreachable
@@ -329,6 +356,53 @@ func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) LatencyStats() LatencyStats {
+	n.latencyMu.RLock()
+	defer n.latencyMu.RUnlock()
+	return n.latency
+}
+
+// recordCall updates the latency stats with a health check call which took elapsed, and returned err. Only the
+// health checks are recorded, as every node makes the same ones, while the calls of users only go to the active node
+// and vary widely in cost. Only transport errors, including timeouts, count as failures: an error returned by the RPC
+// is still a response. Failed calls don't count towards the latency, as they often end with a timeout.
+func (n *node[CHAIN_ID, HEAD, RPC]) recordCall(elapsed time.Duration, err error) {
+	n.latencyMu.Lock()
+	defer n.latencyMu.Unlock()
+	var failed float64
+	if isTransportError(err) {
+		failed = 1
+	}
+	if n.latency.Calls == 0 {
+		n.latency.ErrorRate = failed
+	} else {
+		n.latency.ErrorRate += latencyEWMAWeight * (failed - n.latency.ErrorRate)
+	}
+	if failed == 0 {
+		if n.latency.Latency == 0 {
+			n.latency.Latency = elapsed
+		} else {
+			n.latency.Latency += time.Duration(latencyEWMAWeight * float64(elapsed-n.latency.Latency))
+		}
+	}
+	n.latency.Calls++
+	promPoolRPCNodeLatency.WithLabelValues(n.chainFamily, n.chainID.String(), n.name).Set(float64(n.latency.Latency.Milliseconds()))
+}
+
+// jsonRPCError is implemented by the errors returned in JSON-RPC responses, e.g. go-ethereum's rpc.Error.
+type jsonRPCError interface {
+	ErrorCode() int
+}
+
+// isTransportError returns true if err did not come from a response of the RPC, e.g. a connection error or a timeout.
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr jsonRPCError
+	return !errors.As(err, &rpcErr)
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) newCtx() (context.Context, context.CancelFunc) {
 	ctx, cancel := n.stopCh.NewCtx()
 	ctx = CtxAddHealthCheckFlag(ctx)
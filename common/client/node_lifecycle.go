@@ -143,8 +143,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Pinging RPC", "nodeState", n.State(), "pollFailures", pollFailures)
 			pollCtx, cancel := context.WithTimeout(ctx, pollInterval)
+			start := time.Now()
 			err = n.RPC().Ping(pollCtx)
 			cancel()
+			n.recordCall(time.Since(start), err)
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -371,7 +373,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) isOutOfSyncWithPool(localState ChainInfo) (o
 	ln, ci := n.poolInfoProvider.LatestChainInfo()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLowestLatency:
 		return localState.BlockNumber < ci.BlockNumber-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
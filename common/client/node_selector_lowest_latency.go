@@ -0,0 +1,64 @@
+package client
+
+import (
+	"math"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// lowestLatencyErrorPenalty is the latency added to a node's score for each call which failed with a transport error or
+// a timeout, as such a call usually costs the caller a timeout and a retry.
+const lowestLatencyErrorPenalty = QueryTimeout
+
+type lowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] []Node[CHAIN_ID, RPC]
+
+func NewLowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+	return lowestLatencyNodeSelector[CHAIN_ID, RPC](nodes)
+}
+
+// Select returns the alive node with the lowest score, computed from the moving averages of the latency and error rate
+// of its health checks.
+// Nodes which have not been called yet are only selected when no other node has stats, and ties are broken by Order.
+func (s lowestLatencyNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	lowestScore := time.Duration(math.MaxInt64)
+	var lowestScoreNodes []Node[CHAIN_ID, RPC]
+	var unmeasuredNodes []Node[CHAIN_ID, RPC]
+	for _, n := range s {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		stats := n.LatencyStats()
+		if stats.Calls == 0 {
+			unmeasuredNodes = append(unmeasuredNodes, n)
+			continue
+		}
+		score := latencyScore(stats)
+		if score <= lowestScore {
+			if score < lowestScore {
+				lowestScore = score
+				lowestScoreNodes = nil
+			}
+			lowestScoreNodes = append(lowestScoreNodes, n)
+		}
+	}
+	if len(lowestScoreNodes) == 0 {
+		return firstOrHighestPriority(unmeasuredNodes)
+	}
+	return firstOrHighestPriority(lowestScoreNodes)
+}
+
+func (s lowestLatencyNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLowestLatency
+}
+
+// latencyScore weighs the average latency of a node against its error rate.
+func latencyScore(stats LatencyStats) time.Duration {
+	return stats.Latency + time.Duration(stats.ErrorRate*float64(lowestLatencyErrorPenalty))
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLowestLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLowestLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLowestLatency)
+}
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+
+	newNode := func(state nodeState, stats LatencyStats, order int32) Node[types.ID, nodeClient] {
+		node := newMockNode[types.ID, nodeClient](t)
+		node.On("State").Return(state)
+		node.On("LatencyStats").Return(stats).Maybe()
+		node.On("Order").Return(order).Maybe()
+		return node
+	}
+
+	t.Run("selects the fastest alive node", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateOutOfSync, LatencyStats{Latency: time.Millisecond, Calls: 10}, 1),
+			newNode(nodeStateAlive, LatencyStats{Latency: 200 * time.Millisecond, Calls: 10}, 1),
+			newNode(nodeStateAlive, LatencyStats{Latency: 50 * time.Millisecond, Calls: 10}, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("penalizes errors", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, LatencyStats{Latency: 200 * time.Millisecond, Calls: 10}, 1),
+			// 50ms + 10% of the 10s penalty
+			newNode(nodeStateAlive, LatencyStats{Latency: 50 * time.Millisecond, ErrorRate: 0.1, Calls: 10}, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+	})
+
+	t.Run("breaks ties by order", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, LatencyStats{Latency: 50 * time.Millisecond, Calls: 10}, 2),
+			newNode(nodeStateAlive, LatencyStats{Latency: 50 * time.Millisecond, Calls: 10}, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("prefers measured nodes", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, LatencyStats{}, 1),
+			newNode(nodeStateAlive, LatencyStats{Latency: time.Second, Calls: 1}, 2),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("falls back to unmeasured nodes", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, LatencyStats{}, 2),
+			newNode(nodeStateAlive, LatencyStats{}, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
+
+func TestLowestLatencyNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+	var nodes []Node[types.ID, nodeClient]
+
+	for i := 0; i < 2; i++ {
+		node := newMockNode[types.ID, nodeClient](t)
+		node.On("State").Return(nodeStateUnreachable)
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+	assert.Nil(t, selector.Select())
+}
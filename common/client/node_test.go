@@ -1,10 +1,15 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	clientMocks "github.com/smartcontractkit/chainlink/v2/common/client/mocks"
@@ -105,3 +110,49 @@ func newTestNode(t *testing.T, opts testNodeOpts) testNode {
 		nodeI.(*node[types.ID, Head, RPCClient[types.ID, Head]]),
 	}
 }
+
+func TestUnit_Node_recordCall(t *testing.T) {
+	t.Parallel()
+
+	node := newTestNode(t, testNodeOpts{})
+	assert.Equal(t, LatencyStats{}, node.LatencyStats())
+
+	node.recordCall(100*time.Millisecond, nil)
+	stats := node.LatencyStats()
+	assert.Equal(t, uint64(1), stats.Calls)
+	assert.Zero(t, stats.ErrorRate)
+	assert.Equal(t, 100*time.Millisecond, stats.Latency)
+
+	// failed calls only affect the error rate
+	node.recordCall(time.Minute, errors.New("timeout"))
+	failed := node.LatencyStats()
+	assert.Equal(t, uint64(2), failed.Calls)
+	assert.InDelta(t, latencyEWMAWeight, failed.ErrorRate, 1e-9)
+	assert.Equal(t, stats.Latency, failed.Latency)
+
+	// faster calls bring the latency down
+	for i := 0; i < 50; i++ {
+		node.recordCall(time.Microsecond, nil)
+	}
+	stats = node.LatencyStats()
+	assert.Less(t, stats.Latency, time.Millisecond)
+	assert.Less(t, stats.ErrorRate, 0.001)
+}
+
+// rpcResponseError is an error returned in a JSON-RPC response.
+type rpcResponseError struct{}
+
+func (rpcResponseError) Error() string  { return "execution reverted" }
+func (rpcResponseError) ErrorCode() int { return 3 }
+
+func TestUnit_Node_recordCall_IgnoresResponseErrors(t *testing.T) {
+	t.Parallel()
+
+	node := newTestNode(t, testNodeOpts{})
+	node.recordCall(50*time.Millisecond, nil)
+	node.recordCall(50*time.Millisecond, fmt.Errorf("call failed: %w", rpcResponseError{}))
+	assert.Equal(t, LatencyStats{Latency: 50 * time.Millisecond, Calls: 2}, node.LatencyStats())
+
+	node.recordCall(time.Second, context.DeadlineExceeded)
+	assert.Equal(t, LatencyStats{Latency: 50 * time.Millisecond, ErrorRate: latencyEWMAWeight, Calls: 3}, node.LatencyStats())
+}
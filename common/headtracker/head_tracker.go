@@ -56,6 +56,7 @@ type headTracker[
 	log             logger.SugaredLogger
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH]
 	headSaver       HeadSaver[HTH, BLOCK_HASH]
+	reorgBus        ReorgBus[BLOCK_HASH]
 	mailMon         *mailbox.Monitor
 	client          htrktypes.Client[HTH, S, ID, BLOCK_HASH]
 	chainID         types.ID
@@ -66,6 +67,11 @@ type headTracker[
 	broadcastMB  *mailbox.Mailbox[HTH]
 	headListener HeadListener[HTH, BLOCK_HASH]
 	getNilHead   func() HTH
+
+	// lastCheckedHead is the latest chain checked for reorgs, and lastFinalized its latest finalized block. They are
+	// only accessed by backfillLoop.
+	lastCheckedHead HTH
+	lastFinalized   *BlockRef[BLOCK_HASH]
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -81,6 +87,7 @@ func NewHeadTracker[
 	htConfig htrktypes.HeadTrackerConfig,
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH],
 	headSaver HeadSaver[HTH, BLOCK_HASH],
+	reorgBus ReorgBus[BLOCK_HASH],
 	mailMon *mailbox.Monitor,
 	getNilHead func() HTH,
 ) HeadTracker[HTH, BLOCK_HASH] {
@@ -93,6 +100,7 @@ func NewHeadTracker[
 		backfillMB:      mailbox.NewSingle[HTH](),
 		broadcastMB:     mailbox.New[HTH](HeadsBufferSize),
 		headSaver:       headSaver,
+		reorgBus:        reorgBus,
 		mailMon:         mailMon,
 		getNilHead:      getNilHead,
 	}
@@ -309,6 +317,8 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.checkReorg(head)
 					}
 				}
 			}
@@ -316,6 +326,76 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 	}
 }
 
+// checkReorg compares the backfilled chain of head with the previously checked chain, and publishes a ReorgEvent if the
+// previous head is not part of the new chain.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) checkReorg(head HTH) {
+	newChain := ht.headSaver.Chain(head.BlockHash())
+	if !newChain.IsValid() {
+		return
+	}
+	prevChain, prevFinalized := ht.lastCheckedHead, ht.lastFinalized
+	// Lower heads are not checked, and the last checked head is kept, so that a reorg to a shorter chain is still
+	// detected once the new chain catches up.
+	if prevChain.IsValid() && newChain.BlockNumber() < prevChain.BlockNumber() {
+		return
+	}
+	ht.lastCheckedHead = newChain
+	if finalized := newChain.LatestFinalizedHead(); finalized != nil {
+		ht.lastFinalized = &BlockRef[BLOCK_HASH]{Number: finalized.BlockNumber(), Hash: finalized.BlockHash()}
+	}
+	if !prevChain.IsValid() {
+		return
+	}
+	if newChain.HashAtHeight(prevChain.BlockNumber()) == prevChain.BlockHash() {
+		return
+	}
+
+	event := ReorgEvent[BLOCK_HASH]{
+		OldHead:    BlockRef[BLOCK_HASH]{Number: prevChain.BlockNumber(), Hash: prevChain.BlockHash()},
+		NewHead:    BlockRef[BLOCK_HASH]{Number: newChain.BlockNumber(), Hash: newChain.BlockHash()},
+		FromBlock:  prevChain.BlockNumber(),
+		ToBlock:    prevChain.BlockNumber(),
+		DetectedAt: time.Now(),
+	}
+	// walk the old chain back until we find a block which is also part of the new chain
+	for cur := prevChain.GetParent(); cur != nil; cur = cur.GetParent() {
+		newHead, err := newChain.HeadAtHeight(cur.BlockNumber())
+		if err != nil {
+			// the new chain does not go that deep, so the common ancestor is unknown
+			break
+		}
+		if newHead.BlockHash() == cur.BlockHash() {
+			event.CommonAncestor = &BlockRef[BLOCK_HASH]{Number: cur.BlockNumber(), Hash: cur.BlockHash()}
+			break
+		}
+		event.FromBlock = cur.BlockNumber()
+	}
+	if finalized := prevChain.LatestFinalizedHead(); finalized != nil {
+		event.LatestFinalizedBlock = finalized.BlockNumber()
+		event.FinalityViolated = event.FromBlock <= finalized.BlockNumber()
+	}
+	// The old chain may have been trimmed above the common ancestor, so also cross-check the hash of the latest
+	// finalized block seen before against the new chain.
+	if prevFinalized != nil {
+		if newFinalized, err := newChain.HeadAtHeight(prevFinalized.Number); err == nil && newFinalized.BlockHash() != prevFinalized.Hash {
+			event.FinalityViolated = true
+			event.FromBlock = min(event.FromBlock, prevFinalized.Number)
+			event.LatestFinalizedBlock = max(event.LatestFinalizedBlock, prevFinalized.Number)
+		}
+	}
+	event.Depth = event.ToBlock - event.FromBlock + 1
+
+	l := ht.log.With("oldHead", event.OldHead, "newHead", event.NewHead, "commonAncestor", event.CommonAncestor,
+		"fromBlock", event.FromBlock, "toBlock", event.ToBlock, "depth", event.Depth,
+		"latestFinalizedBlock", event.LatestFinalizedBlock)
+	if event.FinalityViolated {
+		l.Criticalw("Reorg replaced finalized blocks. Either the RPC nodes are misbehaving or the finality of the chain was violated. This node may not function correctly without manual intervention.")
+	} else {
+		l.Infow("Detected reorg")
+	}
+	ht.reorgBus.Publish(event)
+}
+
 // LatestAndFinalizedBlock - returns latest and latest finalized blocks.
 // NOTE: Returns latest finalized block as is, ignoring the FinalityTagBypass feature flag.
 // TODO: BCI-3321 use cached values instead of making RPC requests
@@ -0,0 +1,171 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// ReorgEventsBufferSize is the number of reorg events waiting to be relayed before the oldest ones are dropped.
+const ReorgEventsBufferSize = 100
+
+// BlockRef identifies a block by number and hash.
+type BlockRef[BLOCK_HASH types.Hashable] struct {
+	Number int64
+	Hash   BLOCK_HASH
+}
+
+// ReorgEvent describes a reorg detected by the HeadTracker: the new longest chain does not contain the previous head.
+type ReorgEvent[BLOCK_HASH types.Hashable] struct {
+	// OldHead is the head of the previous longest chain, which was reorged out.
+	OldHead BlockRef[BLOCK_HASH]
+	// NewHead is the head of the new longest chain.
+	NewHead BlockRef[BLOCK_HASH]
+	// CommonAncestor is the latest block of the old chain which is also in the new chain. It is nil if the reorg goes
+	// deeper than the heads tracked by the HeadTracker.
+	CommonAncestor *BlockRef[BLOCK_HASH]
+	// FromBlock and ToBlock are the range of the blocks of the old chain which were replaced. If CommonAncestor is nil,
+	// FromBlock is the earliest block known to be replaced.
+	FromBlock int64
+	ToBlock   int64
+	// Depth is the number of blocks of the old chain which were replaced.
+	Depth int64
+	// LatestFinalizedBlock is the latest finalized block of the old chain.
+	LatestFinalizedBlock int64
+	// FinalityViolated is true if the reorg replaced blocks of the old chain which were finalized.
+	FinalityViolated bool
+	DetectedAt       time.Time
+}
+
+// ReorgListener is implemented by the services which need to react to reorgs.
+type ReorgListener[BLOCK_HASH types.Hashable] interface {
+	OnReorg(ctx context.Context, event ReorgEvent[BLOCK_HASH])
+}
+
+// ReorgSaver persists the reorg events for postmortems.
+type ReorgSaver[BLOCK_HASH types.Hashable] interface {
+	SaveReorgEvent(ctx context.Context, event ReorgEvent[BLOCK_HASH]) error
+}
+
+// ReorgBus persists the reorgs published by the HeadTracker and relays them to all subscribers.
+type ReorgBus[BLOCK_HASH types.Hashable] interface {
+	services.Service
+	Publish(event ReorgEvent[BLOCK_HASH])
+	Subscribe(listener ReorgListener[BLOCK_HASH]) (unsubscribe func())
+}
+
+type reorgBus[BLOCK_HASH types.Hashable] struct {
+	services.Service
+	eng *services.Engine
+
+	saver          ReorgSaver[BLOCK_HASH]
+	mailbox        *mailbox.Mailbox[ReorgEvent[BLOCK_HASH]]
+	mutex          sync.Mutex
+	listeners      map[int]ReorgListener[BLOCK_HASH]
+	lastListenerID int
+}
+
+// NewReorgBus creates a new ReorgBus persisting the events with saver.
+func NewReorgBus[BLOCK_HASH types.Hashable](lggr logger.Logger, saver ReorgSaver[BLOCK_HASH]) ReorgBus[BLOCK_HASH] {
+	rb := &reorgBus[BLOCK_HASH]{
+		saver:     saver,
+		mailbox:   mailbox.New[ReorgEvent[BLOCK_HASH]](ReorgEventsBufferSize),
+		listeners: make(map[int]ReorgListener[BLOCK_HASH]),
+	}
+	rb.Service, rb.eng = services.Config{
+		Name:  "ReorgBus",
+		Start: rb.start,
+		Close: rb.close,
+	}.NewServiceEngine(lggr)
+	return rb
+}
+
+func (rb *reorgBus[BLOCK_HASH]) start(context.Context) error {
+	rb.eng.Go(rb.run)
+	return nil
+}
+
+func (rb *reorgBus[BLOCK_HASH]) close() error {
+	rb.mutex.Lock()
+	rb.listeners = make(map[int]ReorgListener[BLOCK_HASH])
+	rb.mutex.Unlock()
+	return nil
+}
+
+// Publish queues the event to be persisted and relayed to the subscribers. It does not block.
+func (rb *reorgBus[BLOCK_HASH]) Publish(event ReorgEvent[BLOCK_HASH]) {
+	if wasOverCapacity := rb.mailbox.Deliver(event); wasOverCapacity {
+		rb.eng.Errorw("ReorgBus mailbox is over capacity, dropped the oldest reorg event", "event", event)
+	}
+}
+
+// Subscribe subscribes the listener to the reorg events until ReorgBus is closed, or unsubscribe is called explicitly.
+func (rb *reorgBus[BLOCK_HASH]) Subscribe(listener ReorgListener[BLOCK_HASH]) (unsubscribe func()) {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+
+	rb.lastListenerID++
+	listenerID := rb.lastListenerID
+	rb.listeners[listenerID] = listener
+	return func() {
+		rb.mutex.Lock()
+		defer rb.mutex.Unlock()
+		delete(rb.listeners, listenerID)
+	}
+}
+
+func (rb *reorgBus[BLOCK_HASH]) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-rb.mailbox.Notify():
+			for {
+				event, exists := rb.mailbox.Retrieve()
+				if !exists {
+					break
+				}
+				rb.relay(ctx, event)
+			}
+		}
+	}
+}
+
+// relay persists the event, then calls the listeners concurrently. Unlike heads, reorg events are never skipped, so
+// each listener gets every event in order, as long as it returns within TrackableCallbackTimeout.
+func (rb *reorgBus[BLOCK_HASH]) relay(ctx context.Context, event ReorgEvent[BLOCK_HASH]) {
+	if err := rb.saver.SaveReorgEvent(ctx, event); err != nil {
+		rb.eng.Errorw("Failed to save reorg event", "event", event, "err", err)
+	}
+
+	rb.mutex.Lock()
+	listeners := make([]ReorgListener[BLOCK_HASH], 0, len(rb.listeners))
+	for _, listener := range rb.listeners {
+		listeners = append(listeners, listener)
+	}
+	rb.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(len(listeners))
+	for _, listener := range listeners {
+		go func(listener ReorgListener[BLOCK_HASH]) {
+			defer wg.Done()
+			start := time.Now()
+			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			defer cancel()
+			listener.OnReorg(cctx, event)
+			elapsed := time.Since(start)
+			rb.eng.Debugw(fmt.Sprintf("Finished reorg callback in %s", elapsed),
+				"listenerType", reflect.TypeOf(listener), "newHead", event.NewHead.Number, "time", elapsed)
+		}(listener)
+	}
+	wg.Wait()
+}
@@ -138,6 +138,7 @@ type Broadcaster[
 	enabledAddresses []ADDR
 
 	checkerFactory TransmitCheckerFactory[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	safeMode       txmgrtypes.SafeModeChecker
 
 	// triggers allow other goroutines to force Broadcaster to rescan the
 	// database early (before the next poll interval)
@@ -260,6 +261,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetR
 	eb.resumeCallback = callback
 }
 
+// SetSafeModeChecker pauses the broadcast of the queued transactions while the chain is in safe mode. They are
+// broadcast once the safe mode is acknowledged. It must be called before Start.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetSafeModeChecker(c txmgrtypes.SafeModeChecker) {
+	eb.safeMode = c
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Name() string {
 	return eb.lggr.Name()
 }
@@ -374,6 +381,13 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		}
 	}()
 
+	if eb.safeMode != nil {
+		if err = eb.safeMode.CheckSafeMode(); err != nil {
+			eb.lggr.Warnw("Chain is in safe mode, not broadcasting transactions", "address", fromAddress, "err", err)
+			return false, nil
+		}
+	}
+
 	err, retryable = eb.handleAnyInProgressTx(ctx, fromAddress)
 	if err != nil {
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
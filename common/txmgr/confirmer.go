@@ -14,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	commonhex "github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
 
@@ -44,6 +45,9 @@ const (
 	logAfterNConsecutiveBlocksChainTooShort = 10
 )
 
+// ErrTxCancelled is the error of the transactions cancelled by the operator.
+var ErrTxCancelled = errors.New("transaction cancelled")
+
 var (
 	promNumGasBumps = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_num_gas_bumps",
@@ -129,6 +133,7 @@ type Confirmer[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	resumeCallback  ResumeCallback
+	userOpMgr       txmgrtypes.UserOperationManager[ADDR, TX_HASH]
 	chainConfig     txmgrtypes.ConfirmerChainConfig
 	feeConfig       txmgrtypes.ConfirmerFeeConfig
 	txConfig        txmgrtypes.ConfirmerTransactionsConfig
@@ -251,6 +256,11 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Set
 	ec.resumeCallback = callback
 }
 
+// SetUserOperationManager makes the Confirmer track the user operations sent through m on every head.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetUserOperationManager(m txmgrtypes.UserOperationManager[ADDR, TX_HASH]) {
+	ec.userOpMgr = m
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Name() string {
 	return ec.lggr.Name()
 }
@@ -354,6 +364,16 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 		ec.lggr.Debugw("Finished ResumePendingTaskRuns", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	}
 
+	if ec.userOpMgr != nil {
+		mark = time.Now()
+		// the bundler being unreachable must not stop the confirmation of regular transactions
+		if err := ec.userOpMgr.CheckUserOperations(ctx, head.BlockNumber()); err != nil {
+			ec.lggr.Errorw("CheckUserOperations failed", "headNum", head.BlockNumber(), "err", err)
+		}
+
+		ec.lggr.Debugw("Finished CheckUserOperations", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+	}
+
 	ec.lggr.Debugw("processHead finish", "headNum", head.BlockNumber(), "id", "confirmer")
 
 	return nil
@@ -516,7 +536,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 				return
 			}
 			// Resume pending task runs with failure for stuck transactions
-			if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
+			if err := ec.resumeFailedTaskRuns(ctx, tx, errors.New(ec.stuckTxDetector.StuckTxFatalError())); err != nil {
 				errMu.Lock()
 				errorList = append(errorList, fmt.Errorf("failed to resume pending task run for transaction: %w", err))
 				errMu.Unlock()
@@ -586,7 +606,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 		if err != nil {
 			return fmt.Errorf("batchFetchReceipts failed: %w", err)
 		}
-		validReceipts, purgeReceipts := ec.separateValidAndPurgeAttemptReceipts(receipts, batch)
+		validReceipts, purgeReceipts, cancelReceipts := ec.separateValidAndPurgeAttemptReceipts(receipts, batch)
 		// Saves the receipts and mark the associated transactions as Confirmed
 		if err := ec.txStore.SaveFetchedReceipts(ctx, validReceipts, TxConfirmed, nil, ec.chainID); err != nil {
 			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
@@ -596,6 +616,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 		if err := ec.txStore.SaveFetchedReceipts(ctx, purgeReceipts, TxFatalError, &stuckTxFatalErrMsg, ec.chainID); err != nil {
 			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
 		}
+		cancelledErrMsg := ErrTxCancelled.Error()
+		if err := ec.txStore.SaveFetchedReceipts(ctx, cancelReceipts, TxFatalError, &cancelledErrMsg, ec.chainID); err != nil {
+			return fmt.Errorf("saveFetchedReceipts failed: %w", err)
+		}
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
 
 		allReceipts = append(allReceipts, receipts...)
@@ -606,7 +630,9 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) fet
 	return nil
 }
 
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) separateValidAndPurgeAttemptReceipts(receipts []R, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (valid []R, purge []R) {
+// separateValidAndPurgeAttemptReceipts separates the receipts of regular attempts from the ones of purge attempts, which
+// are further split between the transactions purged as terminally stuck and the ones cancelled by an operator
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) separateValidAndPurgeAttemptReceipts(receipts []R, attempts []txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (valid []R, purge []R, cancel []R) {
 	receiptMap := make(map[TX_HASH]R)
 	for _, receipt := range receipts {
 		receiptMap[receipt.GetTxHash()] = receipt
@@ -616,7 +642,11 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sep
 			if attempt.IsPurgeAttempt {
 				// Setting the purged block num here is ok since we have confirmation the tx has been purged with the receipt
 				ec.stuckTxDetector.SetPurgeBlockNum(attempt.Tx.FromAddress, receipt.GetBlockNumber().Int64())
-				purge = append(purge, receipt)
+				if attempt.Tx.Cancelled {
+					cancel = append(cancel, receipt)
+				} else {
+					purge = append(purge, receipt)
+				}
 			} else {
 				valid = append(valid, receipt)
 			}
@@ -625,11 +655,11 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sep
 	return
 }
 
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], txErr error) error {
 	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
 		return nil
 	}
-	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, errors.New(ec.stuckTxDetector.StuckTxFatalError()))
+	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, txErr)
 	if errors.Is(err, sql.ErrNoRows) {
 		ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
 	} else if err != nil {
@@ -1247,6 +1277,66 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) For
 	return nil
 }
 
+// CancelTransaction cancels a transaction on behalf of the operator.
+// An unstarted transaction is marked as fatally errored straight away, unless the Broadcaster picked it up first. An
+// unconfirmed transaction is replaced by a purge attempt: an empty transaction with zero value and a bumped fee at the
+// same sequence. The purge attempt is saved as in_progress and broadcast on the next head, after which it is tracked and
+// bumped like any other purge attempt. The transaction is marked as fatally errored once the purge attempt is included,
+// and its pending task run is resumed with ErrTxCancelled. If the original transaction is included instead, it is
+// confirmed as usual.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	lggr := etx.GetLogger(ec.lggr)
+	switch etx.State {
+	case TxUnstarted:
+		etx.Error = null.StringFrom(ErrTxCancelled.Error())
+		if err := ec.txStore.UpdateTxUnstartedToCancelled(ctx, &etx); err != nil {
+			return fmt.Errorf("failed to mark transaction as cancelled: %w", err)
+		}
+		lggr.Warnw("Transaction cancelled", "etx", etx)
+		return ec.resumeFailedTaskRuns(ctx, etx, ErrTxCancelled)
+	case TxUnconfirmed:
+		if len(etx.TxAttempts) == 0 {
+			return fmt.Errorf("invariant violation: unconfirmed transaction %v has no attempts", etx.ID)
+		}
+		if etx.TxAttempts[0].IsPurgeAttempt {
+			return fmt.Errorf("transaction %v is already being purged", etx.ID)
+		}
+		purgeAttempt, err := ec.TxAttemptBuilder.NewPurgeTxAttempt(ctx, etx, lggr)
+		if err != nil {
+			return fmt.Errorf("failed to create a purge attempt: %w", err)
+		}
+		if err = ec.txStore.SaveCancelAttempt(ctx, &purgeAttempt); err != nil {
+			return fmt.Errorf("failed to save purge attempt: %w", err)
+		}
+		lggr.Warnw("Transaction cancelled, purging it", "etx", etx)
+		return nil
+	default:
+		return fmt.Errorf("cannot cancel transaction %v in state %s", etx.ID, etx.State)
+	}
+}
+
+// BumpTransaction replaces the latest attempt of an unconfirmed transaction with one paying the given fee, on behalf
+// of the operator. Like with CancelTransaction, the new attempt is broadcast on the next head.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) BumpTransaction(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], fee FEE) error {
+	if etx.State != TxUnconfirmed {
+		return fmt.Errorf("cannot bump transaction %v in state %s", etx.ID, etx.State)
+	}
+	if len(etx.TxAttempts) == 0 {
+		return fmt.Errorf("invariant violation: unconfirmed transaction %v has no attempts", etx.ID)
+	}
+	lggr := etx.GetLogger(ec.lggr)
+	previousAttempt := etx.TxAttempts[0]
+	attempt, err := ec.NewManualBumpTxAttempt(ctx, etx, previousAttempt, fee, lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create attempt with fee %s: %w", fee.String(), err)
+	}
+	if err = ec.txStore.SaveInProgressAttempt(ctx, &attempt); err != nil {
+		return fmt.Errorf("failed to save bumped attempt: %w", err)
+	}
+	lggr.Infow("Transaction bumped", "etx", etx, "previousFee", previousAttempt.TxFee.String(), "fee", fee.String())
+	return nil
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sendEmptyTransaction(ctx context.Context, fromAddress ADDR, seq SEQ, overrideGasLimit uint64, fee FEE) (string, error) {
 	gasLimit := overrideGasLimit
 	if gasLimit == 0 {
@@ -1275,7 +1365,9 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Res
 	for _, data := range receiptsPlus {
 		var taskErr error
 		var output interface{}
-		if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
+		if data.Cancelled {
+			taskErr = ErrTxCancelled
+		} else if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
 			taskErr = fmt.Errorf("transaction %s reverted on-chain", data.Receipt.GetTxHash())
 		} else {
 			output = data.Receipt
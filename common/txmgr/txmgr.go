@@ -63,6 +63,12 @@ type TxManager[
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error)
 	CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState) (count uint32, err error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (state commontypes.TransactionStatus, err error)
+	// CancelTransaction cancels the unstarted or unconfirmed transaction with the given ID
+	CancelTransaction(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// BumpTransaction rebroadcasts the unconfirmed transaction with the given ID at the given fee
+	BumpTransaction(ctx context.Context, id int64, fee FEE) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// SendUserOperation sends the request as an ERC-4337 user operation through a bundler, and returns its hash
+	SendUserOperation(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) (opHash TX_HASH, err error)
 }
 
 type reset struct {
@@ -110,6 +116,8 @@ type Txm[
 	tracker            *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	finalizer          txmgrtypes.Finalizer[BLOCK_HASH, HEAD]
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
+	userOpMgr          txmgrtypes.UserOperationManager[ADDR, TX_HASH]
+	safeMode           txmgrtypes.SafeModeChecker
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
 }
@@ -120,6 +128,19 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RegisterRe
 	b.confirmer.SetResumeCallback(fn)
 }
 
+// SetUserOperationManager enables sending transactions as user operations through m. It must be called before Start.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetUserOperationManager(m txmgrtypes.UserOperationManager[ADDR, TX_HASH]) {
+	b.userOpMgr = m
+	b.confirmer.SetUserOperationManager(m)
+}
+
+// SetSafeModeChecker pauses the creation and the broadcast of transactions while the chain is in safe mode. It must
+// be called before Start.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetSafeModeChecker(c txmgrtypes.SafeModeChecker) {
+	b.safeMode = c
+	b.broadcaster.SetSafeModeChecker(c)
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm[
 	CHAIN_ID types.ID,
@@ -225,6 +246,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx
 			}
 		}
 
+		if b.userOpMgr != nil {
+			if err := ms.Start(ctx, b.userOpMgr); err != nil {
+				return fmt.Errorf("Txm: UserOperationManager failed to start: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
@@ -277,6 +304,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop ForwarderManager: %w", err))
 			}
 		}
+		if b.userOpMgr != nil {
+			if err := b.userOpMgr.Close(); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop UserOperationManager: %w", err))
+			}
+		}
 
 		b.wg.Wait()
 
@@ -306,6 +338,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthRepo
 	if b.txConfig.ForwardersEnabled() {
 		services.CopyHealth(report, b.fwdMgr.HealthReport())
 	}
+	if b.userOpMgr != nil {
+		services.CopyHealth(report, b.userOpMgr.HealthReport())
+	}
 	return report
 }
 
@@ -524,6 +559,10 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		}
 	}
 
+	if err = b.checkSafeMode(); err != nil {
+		return tx, err
+	}
+
 	if err = b.checkEnabled(ctx, txRequest.FromAddress); err != nil {
 		return tx, err
 	}
@@ -587,11 +626,24 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkEnabl
 	return nil
 }
 
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkSafeMode() error {
+	if b.safeMode == nil {
+		return nil
+	}
+	if err := b.safeMode.CheckSafeMode(); err != nil {
+		return fmt.Errorf("cannot send transaction on chain ID %s: %w", b.chainID.String(), err)
+	}
+	return nil
+}
+
 // SendNativeToken creates a transaction that transfers the given value of native tokens
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	if utils.IsZero(to) {
 		return etx, errors.New("cannot send native token to zero address")
 	}
+	if err = b.checkSafeMode(); err != nil {
+		return etx, err
+	}
 	txRequest := txmgrtypes.TxRequest[ADDR, TX_HASH]{
 		FromAddress:    from,
 		ToAddress:      to,
@@ -675,6 +727,69 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 	}
 }
 
+// CancelTransaction cancels the unstarted or unconfirmed transaction with the given ID, and returns the updated transaction.
+// The outcome of the cancellation of an unconfirmed transaction is tracked by the Confirmer, see Confirmer.CancelTransaction.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	tx, err := b.getTxByID(ctx, id)
+	if err != nil {
+		return etx, err
+	}
+	if err = b.confirmer.CancelTransaction(ctx, *tx); err != nil {
+		return etx, fmt.Errorf("CancelTransaction failed: %w", err)
+	}
+	tx, err = b.getTxByID(ctx, id)
+	if err != nil {
+		return etx, err
+	}
+	return *tx, nil
+}
+
+// SendUserOperation sends the request as an ERC-4337 user operation through a bundler instead of a transaction of its
+// FromAddress, so that the fees are paid by the smart account or a paymaster. It returns the hash of the user operation.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SendUserOperation(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) (opHash TX_HASH, err error) {
+	if b.userOpMgr == nil {
+		return opHash, errors.New("user operations are not enabled, to enable set Transactions.UserOperations.Enabled = true")
+	}
+	if err = b.checkSafeMode(); err != nil {
+		return opHash, err
+	}
+	if err = b.checkEnabled(ctx, txRequest.FromAddress); err != nil {
+		return opHash, err
+	}
+	return b.userOpMgr.SendUserOperation(ctx, txRequest)
+}
+
+// BumpTransaction rebroadcasts the unconfirmed transaction with the given ID at the given fee, and returns the updated transaction.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) BumpTransaction(ctx context.Context, id int64, fee FEE) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	tx, err := b.getTxByID(ctx, id)
+	if err != nil {
+		return etx, err
+	}
+	if err = b.confirmer.BumpTransaction(ctx, *tx, fee); err != nil {
+		return etx, fmt.Errorf("BumpTransaction failed: %w", err)
+	}
+	tx, err = b.getTxByID(ctx, id)
+	if err != nil {
+		return etx, err
+	}
+	return *tx, nil
+}
+
+// getTxByID loads the transaction with the given ID and its attempts, and checks that it belongs to an enabled key on this chain
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) getTxByID(ctx context.Context, id int64) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	tx, err := b.txStore.GetTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction %d: %w", id, err)
+	}
+	if tx == nil || tx.ChainID.String() != b.chainID.String() {
+		return nil, fmt.Errorf("transaction %d not found on chain ID %s", id, b.chainID.String())
+	}
+	if err = b.checkEnabled(ctx, tx.FromAddress); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
 type NullTxManager[
 	CHAIN_ID types.ID,
 	HEAD types.Head[BLOCK_HASH],
@@ -762,6 +877,18 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 	return
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTransaction(ctx context.Context, id int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) BumpTransaction(ctx context.Context, id int64, fee FEE) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendUserOperation(ctx context.Context, txRequest txmgrtypes.TxRequest[ADDR, TX_HASH]) (opHash TX_HASH, err error) {
+	return opHash, errors.New(n.ErrMsg)
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueueAndCreateTxn(
 	ctx context.Context,
 	txRequest txmgrtypes.TxRequest[ADDR, TX_HASH],
@@ -15,3 +15,15 @@ type ForwarderManager[ADDR types.Hashable] interface {
 	// Converts payload to be forwarder-friendly
 	ConvertPayload(dest ADDR, origPayload []byte) ([]byte, error)
 }
+
+// UserOperationManager sends transactions as ERC-4337 user operations of a smart account through a bundler,
+// so that their fees are paid by the account or a paymaster instead of the sending key.
+type UserOperationManager[ADDR types.Hashable, TX_HASH types.Hashable] interface {
+	services.Service
+	// SendUserOperation wraps the request into a user operation signed by its FromAddress, which must own the smart
+	// account, and sends it to the bundler. It returns the hash of the user operation.
+	SendUserOperation(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH]) (opHash TX_HASH, err error)
+	// CheckUserOperations saves the receipts of the user operations included on chain, and resends the ones which
+	// have not been included for too long.
+	CheckUserOperations(ctx context.Context, blockNum int64) error
+}
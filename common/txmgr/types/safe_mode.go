@@ -0,0 +1,8 @@
+package types
+
+// SafeModeChecker reports whether the chain is in safe mode, in which case no transaction must be created or
+// broadcast until an operator acknowledges the cause.
+type SafeModeChecker interface {
+	// CheckSafeMode returns an error describing the cause of the safe mode, or nil if the chain is not in safe mode.
+	CheckSafeMode() error
+}
@@ -93,8 +93,29 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Priority determines the order in which the unstarted transactions of FromAddress are broadcast.
+	Priority TxPriority
+
+	// Blobs is the data carried by an EIP-4844 blob transaction. Each blob is zero padded to the blob
+	// size of the chain. Only supported by the EVM txmgr.
+	Blobs [][]byte
 }
 
+// TxPriority orders the unstarted transactions of an address. Transactions with a higher priority
+// are broadcast first, and transactions with the same priority in the order they were created.
+type TxPriority int32
+
+const (
+	// TxPriorityLow is for bulk transactions which are not time sensitive, e.g. VRF fulfillments or
+	// blockhash stores.
+	TxPriorityLow TxPriority = -1
+	// TxPriorityNormal is the default priority.
+	TxPriorityNormal TxPriority = 0
+	// TxPriorityHigh is for urgent transactions, e.g. OCR transmissions or keeper performs.
+	TxPriorityHigh TxPriority = 1
+)
+
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
 // on chain.
 type TransmitCheckerSpec[ADDR types.Hashable] struct {
@@ -232,6 +253,12 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	Priority TxPriority
+	// Cancelled marks a tx cancelled by an operator. Its purge attempt replaces it on chain.
+	Cancelled bool
+	// BlobSidecar is the chain specific encoding of the blobs carried by the transaction, if any
+	BlobSidecar []byte
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
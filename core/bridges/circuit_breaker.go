@@ -0,0 +1,146 @@
+package bridges
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// healthScoreWeight is the weight of the latest request in the health score.
+const healthScoreWeight = 0.1
+
+var ErrCircuitOpen = errors.New("bridge circuit breaker is open")
+
+// CircuitState is the state of the circuit breaker of a bridge.
+type CircuitState string
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects requests until the next probe.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen has let a probe through, and rejects requests until it
+	// completes.
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// CircuitBreakerStatus is a snapshot of the circuit breaker of a bridge.
+type CircuitBreakerStatus struct {
+	State               CircuitState
+	ConsecutiveFailures uint32
+	// HealthScore is an exponentially weighted moving average of the
+	// request outcomes, from 0 (all failed) to 1 (all succeeded).
+	HealthScore float64
+	// OpenedAt and NextProbeAt are zero when the circuit is closed.
+	OpenedAt    time.Time
+	NextProbeAt time.Time
+}
+
+// CircuitBreakers tracks the health of each bridge, and stops sending
+// requests to a bridge after too many consecutive failures so that the tasks
+// using it fail fast instead of waiting on timeouts. An open circuit is probed
+// periodically, and closes again as soon as a probe succeeds.
+// A nil CircuitBreakers lets all requests through.
+type CircuitBreakers struct {
+	threshold     uint32
+	probeInterval time.Duration
+	now           func() time.Time
+
+	mu       sync.Mutex
+	breakers map[BridgeName]*CircuitBreakerStatus
+}
+
+// NewCircuitBreakers opens the circuit of a bridge after threshold consecutive
+// failed requests, and probes it every probeInterval while it is open.
+func NewCircuitBreakers(threshold uint32, probeInterval time.Duration) *CircuitBreakers {
+	return &CircuitBreakers{
+		threshold:     threshold,
+		probeInterval: probeInterval,
+		now:           time.Now,
+		breakers:      make(map[BridgeName]*CircuitBreakerStatus),
+	}
+}
+
+func (c *CircuitBreakers) get(name BridgeName) *CircuitBreakerStatus {
+	b, ok := c.breakers[name]
+	if !ok {
+		b = &CircuitBreakerStatus{State: CircuitClosed, HealthScore: 1}
+		c.breakers[name] = b
+	}
+	return b
+}
+
+// Allow returns ErrCircuitOpen if no request should be sent to the bridge.
+// Once the probe interval has elapsed, an open circuit lets one request
+// through, whose outcome must be passed to Record.
+func (c *CircuitBreakers) Allow(name BridgeName) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b := c.get(name)
+	if b.State == CircuitClosed {
+		return nil
+	}
+	now := c.now()
+	if now.Before(b.NextProbeAt) {
+		return ErrCircuitOpen
+	}
+	// if the probe never completes, another one is let through after the interval
+	b.State = CircuitHalfOpen
+	b.NextProbeAt = now.Add(c.probeInterval)
+	return nil
+}
+
+// Record updates the circuit of the bridge with the outcome of a request.
+func (c *CircuitBreakers) Record(name BridgeName, success bool) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b := c.get(name)
+	if success {
+		b.HealthScore += healthScoreWeight * (1 - b.HealthScore)
+		*b = CircuitBreakerStatus{State: CircuitClosed, HealthScore: b.HealthScore}
+		return
+	}
+
+	b.HealthScore -= healthScoreWeight * b.HealthScore
+	b.ConsecutiveFailures++
+	switch {
+	case b.State == CircuitHalfOpen:
+		b.State = CircuitOpen
+		b.NextProbeAt = c.now().Add(c.probeInterval)
+	case b.State == CircuitClosed && b.ConsecutiveFailures >= c.threshold:
+		now := c.now()
+		b.State = CircuitOpen
+		b.OpenedAt = now
+		b.NextProbeAt = now.Add(c.probeInterval)
+	}
+}
+
+// Status returns the current status of the circuit of the bridge.
+func (c *CircuitBreakers) Status(name BridgeName) CircuitBreakerStatus {
+	if c == nil {
+		return CircuitBreakerStatus{State: CircuitClosed, HealthScore: 1}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b, ok := c.breakers[name]; ok {
+		return *b
+	}
+	return CircuitBreakerStatus{State: CircuitClosed, HealthScore: 1}
+}
+
+// Reset closes the circuit of the bridge and forgets its history, e.g. after
+// the bridge has been updated or deleted.
+func (c *CircuitBreakers) Reset(name BridgeName) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.breakers, name)
+}
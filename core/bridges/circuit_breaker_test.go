@@ -0,0 +1,118 @@
+package bridges_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	const name = bridges.BridgeName("bridge1")
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers(3, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow(name))
+			cb.Record(name, false)
+		}
+		// a success resets the count
+		require.NoError(t, cb.Allow(name))
+		cb.Record(name, true)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow(name))
+			cb.Record(name, false)
+		}
+		status := cb.Status(name)
+		assert.Equal(t, bridges.CircuitClosed, status.State)
+		assert.Equal(t, uint32(2), status.ConsecutiveFailures)
+
+		require.NoError(t, cb.Allow(name))
+		cb.Record(name, false)
+		status = cb.Status(name)
+		assert.Equal(t, bridges.CircuitOpen, status.State)
+		assert.Equal(t, uint32(3), status.ConsecutiveFailures)
+		assert.False(t, status.OpenedAt.IsZero())
+		assert.Equal(t, status.OpenedAt.Add(time.Hour), status.NextProbeAt)
+		assert.ErrorIs(t, cb.Allow(name), bridges.ErrCircuitOpen)
+
+		// other bridges are not affected
+		assert.NoError(t, cb.Allow("bridge2"))
+	})
+
+	t.Run("probes an open circuit", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers(1, time.Millisecond)
+
+		cb.Record(name, false)
+		require.Equal(t, bridges.CircuitOpen, cb.Status(name).State)
+		time.Sleep(time.Millisecond)
+
+		// a single probe is let through
+		require.NoError(t, cb.Allow(name))
+		assert.Equal(t, bridges.CircuitHalfOpen, cb.Status(name).State)
+
+		// a failed probe opens the circuit again
+		cb.Record(name, false)
+		assert.Equal(t, bridges.CircuitOpen, cb.Status(name).State)
+		time.Sleep(time.Millisecond)
+
+		// a successful probe closes it
+		require.NoError(t, cb.Allow(name))
+		cb.Record(name, true)
+		status := cb.Status(name)
+		assert.Equal(t, bridges.CircuitClosed, status.State)
+		assert.Zero(t, status.ConsecutiveFailures)
+		assert.True(t, status.OpenedAt.IsZero())
+		assert.True(t, status.NextProbeAt.IsZero())
+	})
+
+	t.Run("rejects requests while probing", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers(1, 50*time.Millisecond)
+
+		cb.Record(name, false)
+		time.Sleep(50 * time.Millisecond)
+		require.NoError(t, cb.Allow(name))
+		assert.ErrorIs(t, cb.Allow(name), bridges.ErrCircuitOpen)
+	})
+
+	t.Run("health score", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers(100, time.Hour)
+		assert.Equal(t, 1.0, cb.Status(name).HealthScore)
+
+		for i := 0; i < 10; i++ {
+			cb.Record(name, false)
+		}
+		low := cb.Status(name).HealthScore
+		assert.InDelta(t, 0.35, low, 0.01)
+
+		cb.Record(name, true)
+		assert.Greater(t, cb.Status(name).HealthScore, low)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		cb := bridges.NewCircuitBreakers(1, time.Hour)
+
+		cb.Record(name, false)
+		require.ErrorIs(t, cb.Allow(name), bridges.ErrCircuitOpen)
+		cb.Reset(name)
+		assert.NoError(t, cb.Allow(name))
+		assert.Equal(t, bridges.CircuitBreakerStatus{State: bridges.CircuitClosed, HealthScore: 1}, cb.Status(name))
+	})
+
+	t.Run("nil registry allows all requests", func(t *testing.T) {
+		var cb *bridges.CircuitBreakers
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, cb.Allow(name))
+			cb.Record(name, false)
+		}
+		cb.Reset(name)
+		assert.Equal(t, bridges.CircuitBreakerStatus{State: bridges.CircuitClosed, HealthScore: 1}, cb.Status(name))
+	})
+}
@@ -439,6 +439,10 @@ func makeTestEvmTxm(
 	require.NoError(t, broadcaster.Start(testutils.Context(t)), "failed to start head broadcaster")
 	t.Cleanup(func() { require.NoError(t, broadcaster.Close()) })
 
+	reorgBus := headtracker.NewReorgBus(logger.NullLogger, headtracker.NewNullReorgORM())
+	require.NoError(t, reorgBus.Start(testutils.Context(t)), "failed to start reorg bus")
+	t.Cleanup(func() { require.NoError(t, reorgBus.Close()) })
+
 	ht := headtracker.NewHeadTracker(
 		logger.NullLogger,
 		ethClient,
@@ -446,6 +450,7 @@ func makeTestEvmTxm(
 		evmConfig.HeadTrackerConfig,
 		broadcaster,
 		headSaver,
+		reorgBus,
 		mailbox.NewMonitor("contract_transmitter_test", logger.NullLogger),
 	)
 	require.NoError(t, ht.Start(testutils.Context(t)), "failed to start head tracker")
@@ -478,7 +483,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 	require.NoError(t, err, "can't create tx manager")
 
 	_, unsub := broadcaster.Subscribe(txm)
@@ -625,6 +631,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Mempool() evmconfig.Mempool {
+	return &TestMempoolConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -677,6 +687,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestMempoolConfig struct {
+	evmconfig.Mempool
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -690,6 +704,9 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) UserOperations() evmconfig.UserOperationsConfig {
+	return &userOperationsConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -697,6 +714,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type userOperationsConfig struct {
+	evmconfig.UserOperationsConfig
+}
+
+func (u *userOperationsConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 	"sync"
@@ -104,6 +105,10 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+
+	// callReader and balanceReader protect the reads which feed on-chain decisions, according to the ReadMode
+	callReader    *commonclient.CriticalReader[*big.Int, *RPCClient, []byte]
+	balanceReader *commonclient.CriticalReader[*big.Int, *RPCClient, *big.Int]
 }
 
 func NewChainClient(
@@ -115,6 +120,8 @@ func NewChainClient(
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	readMode string,
+	readQuorum uint32,
 	chainType chaintype.ChainType,
 ) Client {
 	chainFamily := "EVM"
@@ -143,20 +150,20 @@ func NewChainClient(
 	)
 
 	return &chainClient{
-		multiNode:    multiNode,
-		txSender:     txSender,
-		logger:       logger.Sugared(lggr),
-		chainType:    chainType,
-		clientErrors: clientErrors,
+		multiNode:     multiNode,
+		txSender:      txSender,
+		callReader:    commonclient.NewCriticalReader(lggr, multiNode, "eth_call", readMode, readQuorum, bytes.Equal),
+		balanceReader: commonclient.NewCriticalReader(lggr, multiNode, "eth_getBalance", readMode, readQuorum, func(a, b *big.Int) bool { return a.Cmp(b) == 0 }),
+		logger:        logger.Sugared(lggr),
+		chainType:     chainType,
+		clientErrors:  clientErrors,
 	}
 }
 
 func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.BalanceAt(ctx, account, blockNumber)
+	return c.balanceReader.Read(ctx, blockNumber, func(ctx context.Context, r *RPCClient, blockNumber *big.Int) (*big.Int, error) {
+		return r.BalanceAt(ctx, account, blockNumber)
+	})
 }
 
 // BatchCallContext - sends all given requests as a single batch.
@@ -246,11 +253,9 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return c.callReader.Read(ctx, blockNumber, func(ctx context.Context, r *RPCClient, blockNumber *big.Int) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	})
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
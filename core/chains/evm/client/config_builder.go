@@ -49,6 +49,10 @@ func NewClientConfigs(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	// critical reads are not protected for external users
+	readMode, readQuorum := commonclient.ReadModeSingle, uint32(1)
+	// nor are RPC requests rate limited
+	noRateLimit, noBudget := uint32(0), uint64(0)
 	nodePool := toml.NodePool{
 		SelectionMode:              selectionMode,
 		LeaseDuration:              commonconfig.MustNewDuration(leaseDuration),
@@ -60,6 +64,14 @@ func NewClientConfigs(
 		DeathDeclarationDelay:      commonconfig.MustNewDuration(deathDeclarationDelay),
 		FinalizedBlockPollInterval: commonconfig.MustNewDuration(finalizedBlockPollInterval),
 		NewHeadsPollInterval:       commonconfig.MustNewDuration(newHeadsPollInterval),
+		ReadMode:                   &readMode,
+		ReadQuorum:                 &readQuorum,
+		RateLimit: toml.RPCRateLimit{
+			GetLogsPerSecond:     &noRateLimit,
+			CallPerSecond:        &noRateLimit,
+			OtherPerSecond:       &noRateLimit,
+			MonthlyRequestBudget: &noBudget,
+		},
 	}
 	nodePoolCfg := &evmconfig.NodePoolConfig{C: nodePool}
 	chainConfig := &evmconfig.EVMConfig{
@@ -92,6 +92,9 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeReadMode                   string
+	NodeReadQuorum                 uint32
+	NodeRateLimit                  TestRPCRateLimit
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -126,6 +129,23 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) ReadMode() string   { return tc.NodeReadMode }
+func (tc TestNodePoolConfig) ReadQuorum() uint32 { return tc.NodeReadQuorum }
+
+func (tc TestNodePoolConfig) RateLimit() config.RPCRateLimit { return tc.NodeRateLimit }
+
+type TestRPCRateLimit struct {
+	GetLogsPerSecondVal     uint32
+	CallPerSecondVal        uint32
+	OtherPerSecondVal       uint32
+	MonthlyRequestBudgetVal uint64
+}
+
+func (rl TestRPCRateLimit) GetLogsPerSecond() uint32     { return rl.GetLogsPerSecondVal }
+func (rl TestRPCRateLimit) CallPerSecond() uint32        { return rl.CallPerSecondVal }
+func (rl TestRPCRateLimit) OtherPerSecond() uint32       { return rl.OtherPerSecondVal }
+func (rl TestRPCRateLimit) MonthlyRequestBudget() uint64 { return rl.MonthlyRequestBudgetVal }
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -168,7 +188,7 @@ func NewChainClientWithTestNode(
 	}
 
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, commonclient.ReadModeSingle, 1, "")
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -182,7 +202,7 @@ func NewChainClientWithEmptyNode(
 ) Client {
 	lggr := logger.Test(t)
 
-	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, commonclient.ReadModeSingle, 1, "")
 	t.Cleanup(c.Close)
 	return c
 }
@@ -206,7 +226,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, commonclient.ReadModeSingle, 1, "")
 	t.Cleanup(c.Close)
 	return c
 }
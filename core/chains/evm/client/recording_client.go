@@ -0,0 +1,521 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+const (
+	// cassetteHeadEvent is the method of the entries recording the heads received by a SubscribeToHeads subscription
+	cassetteHeadEvent = "newHeads"
+	// cassetteLogEvent is the method of the entries recording the logs received by a SubscribeFilterLogs subscription
+	cassetteLogEvent = "logs"
+)
+
+// CassetteEntry is a single line of a JSONL cassette, recording one call of a Client method, or one event received
+// by a subscription.
+type CassetteEntry struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+var _ Client = (*RecordingClient)(nil)
+
+// RecordingClient wraps a Client, and writes every request and response to a JSONL cassette, which can be served
+// by a ReplayClient to reproduce the traffic without network access.
+type RecordingClient struct {
+	c    Client
+	lggr logger.SugaredLogger
+
+	mu  sync.Mutex // protects enc
+	enc *json.Encoder
+}
+
+func NewRecordingClient(c Client, w io.Writer, lggr logger.Logger) *RecordingClient {
+	r := &RecordingClient{
+		c:    c,
+		lggr: logger.Sugared(logger.Named(lggr, "RecordingClient")),
+		enc:  json.NewEncoder(w),
+	}
+	// recorded once, as they are static and called very frequently
+	r.record("ConfiguredChainID", nil, c.ConfiguredChainID(), nil)
+	r.record("IsL2", nil, c.IsL2(), nil)
+	return r
+}
+
+func (r *RecordingClient) record(method string, params []any, result any, err error) {
+	entry := CassetteEntry{Method: method}
+	if len(params) > 0 {
+		b, merr := json.Marshal(params)
+		if merr != nil {
+			r.lggr.Errorw("Failed to marshal params, call is not recorded", "method", method, "err", merr)
+			return
+		}
+		entry.Params = b
+	}
+	b, merr := json.Marshal(result)
+	if merr != nil {
+		r.lggr.Errorw("Failed to marshal result, call is not recorded", "method", method, "err", merr)
+		return
+	}
+	entry.Result = b
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if werr := r.enc.Encode(entry); werr != nil {
+		r.lggr.Errorw("Failed to write cassette entry", "method", method, "err", werr)
+	}
+}
+
+func (r *RecordingClient) Dial(ctx context.Context) error {
+	return r.c.Dial(ctx)
+}
+
+func (r *RecordingClient) Close() {
+	r.c.Close()
+}
+
+func (r *RecordingClient) ConfiguredChainID() *big.Int {
+	return r.c.ConfiguredChainID()
+}
+
+func (r *RecordingClient) NodeStates() map[string]string {
+	return r.c.NodeStates()
+}
+
+func (r *RecordingClient) IsL2() bool {
+	return r.c.IsL2()
+}
+
+func (r *RecordingClient) TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error) {
+	balance, err := r.c.TokenBalance(ctx, address, contractAddress)
+	r.record("TokenBalance", []any{address, contractAddress}, balance, err)
+	return balance, err
+}
+
+func (r *RecordingClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	balance, err := r.c.BalanceAt(ctx, account, blockNumber)
+	r.record("BalanceAt", []any{account, blockNumber}, balance, err)
+	return balance, err
+}
+
+func (r *RecordingClient) LINKBalance(ctx context.Context, address common.Address, linkAddress common.Address) (*commonassets.Link, error) {
+	balance, err := r.c.LINKBalance(ctx, address, linkAddress)
+	r.record("LINKBalance", []any{address, linkAddress}, balance, err)
+	return balance, err
+}
+
+// CallContext records the raw JSON response, so that it is replayed exactly regardless of the type of result.
+func (r *RecordingClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var raw json.RawMessage
+	err := r.c.CallContext(ctx, &raw, method, args...)
+	r.record("CallContext", append([]any{method}, args...), raw, err)
+	if err != nil {
+		return err
+	}
+	return unmarshalRaw(raw, result)
+}
+
+func (r *RecordingClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return r.recordBatch("BatchCallContext", b, func(elems []rpc.BatchElem) error {
+		return r.c.BatchCallContext(ctx, elems)
+	})
+}
+
+func (r *RecordingClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return r.recordBatch("BatchCallContextAll", b, func(elems []rpc.BatchElem) error {
+		return r.c.BatchCallContextAll(ctx, elems)
+	})
+}
+
+// recordBatch records the raw JSON response of each element, like CallContext.
+func (r *RecordingClient) recordBatch(method string, b []rpc.BatchElem, call func([]rpc.BatchElem) error) error {
+	raws := make([]json.RawMessage, len(b))
+	elems := make([]rpc.BatchElem, len(b))
+	for i := range b {
+		elems[i] = rpc.BatchElem{Method: b[i].Method, Args: b[i].Args, Result: &raws[i]}
+	}
+	err := call(elems)
+
+	results := make([]cassetteBatchResult, len(b))
+	for i := range elems {
+		results[i].Result = raws[i]
+		if elems[i].Error != nil {
+			results[i].Error = elems[i].Error.Error()
+		}
+		if err == nil {
+			b[i].Error = elems[i].Error
+			if b[i].Error == nil {
+				b[i].Error = unmarshalRaw(raws[i], b[i].Result)
+			}
+		}
+	}
+	r.record(method, []any{newCassetteBatchCalls(b)}, results, err)
+	return err
+}
+
+func (r *RecordingClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	head, err := r.c.HeadByNumber(ctx, n)
+	r.record("HeadByNumber", []any{n}, newCassetteHead(head), err)
+	return head, err
+}
+
+func (r *RecordingClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
+	head, err := r.c.HeadByHash(ctx, h)
+	r.record("HeadByHash", []any{h}, newCassetteHead(head), err)
+	return head, err
+}
+
+// SubscribeToHeads records the subscription, and each head it receives as a separate entry.
+func (r *RecordingClient) SubscribeToHeads(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+	ch, sub, err := r.c.SubscribeToHeads(ctx)
+	r.record("SubscribeToHeads", nil, nil, err)
+	if err != nil {
+		return nil, nil, err
+	}
+	rsub := &recordingSubscription{Subscription: sub, stop: make(chan struct{})}
+	out := make(chan *evmtypes.Head)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-rsub.stop:
+				return
+			case head, ok := <-ch:
+				if !ok {
+					return
+				}
+				r.record(cassetteHeadEvent, nil, newCassetteHead(head), nil)
+				select {
+				case out <- head:
+				case <-rsub.stop:
+					return
+				}
+			}
+		}
+	}()
+	return out, rsub, nil
+}
+
+func (r *RecordingClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	head, err := r.c.LatestFinalizedBlock(ctx)
+	r.record("LatestFinalizedBlock", nil, newCassetteHead(head), err)
+	return head, err
+}
+
+func (r *RecordingClient) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (commonclient.SendTxReturnCode, error) {
+	code, err := r.c.SendTransactionReturnCode(ctx, tx, fromAddress)
+	r.record("SendTransactionReturnCode", []any{tx, fromAddress}, code, err)
+	return code, err
+}
+
+func (r *RecordingClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := r.c.SendTransaction(ctx, tx)
+	r.record("SendTransaction", []any{tx}, nil, err)
+	return err
+}
+
+func (r *RecordingClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	code, err := r.c.CodeAt(ctx, account, blockNumber)
+	r.record("CodeAt", []any{account, blockNumber}, hexutil.Bytes(code), err)
+	return code, err
+}
+
+func (r *RecordingClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	code, err := r.c.PendingCodeAt(ctx, account)
+	r.record("PendingCodeAt", []any{account}, hexutil.Bytes(code), err)
+	return code, err
+}
+
+func (r *RecordingClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	nonce, err := r.c.PendingNonceAt(ctx, account)
+	r.record("PendingNonceAt", []any{account}, nonce, err)
+	return nonce, err
+}
+
+func (r *RecordingClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	nonce, err := r.c.NonceAt(ctx, account, blockNumber)
+	r.record("NonceAt", []any{account, blockNumber}, nonce, err)
+	return nonce, err
+}
+
+func (r *RecordingClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	tx, err := r.c.TransactionByHash(ctx, txHash)
+	r.record("TransactionByHash", []any{txHash}, tx, err)
+	return tx, err
+}
+
+func (r *RecordingClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := r.c.TransactionReceipt(ctx, txHash)
+	r.record("TransactionReceipt", []any{txHash}, receipt, err)
+	return receipt, err
+}
+
+func (r *RecordingClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	block, err := r.c.BlockByNumber(ctx, number)
+	r.record("BlockByNumber", []any{number}, cassetteBlock{block}, err)
+	return block, err
+}
+
+func (r *RecordingClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	block, err := r.c.BlockByHash(ctx, hash)
+	r.record("BlockByHash", []any{hash}, cassetteBlock{block}, err)
+	return block, err
+}
+
+func (r *RecordingClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := r.c.FilterLogs(ctx, q)
+	r.record("FilterLogs", []any{q}, logs, err)
+	return logs, err
+}
+
+// SubscribeFilterLogs records the subscription, and each log it receives as a separate entry.
+func (r *RecordingClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	in := make(chan types.Log)
+	sub, err := r.c.SubscribeFilterLogs(ctx, q, in)
+	r.record("SubscribeFilterLogs", []any{q}, nil, err)
+	if err != nil {
+		return nil, err
+	}
+	rsub := &recordingSubscription{Subscription: sub, stop: make(chan struct{})}
+	go func() {
+		for {
+			select {
+			case <-rsub.stop:
+				return
+			case log := <-in:
+				r.record(cassetteLogEvent, []any{q}, log, nil)
+				select {
+				case ch <- log:
+				case <-rsub.stop:
+					return
+				}
+			}
+		}
+	}()
+	return rsub, nil
+}
+
+func (r *RecordingClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	gas, err := r.c.EstimateGas(ctx, call)
+	r.record("EstimateGas", []any{call}, gas, err)
+	return gas, err
+}
+
+func (r *RecordingClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	price, err := r.c.SuggestGasPrice(ctx)
+	r.record("SuggestGasPrice", nil, price, err)
+	return price, err
+}
+
+func (r *RecordingClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	tipCap, err := r.c.SuggestGasTipCap(ctx)
+	r.record("SuggestGasTipCap", nil, tipCap, err)
+	return tipCap, err
+}
+
+func (r *RecordingClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	height, err := r.c.LatestBlockHeight(ctx)
+	r.record("LatestBlockHeight", nil, height, err)
+	return height, err
+}
+
+func (r *RecordingClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	feeHistory, err := r.c.FeeHistory(ctx, blockCount, rewardPercentiles)
+	r.record("FeeHistory", []any{blockCount, rewardPercentiles}, feeHistory, err)
+	return feeHistory, err
+}
+
+func (r *RecordingClient) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+	header, err := r.c.HeaderByNumber(ctx, n)
+	r.record("HeaderByNumber", []any{n}, header, err)
+	return header, err
+}
+
+func (r *RecordingClient) HeaderByHash(ctx context.Context, h common.Hash) (*types.Header, error) {
+	header, err := r.c.HeaderByHash(ctx, h)
+	r.record("HeaderByHash", []any{h}, header, err)
+	return header, err
+}
+
+func (r *RecordingClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	res, err := r.c.CallContract(ctx, msg, blockNumber)
+	r.record("CallContract", []any{msg, blockNumber}, hexutil.Bytes(res), err)
+	return res, err
+}
+
+func (r *RecordingClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	res, err := r.c.PendingCallContract(ctx, msg)
+	r.record("PendingCallContract", []any{msg}, hexutil.Bytes(res), err)
+	return res, err
+}
+
+func (r *RecordingClient) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *SendError {
+	sendErr := r.c.CheckTxValidity(ctx, from, to, data)
+	var err error
+	if sendErr != nil {
+		err = sendErr
+	}
+	r.record("CheckTxValidity", []any{from, to, hexutil.Bytes(data)}, nil, err)
+	return sendErr
+}
+
+// recordingSubscription stops the forwarding of events once unsubscribed.
+type recordingSubscription struct {
+	ethereum.Subscription
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (s *recordingSubscription) Unsubscribe() {
+	s.Subscription.Unsubscribe()
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+type cassetteBatchCall struct {
+	Method string        `json:"method"`
+	Args   []interface{} `json:"args"`
+}
+
+func newCassetteBatchCalls(b []rpc.BatchElem) []cassetteBatchCall {
+	calls := make([]cassetteBatchCall, len(b))
+	for i := range b {
+		calls[i] = cassetteBatchCall{Method: b[i].Method, Args: b[i].Args}
+	}
+	return calls
+}
+
+type cassetteBatchResult struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// cassetteHead is the cassette encoding of a head. Unlike the JSON encoding of evmtypes.Head, it preserves every
+// field set from the RPC response.
+type cassetteHead struct {
+	Hash             common.Hash `json:"hash"`
+	Number           int64       `json:"number"`
+	L1BlockNumber    *int64      `json:"l1BlockNumber,omitempty"`
+	ParentHash       common.Hash `json:"parentHash"`
+	EVMChainID       *big.Int    `json:"evmChainID,omitempty"`
+	Timestamp        time.Time   `json:"timestamp"`
+	BaseFeePerGas    *big.Int    `json:"baseFeePerGas,omitempty"`
+	ReceiptsRoot     common.Hash `json:"receiptsRoot"`
+	TransactionsRoot common.Hash `json:"transactionsRoot"`
+	StateRoot        common.Hash `json:"stateRoot"`
+	Difficulty       *big.Int    `json:"difficulty,omitempty"`
+	TotalDifficulty  *big.Int    `json:"totalDifficulty,omitempty"`
+	IsFinalized      bool        `json:"isFinalized,omitempty"`
+}
+
+func newCassetteHead(h *evmtypes.Head) *cassetteHead {
+	if h == nil {
+		return nil
+	}
+	ch := &cassetteHead{
+		Hash:             h.Hash,
+		Number:           h.Number,
+		ParentHash:       h.ParentHash,
+		EVMChainID:       h.EVMChainID.ToInt(),
+		Timestamp:        h.Timestamp,
+		BaseFeePerGas:    h.BaseFeePerGas.ToInt(),
+		ReceiptsRoot:     h.ReceiptsRoot,
+		TransactionsRoot: h.TransactionsRoot,
+		StateRoot:        h.StateRoot,
+		Difficulty:       h.Difficulty,
+		TotalDifficulty:  h.TotalDifficulty,
+		IsFinalized:      h.IsFinalized.Load(),
+	}
+	if h.L1BlockNumber.Valid {
+		ch.L1BlockNumber = &h.L1BlockNumber.Int64
+	}
+	return ch
+}
+
+func (ch *cassetteHead) head() *evmtypes.Head {
+	if ch == nil {
+		return nil
+	}
+	h := &evmtypes.Head{
+		Hash:             ch.Hash,
+		Number:           ch.Number,
+		ParentHash:       ch.ParentHash,
+		Timestamp:        ch.Timestamp,
+		ReceiptsRoot:     ch.ReceiptsRoot,
+		TransactionsRoot: ch.TransactionsRoot,
+		StateRoot:        ch.StateRoot,
+		Difficulty:       ch.Difficulty,
+		TotalDifficulty:  ch.TotalDifficulty,
+	}
+	if ch.EVMChainID != nil {
+		h.EVMChainID = ubig.New(ch.EVMChainID)
+	}
+	if ch.BaseFeePerGas != nil {
+		h.BaseFeePerGas = assets.NewWei(ch.BaseFeePerGas)
+	}
+	if ch.L1BlockNumber != nil {
+		h.L1BlockNumber.Int64, h.L1BlockNumber.Valid = *ch.L1BlockNumber, true
+	}
+	h.IsFinalized.Store(ch.IsFinalized)
+	return h
+}
+
+// cassetteBlock encodes a block as RLP, since types.Block has no JSON encoding.
+type cassetteBlock struct {
+	*types.Block
+}
+
+func (b cassetteBlock) MarshalJSON() ([]byte, error) {
+	if b.Block == nil {
+		return []byte("null"), nil
+	}
+	enc, err := rlp.EncodeToBytes(b.Block)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(hexutil.Bytes(enc))
+}
+
+func (b *cassetteBlock) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		b.Block = nil
+		return nil
+	}
+	var enc hexutil.Bytes
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return err
+	}
+	b.Block = new(types.Block)
+	return rlp.DecodeBytes(enc, b.Block)
+}
+
+func unmarshalRaw(raw json.RawMessage, result interface{}) error {
+	if result == nil || len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
@@ -0,0 +1,126 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type fakeSubscription struct{}
+
+func (fakeSubscription) Unsubscribe()      {}
+func (fakeSubscription) Err() <-chan error { return nil }
+
+func TestRecordingClient_Replay(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	account := testutils.NewAddress()
+
+	head := testutils.Head(42)
+	head.BaseFeePerGas = assets.NewWeiI(7)
+	head.IsFinalized.Store(true)
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(42), Difficulty: big.NewInt(1)})
+
+	inner := mocks.NewClient(t)
+	inner.On("ConfiguredChainID").Return(testutils.FixtureChainID).Once()
+	inner.On("IsL2").Return(true).Once()
+	inner.On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Return(big.NewInt(1), nil).Once()
+	inner.On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Return(big.NewInt(2), nil).Once()
+	inner.On("HeadByNumber", mock.Anything, big.NewInt(42)).Return(head, nil).Once()
+	inner.On("BlockByNumber", mock.Anything, big.NewInt(42)).Return(block, nil).Once()
+	inner.On("TransactionReceipt", mock.Anything, head.Hash).Return(nil, ethereum.NotFound).Once()
+	inner.On("CallContext", mock.Anything, mock.Anything, "eth_chainId").Run(func(args mock.Arguments) {
+		*args.Get(1).(*json.RawMessage) = json.RawMessage(`"0x539"`)
+	}).Return(nil).Once()
+	inner.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		b := args.Get(1).([]rpc.BatchElem)
+		*b[0].Result.(*json.RawMessage) = json.RawMessage(`"0x1"`)
+		b[1].Error = ethereum.NotFound
+	}).Return(nil).Once()
+	heads := make(chan *evmtypes.Head, 1)
+	heads <- head
+	inner.On("SubscribeToHeads", mock.Anything).Return((<-chan *evmtypes.Head)(heads), fakeSubscription{}, nil).Once()
+
+	var cassette bytes.Buffer
+	recorder := client.NewRecordingClient(inner, &cassette, lggr)
+	record := func(c client.Client) {
+		balance, err := c.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), balance)
+		balance, err = c.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2), balance)
+
+		h, err := c.HeadByNumber(ctx, big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, head.Hash, h.Hash)
+		assert.Equal(t, head.BaseFeePerGas, h.BaseFeePerGas)
+		assert.Equal(t, head.EVMChainID, h.EVMChainID)
+		assert.True(t, h.IsFinalized.Load())
+
+		b, err := c.BlockByNumber(ctx, big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, block.Hash(), b.Hash())
+
+		_, err = c.TransactionReceipt(ctx, head.Hash)
+		require.ErrorIs(t, err, ethereum.NotFound)
+
+		var chainID string
+		require.NoError(t, c.CallContext(ctx, &chainID, "eth_chainId"))
+		assert.Equal(t, "0x539", chainID)
+
+		var nonce string
+		batch := []rpc.BatchElem{
+			{Method: "eth_getTransactionCount", Args: []interface{}{account, "latest"}, Result: &nonce},
+			{Method: "eth_getTransactionByHash", Args: []interface{}{head.Hash}, Result: new(json.RawMessage)},
+		}
+		require.NoError(t, c.BatchCallContext(ctx, batch))
+		assert.Equal(t, "0x1", nonce)
+		assert.NoError(t, batch[0].Error)
+		assert.ErrorIs(t, batch[1].Error, ethereum.NotFound)
+
+		ch, sub, err := c.SubscribeToHeads(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, head.Hash, (<-ch).Hash)
+		sub.Unsubscribe()
+	}
+	record(recorder)
+
+	replayer, err := client.NewReplayClient(&cassette, lggr)
+	require.NoError(t, err)
+	assert.Equal(t, testutils.FixtureChainID, replayer.ConfiguredChainID())
+	assert.True(t, replayer.IsL2())
+	record(replayer)
+
+	t.Run("repeats the last recorded response", func(t *testing.T) {
+		balance, err := replayer.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2), balance)
+	})
+
+	t.Run("returns an error for calls which were not recorded", func(t *testing.T) {
+		_, err := replayer.BalanceAt(ctx, account, big.NewInt(1))
+		require.ErrorIs(t, err, client.ErrNotRecorded)
+		_, err = replayer.PendingNonceAt(ctx, account)
+		require.ErrorIs(t, err, client.ErrNotRecorded)
+	})
+}
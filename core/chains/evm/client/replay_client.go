@@ -0,0 +1,410 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// ErrNotRecorded is returned by the ReplayClient for calls which are not in the cassette.
+var ErrNotRecorded = errors.New("call not recorded in cassette")
+
+// replayErrors are the errors which callers compare against, and are therefore replayed as the original error value
+// instead of an equivalent message.
+var replayErrors = []error{ethereum.NotFound, context.Canceled, context.DeadlineExceeded}
+
+var _ Client = (*ReplayClient)(nil)
+
+// ReplayClient serves the responses recorded in a cassette by a RecordingClient, without network access.
+// Calls are matched by method and params. Repeated identical calls are served the recorded responses in order, and
+// the last one once these run out. Events recorded from subscriptions are delivered to the replayed subscriptions in
+// the order they were recorded.
+type ReplayClient struct {
+	lggr    logger.SugaredLogger
+	chainID *big.Int
+	isL2    bool
+
+	mu        sync.Mutex // protects responses, heads and logs
+	responses map[string][]CassetteEntry
+	heads     []*cassetteHead
+	logs      map[string][]types.Log
+}
+
+func NewReplayClient(r io.Reader, lggr logger.Logger) (*ReplayClient, error) {
+	c := &ReplayClient{
+		lggr:      logger.Sugared(logger.Named(lggr, "ReplayClient")),
+		responses: make(map[string][]CassetteEntry),
+		logs:      make(map[string][]types.Log),
+	}
+	dec := json.NewDecoder(r)
+	for i := 1; ; i++ {
+		var entry CassetteEntry
+		if err := dec.Decode(&entry); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode cassette entry %d: %w", i, err)
+		}
+
+		var err error
+		switch entry.Method {
+		case "ConfiguredChainID":
+			err = json.Unmarshal(entry.Result, &c.chainID)
+		case "IsL2":
+			err = json.Unmarshal(entry.Result, &c.isL2)
+		case cassetteHeadEvent:
+			var head *cassetteHead
+			err = json.Unmarshal(entry.Result, &head)
+			c.heads = append(c.heads, head)
+		case cassetteLogEvent:
+			var log types.Log
+			err = json.Unmarshal(entry.Result, &log)
+			c.logs[string(entry.Params)] = append(c.logs[string(entry.Params)], log)
+		default:
+			key := entry.Method + string(entry.Params)
+			c.responses[key] = append(c.responses[key], entry)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode result of cassette entry %d (%s): %w", i, entry.Method, err)
+		}
+	}
+	return c, nil
+}
+
+// replay unmarshals the next response recorded for the call into result, and returns the recorded error.
+func (c *ReplayClient) replay(method string, params []any, result any) error {
+	var b []byte
+	if len(params) > 0 {
+		var err error
+		if b, err = json.Marshal(params); err != nil {
+			return fmt.Errorf("failed to marshal params of %s: %w", method, err)
+		}
+	}
+	key := method + string(b)
+
+	c.mu.Lock()
+	entries := c.responses[key]
+	if len(entries) == 0 {
+		c.mu.Unlock()
+		c.lggr.Errorw("Call not recorded in cassette", "method", method, "params", string(b))
+		return fmt.Errorf("%w: %s %s", ErrNotRecorded, method, b)
+	}
+	entry := entries[0]
+	if len(entries) > 1 {
+		c.responses[key] = entries[1:]
+	}
+	c.mu.Unlock()
+
+	if result != nil && len(entry.Result) > 0 {
+		if err := json.Unmarshal(entry.Result, result); err != nil {
+			return fmt.Errorf("failed to unmarshal recorded result of %s: %w", method, err)
+		}
+	}
+	if entry.Error != "" {
+		return replayError(entry.Error)
+	}
+	return nil
+}
+
+func replayError(msg string) error {
+	for _, err := range replayErrors {
+		if err.Error() == msg {
+			return err
+		}
+	}
+	return errors.New(msg)
+}
+
+func (c *ReplayClient) Dial(context.Context) error {
+	return nil
+}
+
+func (c *ReplayClient) Close() {}
+
+func (c *ReplayClient) ConfiguredChainID() *big.Int {
+	return c.chainID
+}
+
+func (c *ReplayClient) NodeStates() map[string]string {
+	return nil
+}
+
+func (c *ReplayClient) IsL2() bool {
+	return c.isL2
+}
+
+func (c *ReplayClient) TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (balance *big.Int, err error) {
+	err = c.replay("TokenBalance", []any{address, contractAddress}, &balance)
+	return
+}
+
+func (c *ReplayClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	err = c.replay("BalanceAt", []any{account, blockNumber}, &balance)
+	return
+}
+
+func (c *ReplayClient) LINKBalance(ctx context.Context, address common.Address, linkAddress common.Address) (balance *commonassets.Link, err error) {
+	err = c.replay("LINKBalance", []any{address, linkAddress}, &balance)
+	return
+}
+
+func (c *ReplayClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var raw json.RawMessage
+	if err := c.replay("CallContext", append([]any{method}, args...), &raw); err != nil {
+		return err
+	}
+	return unmarshalRaw(raw, result)
+}
+
+func (c *ReplayClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.replayBatch("BatchCallContext", b)
+}
+
+func (c *ReplayClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return c.replayBatch("BatchCallContextAll", b)
+}
+
+func (c *ReplayClient) replayBatch(method string, b []rpc.BatchElem) error {
+	var results []cassetteBatchResult
+	if err := c.replay(method, []any{newCassetteBatchCalls(b)}, &results); err != nil {
+		return err
+	}
+	if len(results) != len(b) {
+		return fmt.Errorf("recorded %d results for %s, but %d were requested", len(results), method, len(b))
+	}
+	for i := range b {
+		if results[i].Error != "" {
+			b[i].Error = replayError(results[i].Error)
+			continue
+		}
+		b[i].Error = unmarshalRaw(results[i].Result, b[i].Result)
+	}
+	return nil
+}
+
+func (c *ReplayClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	var head *cassetteHead
+	err := c.replay("HeadByNumber", []any{n}, &head)
+	return head.head(), err
+}
+
+func (c *ReplayClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
+	var head *cassetteHead
+	err := c.replay("HeadByHash", []any{h}, &head)
+	return head.head(), err
+}
+
+// SubscribeToHeads delivers the recorded heads which have not been delivered to a previous subscription.
+func (c *ReplayClient) SubscribeToHeads(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+	if err := c.replay("SubscribeToHeads", nil, nil); err != nil {
+		return nil, nil, err
+	}
+	sub := newReplaySubscription()
+	ch := make(chan *evmtypes.Head)
+	go func() {
+		defer close(ch)
+		for {
+			c.mu.Lock()
+			if len(c.heads) == 0 {
+				c.mu.Unlock()
+				// like a live subscription which receives no new heads
+				<-sub.stop
+				return
+			}
+			head := c.heads[0]
+			c.heads = c.heads[1:]
+			c.mu.Unlock()
+
+			select {
+			case ch <- head.head():
+			case <-sub.stop:
+				return
+			}
+		}
+	}()
+	return ch, sub, nil
+}
+
+func (c *ReplayClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	var head *cassetteHead
+	err := c.replay("LatestFinalizedBlock", nil, &head)
+	return head.head(), err
+}
+
+func (c *ReplayClient) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (code commonclient.SendTxReturnCode, err error) {
+	err = c.replay("SendTransactionReturnCode", []any{tx, fromAddress}, &code)
+	return
+}
+
+func (c *ReplayClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return c.replay("SendTransaction", []any{tx}, nil)
+}
+
+func (c *ReplayClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code hexutil.Bytes
+	err := c.replay("CodeAt", []any{account, blockNumber}, &code)
+	return code, err
+}
+
+func (c *ReplayClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	var code hexutil.Bytes
+	err := c.replay("PendingCodeAt", []any{account}, &code)
+	return code, err
+}
+
+func (c *ReplayClient) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = c.replay("PendingNonceAt", []any{account}, &nonce)
+	return
+}
+
+func (c *ReplayClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	err = c.replay("NonceAt", []any{account, blockNumber}, &nonce)
+	return
+}
+
+func (c *ReplayClient) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *types.Transaction, err error) {
+	err = c.replay("TransactionByHash", []any{txHash}, &tx)
+	return
+}
+
+func (c *ReplayClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = c.replay("TransactionReceipt", []any{txHash}, &receipt)
+	return
+}
+
+func (c *ReplayClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	var block cassetteBlock
+	err := c.replay("BlockByNumber", []any{number}, &block)
+	return block.Block, err
+}
+
+func (c *ReplayClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	var block cassetteBlock
+	err := c.replay("BlockByHash", []any{hash}, &block)
+	return block.Block, err
+}
+
+func (c *ReplayClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = c.replay("FilterLogs", []any{q}, &logs)
+	return
+}
+
+// SubscribeFilterLogs delivers the logs recorded for the same filter query, which have not been delivered to a
+// previous subscription.
+func (c *ReplayClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if err := c.replay("SubscribeFilterLogs", []any{q}, nil); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal([]any{q})
+	if err != nil {
+		return nil, err
+	}
+	key := string(b)
+	sub := newReplaySubscription()
+	go func() {
+		for {
+			c.mu.Lock()
+			if len(c.logs[key]) == 0 {
+				c.mu.Unlock()
+				return
+			}
+			log := c.logs[key][0]
+			c.logs[key] = c.logs[key][1:]
+			c.mu.Unlock()
+
+			select {
+			case ch <- log:
+			case <-sub.stop:
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func (c *ReplayClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = c.replay("EstimateGas", []any{call}, &gas)
+	return
+}
+
+func (c *ReplayClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = c.replay("SuggestGasPrice", nil, &price)
+	return
+}
+
+func (c *ReplayClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	err = c.replay("SuggestGasTipCap", nil, &tipCap)
+	return
+}
+
+func (c *ReplayClient) LatestBlockHeight(ctx context.Context) (height *big.Int, err error) {
+	err = c.replay("LatestBlockHeight", nil, &height)
+	return
+}
+
+func (c *ReplayClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
+	err = c.replay("FeeHistory", []any{blockCount, rewardPercentiles}, &feeHistory)
+	return
+}
+
+func (c *ReplayClient) HeaderByNumber(ctx context.Context, n *big.Int) (header *types.Header, err error) {
+	err = c.replay("HeaderByNumber", []any{n}, &header)
+	return
+}
+
+func (c *ReplayClient) HeaderByHash(ctx context.Context, h common.Hash) (header *types.Header, err error) {
+	err = c.replay("HeaderByHash", []any{h}, &header)
+	return
+}
+
+func (c *ReplayClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var res hexutil.Bytes
+	err := c.replay("CallContract", []any{msg, blockNumber}, &res)
+	return res, err
+}
+
+func (c *ReplayClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	var res hexutil.Bytes
+	err := c.replay("PendingCallContract", []any{msg}, &res)
+	return res, err
+}
+
+func (c *ReplayClient) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *SendError {
+	if err := c.replay("CheckTxValidity", []any{from, to, hexutil.Bytes(data)}, nil); err != nil {
+		return NewSendError(err)
+	}
+	return nil
+}
+
+type replaySubscription struct {
+	errCh    chan error
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newReplaySubscription() *replaySubscription {
+	return &replaySubscription{errCh: make(chan error), stop: make(chan struct{})}
+}
+
+func (s *replaySubscription) Unsubscribe() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+func (s *replaySubscription) Err() <-chan error {
+	return s.errCh
+}
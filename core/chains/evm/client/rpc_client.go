@@ -98,6 +98,7 @@ type RPCClient struct {
 	newHeadsPollInterval       time.Duration
 	rpcTimeout                 time.Duration
 	chainType                  chaintype.ChainType
+	rateLimiter                *rpcRateLimiter
 
 	ws   *rawclient
 	http *rawclient
@@ -164,6 +165,7 @@ func NewRPCClient(
 		"evmChainID", chainID,
 	)
 	r.rpcLog = logger.Sugared(lggr).Named("RPC")
+	r.rateLimiter = newRPCRateLimiter(cfg.RateLimit(), r.rpcLog, chainID.String(), name)
 	r.subs = map[ethereum.Subscription]struct{}{}
 
 	return r
@@ -345,6 +347,9 @@ func (r *RPCClient) registerSub(sub ethereum.Subscription, stopInFLightCh chan s
 
 // CallContext implementation
 func (r *RPCClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := r.rateLimiter.wait(ctx, method); err != nil {
+		return err
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With(
@@ -388,6 +393,14 @@ func (r *RPCClient) BatchCallContext(rootCtx context.Context, b []rpc.BatchElem)
 		}
 	}
 
+	methods := make([]string, len(b))
+	for i, el := range b {
+		methods[i] = el.Method
+	}
+	if err := r.rateLimiter.wait(rootCtx, methods...); err != nil {
+		return err
+	}
+
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(rootCtx, r.largePayloadRPCTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("nBatchElems", len(b), "batchElems", b)
@@ -538,6 +551,9 @@ func (r *RPCClient) TransactionReceipt(ctx context.Context, txHash common.Hash)
 }
 
 func (r *RPCClient) TransactionReceiptGeth(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getTransactionReceipt"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("txHash", txHash)
@@ -561,6 +577,9 @@ func (r *RPCClient) TransactionReceiptGeth(ctx context.Context, txHash common.Ha
 	return
 }
 func (r *RPCClient) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *types.Transaction, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getTransactionByHash"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("txHash", txHash)
@@ -585,6 +604,9 @@ func (r *RPCClient) TransactionByHash(ctx context.Context, txHash common.Hash) (
 }
 
 func (r *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("number", number)
@@ -606,6 +628,9 @@ func (r *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (header
 }
 
 func (r *RPCClient) HeaderByHash(ctx context.Context, hash common.Hash) (header *types.Header, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBlockByHash"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("hash", hash)
@@ -709,6 +734,9 @@ func (r *RPCClient) BlockByNumber(ctx context.Context, number *big.Int) (head *e
 }
 
 func (r *RPCClient) ethGetBlockByNumber(ctx context.Context, number string, result interface{}) (err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	const method = "eth_getBlockByNumber"
@@ -745,6 +773,9 @@ func (r *RPCClient) BlockByHash(ctx context.Context, hash common.Hash) (head *ev
 }
 
 func (r *RPCClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (block *types.Block, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBlockByHash"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("hash", hash)
@@ -768,6 +799,9 @@ func (r *RPCClient) BlockByHashGeth(ctx context.Context, hash common.Hash) (bloc
 }
 
 func (r *RPCClient) BlockByNumberGeth(ctx context.Context, number *big.Int) (block *types.Block, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBlockByNumber"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("number", number)
@@ -829,6 +863,9 @@ func (r *RPCClient) SendEmptyTransaction(
 
 // PendingSequenceAt returns one higher than the highest nonce from both mempool and mined transactions
 func (r *RPCClient) PendingSequenceAt(ctx context.Context, account common.Address) (nonce evmtypes.Nonce, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getTransactionCount"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("account", account)
@@ -858,6 +895,9 @@ func (r *RPCClient) PendingSequenceAt(ctx context.Context, account common.Addres
 // mined nonce at the given block number, but it actually returns the total
 // transaction count which is the highest mined nonce + 1
 func (r *RPCClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getTransactionCount"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
@@ -881,6 +921,9 @@ func (r *RPCClient) NonceAt(ctx context.Context, account common.Address, blockNu
 }
 
 func (r *RPCClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getCode"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("account", account)
@@ -904,6 +947,9 @@ func (r *RPCClient) PendingCodeAt(ctx context.Context, account common.Address) (
 }
 
 func (r *RPCClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getCode"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("account", account, "blockNumber", blockNumber)
@@ -927,6 +973,9 @@ func (r *RPCClient) CodeAt(ctx context.Context, account common.Address, blockNum
 }
 
 func (r *RPCClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_estimateGas"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
 	call := c.(ethereum.CallMsg)
@@ -951,6 +1000,9 @@ func (r *RPCClient) EstimateGas(ctx context.Context, c interface{}) (gas uint64,
 }
 
 func (r *RPCClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_gasPrice"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr()
@@ -974,6 +1026,9 @@ func (r *RPCClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err er
 }
 
 func (r *RPCClient) CallContract(ctx context.Context, msg interface{}, blockNumber *big.Int) (val []byte, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_call"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("callMsg", msg, "blockNumber", blockNumber)
@@ -1002,6 +1057,9 @@ func (r *RPCClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 }
 
 func (r *RPCClient) PendingCallContract(ctx context.Context, msg interface{}) (val []byte, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_call"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("callMsg", msg)
@@ -1036,6 +1094,9 @@ func (r *RPCClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
 }
 
 func (r *RPCClient) BlockNumber(ctx context.Context) (height uint64, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_blockNumber"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr()
@@ -1059,6 +1120,9 @@ func (r *RPCClient) BlockNumber(ctx context.Context) (height uint64, err error)
 }
 
 func (r *RPCClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getBalance"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("account", account.Hex(), "blockNumber", blockNumber)
@@ -1082,6 +1146,9 @@ func (r *RPCClient) BalanceAt(ctx context.Context, account common.Address, block
 }
 
 func (r *RPCClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_feeHistory"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("blockCount", blockCount, "rewardPercentiles", rewardPercentiles)
@@ -1147,6 +1214,9 @@ func (r *RPCClient) FilterEvents(ctx context.Context, q ethereum.FilterQuery) ([
 }
 
 func (r *RPCClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_getLogs"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr().With("q", q)
@@ -1204,6 +1274,9 @@ func (r *RPCClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 }
 
 func (r *RPCClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_maxPriorityFeePerGas"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr()
@@ -1229,6 +1302,9 @@ func (r *RPCClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err
 // Returns the ChainID according to the geth client. This is useful for functions like verify()
 // the common node.
 func (r *RPCClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	if err = r.rateLimiter.wait(ctx, "eth_chainId"); err != nil {
+		return
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 
 	defer cancel()
@@ -1328,6 +1404,9 @@ func (r *RPCClient) makeQueryCtx(ctx context.Context, timeout time.Duration) (co
 }
 
 func (r *RPCClient) IsSyncing(ctx context.Context) (bool, error) {
+	if err := r.rateLimiter.wait(ctx, "eth_syncing"); err != nil {
+		return false, err
+	}
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.rpcTimeout)
 	defer cancel()
 	lggr := r.newRqLggr()
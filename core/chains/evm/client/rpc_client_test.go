@@ -661,3 +661,53 @@ func TestAstarCustomFinality(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	chainId := big.NewInt(123456)
+	nodePoolCfg := client.TestNodePoolConfig{
+		NodeFinalizedBlockPollInterval: 1 * time.Second,
+		NodeRateLimit:                  client.TestRPCRateLimit{OtherPerSecondVal: 1, MonthlyRequestBudgetVal: 1},
+	}
+	txHash := testutils.NewHash()
+	wsURL := testutils.NewWSServer(t, chainId, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "eth_getBalance":
+			resp.Result = `"0x1"`
+		case "eth_sendRawTransaction":
+			resp.Result = fmt.Sprintf(`"%s"`, txHash.Hex())
+		default:
+			assert.Fail(t, fmt.Sprintf("unexpected method: %s", method))
+		}
+		return
+	}).WSURL()
+
+	rpcClient := client.NewRPCClient(nodePoolCfg, logger.Test(t), wsURL, nil, "rpc", 1, chainId, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	defer rpcClient.Close()
+	ctx := tests.Context(t)
+	require.NoError(t, rpcClient.Dial(ctx))
+
+	var balance string
+	require.NoError(t, rpcClient.CallContext(ctx, &balance, "eth_getBalance", testutils.NewAddress(), "latest"))
+	require.ErrorIs(t, rpcClient.CallContext(ctx, &balance, "eth_getBalance", testutils.NewAddress(), "latest"), client.ErrRPCRequestBudgetExhausted)
+
+	t.Run("sends transactions once the budget is exhausted", func(t *testing.T) {
+		var hash string
+		require.NoError(t, rpcClient.CallContext(ctx, &hash, "eth_sendRawTransaction", "0x01"))
+		assert.Equal(t, txHash.Hex(), hash)
+	})
+
+	t.Run("sends batched transactions once the budget is exhausted", func(t *testing.T) {
+		batch := make([]rpc.BatchElem, 3)
+		hashes := make([]string, len(batch))
+		for i := range batch {
+			batch[i] = rpc.BatchElem{Method: "eth_sendRawTransaction", Args: []interface{}{"0x01"}, Result: &hashes[i]}
+		}
+		require.NoError(t, rpcClient.BatchCallContext(ctx, batch))
+		for i := range batch {
+			require.NoError(t, batch[i].Error)
+			assert.Equal(t, txHash.Hex(), hashes[i])
+		}
+	})
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+// RPC method classes, which are rate limited separately
+const (
+	rpcMethodClassGetLogs = "GetLogs"
+	rpcMethodClassCall    = "Call"
+	rpcMethodClassOther   = "Other"
+	// rpcMethodClassSend is never rate limited, and does not count against the request budget
+	rpcMethodClassSend = "Send"
+)
+
+// ErrRPCRequestBudgetExhausted is returned for requests to a node which has used its monthly request budget.
+var ErrRPCRequestBudgetExhausted = errors.New("monthly RPC request budget exhausted")
+
+var (
+	promEVMPoolRPCNodeThrottledRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_throttled_requests",
+		Help: "The number of requests to the given RPC node which were delayed by its rate limit",
+	}, []string{"evmChainID", "nodeName", "methodClass"})
+	promEVMPoolRPCNodeThrottledSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_throttled_seconds",
+		Help: "The total time requests to the given RPC node were delayed by its rate limit",
+	}, []string{"evmChainID", "nodeName", "methodClass"})
+	promEVMPoolRPCNodeBudgetUsed = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_budget_used",
+		Help: "The number of requests sent to the given RPC node in the current month, if it has a monthly request budget",
+	}, []string{"evmChainID", "nodeName"})
+	promEVMPoolRPCNodeBudgetRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_budget_rejected_requests",
+		Help: "The number of requests to the given RPC node which were rejected because its monthly request budget was exhausted",
+	}, []string{"evmChainID", "nodeName"})
+)
+
+// rpcMethodClass returns the class of the RPC method for the purpose of rate limiting.
+func rpcMethodClass(method string) string {
+	switch method {
+	case "eth_getLogs":
+		return rpcMethodClassGetLogs
+	case "eth_call", "eth_estimateGas":
+		return rpcMethodClassCall
+	case "eth_sendRawTransaction", "eth_sendTransaction":
+		return rpcMethodClassSend
+	default:
+		return rpcMethodClassOther
+	}
+}
+
+type rpcRateLimiterContextKey struct{}
+
+// CtxAddRequestBudgetExemptFlag returns a ctx whose requests do not count against the monthly request budget of the
+// RPC nodes, and are not rejected once it is exhausted. It is used by the transaction manager, so that transactions
+// already sent can still be tracked until they are confirmed.
+func CtxAddRequestBudgetExemptFlag(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rpcRateLimiterContextKey{}, struct{}{})
+}
+
+func ctxIsRequestBudgetExempt(ctx context.Context) bool {
+	return ctx.Value(rpcRateLimiterContextKey{}) != nil
+}
+
+// rpcRateLimiter applies the token bucket rate limit of each method class, and the monthly request budget, of a
+// single RPC node. Callers wait for the rate limit, so that e.g. log backfills slow down instead of failing.
+// Health check requests are neither rate limited nor counted against the request budget, so that throttling does not
+// mark the node as unreachable. Transactions are always sent, and a batch which sends a transaction is exempt as a
+// whole. The requests of the transaction manager are also exempt from the request budget.
+//
+// The budget used is only counted in memory, for the lifetime of the process: it restarts from 0 when the node is
+// restarted, so the requests sent over a month may exceed the budget if the node restarts within the month.
+type rpcRateLimiter struct {
+	lggr       logger.SugaredLogger
+	chainID    string
+	nodeName   string
+	limiters   map[string]*rate.Limiter // by method class, unlimited if missing
+	budget     uint64
+	now        func() time.Time
+	budgetMu   sync.Mutex // protects the fields below
+	budgetFrom time.Time  // start of the current budget period
+	budgetUsed uint64     // since budgetFrom, or since the process started if later
+}
+
+func newRPCRateLimiter(cfg config.RPCRateLimit, lggr logger.SugaredLogger, chainID string, nodeName string) *rpcRateLimiter {
+	l := &rpcRateLimiter{
+		lggr:     lggr,
+		chainID:  chainID,
+		nodeName: nodeName,
+		limiters: make(map[string]*rate.Limiter),
+		budget:   cfg.MonthlyRequestBudget(),
+		now:      time.Now,
+	}
+	for class, perSecond := range map[string]uint32{
+		rpcMethodClassGetLogs: cfg.GetLogsPerSecond(),
+		rpcMethodClassCall:    cfg.CallPerSecond(),
+		rpcMethodClassOther:   cfg.OtherPerSecond(),
+	} {
+		if perSecond > 0 {
+			l.limiters[class] = rate.NewLimiter(rate.Limit(perSecond), int(perSecond))
+		}
+	}
+	return l
+}
+
+// wait blocks until a request, or a batch of requests, for methods may be sent to the node, or returns an error if
+// the ctx is done first, or the request budget is exhausted. The budget is only spent once the rate limit wait is
+// over.
+func (l *rpcRateLimiter) wait(ctx context.Context, methods ...string) error {
+	for _, method := range methods {
+		if rpcMethodClass(method) == rpcMethodClassSend {
+			return nil
+		}
+	}
+	if commonclient.CtxIsHeathCheckRequest(ctx) {
+		return nil
+	}
+	for _, method := range methods {
+		if err := l.waitClass(ctx, rpcMethodClass(method)); err != nil {
+			return err
+		}
+	}
+	if ctxIsRequestBudgetExempt(ctx) {
+		return nil
+	}
+	return l.spendBudget(uint64(len(methods)))
+}
+
+func (l *rpcRateLimiter) waitClass(ctx context.Context, class string) error {
+	limiter, ok := l.limiters[class]
+	if !ok {
+		return nil
+	}
+	reservation := limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+	promEVMPoolRPCNodeThrottledRequests.WithLabelValues(l.chainID, l.nodeName, class).Inc()
+	promEVMPoolRPCNodeThrottledSeconds.WithLabelValues(l.chainID, l.nodeName, class).Add(delay.Seconds())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// spendBudget counts n requests against the budget, or none of them if they do not all fit in it.
+func (l *rpcRateLimiter) spendBudget(n uint64) error {
+	if l.budget == 0 {
+		return nil
+	}
+	l.budgetMu.Lock()
+	defer l.budgetMu.Unlock()
+	now := l.now().UTC()
+	if periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC); !periodStart.Equal(l.budgetFrom) {
+		l.budgetFrom = periodStart
+		l.budgetUsed = 0
+	}
+	if l.budgetUsed+n > l.budget {
+		promEVMPoolRPCNodeBudgetRejected.WithLabelValues(l.chainID, l.nodeName).Add(float64(n))
+		return ErrRPCRequestBudgetExhausted
+	}
+	l.budgetUsed += n
+	promEVMPoolRPCNodeBudgetUsed.WithLabelValues(l.chainID, l.nodeName).Set(float64(l.budgetUsed))
+	if l.budgetUsed == l.budget {
+		l.lggr.Criticalw("Monthly RPC request budget exhausted, requests other than health checks, sending transactions and tracking them are rejected until the next month",
+			"budget", l.budget)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+func TestRPCMethodClass(t *testing.T) {
+	assert.Equal(t, rpcMethodClassGetLogs, rpcMethodClass("eth_getLogs"))
+	assert.Equal(t, rpcMethodClassCall, rpcMethodClass("eth_call"))
+	assert.Equal(t, rpcMethodClassCall, rpcMethodClass("eth_estimateGas"))
+	assert.Equal(t, rpcMethodClassOther, rpcMethodClass("eth_getBlockByNumber"))
+	assert.Equal(t, rpcMethodClassSend, rpcMethodClass("eth_sendRawTransaction"))
+}
+
+func TestRPCRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	newLimiter := func(t *testing.T, cfg TestRPCRateLimit) *rpcRateLimiter {
+		return newRPCRateLimiter(cfg, logger.Sugared(logger.Test(t)), "1", "node")
+	}
+
+	t.Run("does not limit if disabled", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{})
+		ctx := tests.Context(t)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, l.wait(ctx, "eth_getLogs"))
+		}
+	})
+
+	t.Run("limits each method class separately", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{GetLogsPerSecondVal: 1, CallPerSecondVal: 1})
+		ctx := tests.Context(t)
+		require.NoError(t, l.wait(ctx, "eth_getLogs"))
+		require.NoError(t, l.wait(ctx, "eth_call"))
+		require.NoError(t, l.wait(ctx, "eth_blockNumber"))
+
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, l.wait(ctx, "eth_getLogs"), context.DeadlineExceeded)
+	})
+
+	t.Run("waits for the rate limit", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{OtherPerSecondVal: 10})
+		ctx := tests.Context(t)
+		start := time.Now()
+		for i := 0; i < 12; i++ {
+			require.NoError(t, l.wait(ctx, "eth_getBalance"))
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("does not limit health checks", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{OtherPerSecondVal: 1})
+		ctx := commonclient.CtxAddHealthCheckFlag(tests.Context(t))
+		for i := 0; i < 10; i++ {
+			require.NoError(t, l.wait(ctx, "web3_clientVersion"))
+		}
+	})
+
+	t.Run("rejects requests once the monthly budget is exhausted", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{MonthlyRequestBudgetVal: 2})
+		now := time.Date(2024, time.March, 31, 23, 59, 0, 0, time.UTC)
+		l.now = func() time.Time { return now }
+		ctx := tests.Context(t)
+
+		require.NoError(t, l.wait(ctx, "eth_getLogs"))
+		require.NoError(t, l.wait(ctx, "eth_getBalance"))
+		require.ErrorIs(t, l.wait(ctx, "eth_call"), ErrRPCRequestBudgetExhausted)
+
+		now = now.Add(time.Minute)
+		require.ErrorIs(t, l.wait(ctx, "eth_call", "eth_call", "eth_call"), ErrRPCRequestBudgetExhausted)
+		require.NoError(t, l.wait(ctx, "eth_call", "eth_call"))
+		require.ErrorIs(t, l.wait(ctx, "eth_call"), ErrRPCRequestBudgetExhausted)
+	})
+
+	t.Run("does not count health checks and transaction manager requests against the budget", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{MonthlyRequestBudgetVal: 1})
+		ctx := tests.Context(t)
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, waitHealthCheck(ctx, l))
+			require.NoError(t, l.wait(CtxAddRequestBudgetExemptFlag(ctx), "eth_getTransactionCount"))
+		}
+		require.NoError(t, l.wait(ctx, "eth_getBalance"))
+		require.ErrorIs(t, l.wait(ctx, "eth_getBalance"), ErrRPCRequestBudgetExhausted)
+		require.NoError(t, waitHealthCheck(ctx, l))
+		require.NoError(t, l.wait(CtxAddRequestBudgetExemptFlag(ctx), "eth_getTransactionReceipt", "eth_getTransactionReceipt"))
+	})
+
+	t.Run("spends the budget once the rate limit wait is over", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{OtherPerSecondVal: 1, MonthlyRequestBudgetVal: 2})
+		ctx := tests.Context(t)
+		require.NoError(t, l.wait(ctx, "eth_getBalance"))
+
+		waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, l.wait(waitCtx, "eth_getBalance"), context.DeadlineExceeded)
+		require.NoError(t, l.wait(ctx, "eth_getBalance"))
+	})
+
+	t.Run("never limits sending transactions", func(t *testing.T) {
+		l := newLimiter(t, TestRPCRateLimit{OtherPerSecondVal: 1, MonthlyRequestBudgetVal: 1})
+		ctx := tests.Context(t)
+
+		require.NoError(t, l.wait(ctx, "eth_getBalance"))
+		require.ErrorIs(t, l.wait(ctx, "eth_getBalance"), ErrRPCRequestBudgetExhausted)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, l.wait(ctx, "eth_sendRawTransaction"))
+			require.NoError(t, l.wait(ctx, "eth_getTransactionCount", "eth_sendRawTransaction"))
+		}
+	})
+}
+
+func waitHealthCheck(ctx context.Context, l *rpcRateLimiter) error {
+	return l.wait(commonclient.CtxAddHealthCheckFlag(ctx), "web3_clientVersion")
+}
@@ -184,6 +184,10 @@ func (e *EVMConfig) LogPrunePageSize() uint32 {
 	return *e.C.LogPrunePageSize
 }
 
+func (e *EVMConfig) LogCompactAfter() time.Duration {
+	return e.C.LogCompactAfter.Duration()
+}
+
 func (e *EVMConfig) FinalizedBlockOffset() uint32 {
 	return *e.C.FinalizedBlockOffset
 }
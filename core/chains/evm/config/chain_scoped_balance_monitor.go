@@ -1,6 +1,11 @@
 package config
 
-import "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
@@ -9,3 +14,38 @@ type balanceMonitorConfig struct {
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) AlertThreshold() *assets.Wei {
+	return b.c.AlertThreshold
+}
+
+func (b *balanceMonitorConfig) Funding() FundingConfig {
+	return &fundingConfig{c: b.c.Funding}
+}
+
+type fundingConfig struct {
+	c toml.FundingConfig
+}
+
+func (f *fundingConfig) Enabled() bool {
+	return f.c.Enabled != nil && *f.c.Enabled
+}
+
+func (f *fundingConfig) TreasuryAddress() common.Address {
+	if f.c.TreasuryAddress == nil {
+		return common.Address{}
+	}
+	return f.c.TreasuryAddress.Address()
+}
+
+func (f *fundingConfig) MinBalance() *assets.Wei {
+	return f.c.MinBalance
+}
+
+func (f *fundingConfig) TargetBalance() *assets.Wei {
+	return f.c.TargetBalance
+}
+
+func (f *fundingConfig) DailySpendCap() *assets.Wei {
+	return f.c.DailySpendCap
+}
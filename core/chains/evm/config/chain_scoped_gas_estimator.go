@@ -43,6 +43,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Mempool() Mempool {
+	return &mempoolConfig{c: g.c.Mempool}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -216,3 +220,19 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type mempoolConfig struct {
+	c toml.MempoolEstimator
+}
+
+func (m *mempoolConfig) TargetBlocks() uint16 {
+	return *m.c.TargetBlocks
+}
+
+func (m *mempoolConfig) Percentile() uint16 {
+	return *m.c.Percentile
+}
+
+func (m *mempoolConfig) TxPoolInterval() time.Duration {
+	return m.c.TxPoolInterval.Duration()
+}
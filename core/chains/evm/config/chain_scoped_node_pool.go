@@ -51,3 +51,33 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) ReadMode() string {
+	return *n.C.ReadMode
+}
+
+func (n *NodePoolConfig) ReadQuorum() uint32 {
+	return *n.C.ReadQuorum
+}
+
+func (n *NodePoolConfig) RateLimit() RPCRateLimit { return &rpcRateLimitConfig{c: n.C.RateLimit} }
+
+type rpcRateLimitConfig struct {
+	c toml.RPCRateLimit
+}
+
+func (r *rpcRateLimitConfig) GetLogsPerSecond() uint32 {
+	return *r.c.GetLogsPerSecond
+}
+
+func (r *rpcRateLimitConfig) CallPerSecond() uint32 {
+	return *r.c.CallPerSecond
+}
+
+func (r *rpcRateLimitConfig) OtherPerSecond() uint32 {
+	return *r.c.OtherPerSecond
+}
+
+func (r *rpcRateLimitConfig) MonthlyRequestBudget() uint64 {
+	return *r.c.MonthlyRequestBudget
+}
@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -39,6 +41,10 @@ func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) UserOperations() UserOperationsConfig {
+	return &userOperationsConfig{c: t.c.UserOperations}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +64,33 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type userOperationsConfig struct {
+	c toml.UserOperationsConfig
+}
+
+func (u *userOperationsConfig) Enabled() bool {
+	return u.c.Enabled != nil && *u.c.Enabled
+}
+
+func (u *userOperationsConfig) BundlerURL() *url.URL {
+	return u.c.BundlerURL.URL()
+}
+
+func (u *userOperationsConfig) EntryPoint() common.Address {
+	if u.c.EntryPoint == nil {
+		return common.Address{}
+	}
+	return u.c.EntryPoint.Address()
+}
+
+func (u *userOperationsConfig) Account() common.Address {
+	if u.c.Account == nil {
+		return common.Address{}
+	}
+	return u.c.Account.Address()
+}
+
+func (u *userOperationsConfig) PaymasterURL() *url.URL {
+	return u.c.PaymasterURL.URL()
+}
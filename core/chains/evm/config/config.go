@@ -40,6 +40,7 @@ type EVM interface {
 	BackupLogPollerBlockDelay() uint64
 	LogPollInterval() time.Duration
 	LogPrunePageSize() uint32
+	LogCompactAfter() time.Duration
 	MinContractPayment() *commonassets.Link
 	MinIncomingConfirmations() uint32
 	NonceAutoSync() bool
@@ -82,6 +83,16 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	AlertThreshold() *assets.Wei
+	Funding() FundingConfig
+}
+
+type FundingConfig interface {
+	Enabled() bool
+	TreasuryAddress() gethcommon.Address
+	MinBalance() *assets.Wei
+	TargetBalance() *assets.Wei
+	DailySpendCap() *assets.Wei
 }
 
 type ClientErrors interface {
@@ -110,6 +121,7 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	UserOperations() UserOperationsConfig
 }
 
 type AutoPurgeConfig interface {
@@ -119,9 +131,18 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type UserOperationsConfig interface {
+	Enabled() bool
+	BundlerURL() *url.URL
+	EntryPoint() gethcommon.Address
+	Account() gethcommon.Address
+	PaymasterURL() *url.URL
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Mempool() Mempool
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -174,6 +195,12 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type Mempool interface {
+	TargetBlocks() uint16
+	Percentile() uint16
+	TxPoolInterval() time.Duration
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -192,6 +219,16 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	ReadMode() string
+	ReadQuorum() uint32
+	RateLimit() RPCRateLimit
+}
+
+type RPCRateLimit interface {
+	GetLogsPerSecond() uint32
+	CallPerSecond() uint32
+	OtherPerSecond() uint32
+	MonthlyRequestBudget() uint64
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
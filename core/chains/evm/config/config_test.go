@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
@@ -247,6 +250,16 @@ func TestChainScopedConfig_FeeHistory(t *testing.T) {
 	assert.Equal(t, 10*time.Second, u.CacheTimeout())
 }
 
+func TestChainScopedConfig_Mempool(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	m := cfg.EVM().GasEstimator().Mempool()
+	assert.Equal(t, uint16(2), m.TargetBlocks())
+	assert.Equal(t, uint16(60), m.Percentile())
+	assert.Equal(t, time.Minute, m.TxPoolInterval())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
@@ -346,6 +359,71 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.Equal(t, true, ht.PersistenceEnabled())
 }
 
+func TestChainScopedConfig_BalanceMonitorFunding(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+		f := cfg.EVM().BalanceMonitor().Funding()
+		assert.False(t, f.Enabled())
+		assert.Equal(t, common.Address{}, f.TreasuryAddress())
+		assert.Nil(t, f.MinBalance())
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		treasury := testutils.NewAddress()
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			treasuryEIP55 := types.EIP55AddressFromAddress(treasury)
+			c.BalanceMonitor.Funding = toml.FundingConfig{
+				Enabled:         ptr(true),
+				TreasuryAddress: &treasuryEIP55,
+				MinBalance:      assets.NewWeiI(100),
+				TargetBalance:   assets.NewWeiI(500),
+				DailySpendCap:   assets.NewWeiI(1000),
+			}
+		})
+
+		f := cfg.EVM().BalanceMonitor().Funding()
+		assert.True(t, f.Enabled())
+		assert.Equal(t, treasury, f.TreasuryAddress())
+		assert.Equal(t, assets.NewWeiI(100), f.MinBalance())
+		assert.Equal(t, assets.NewWeiI(500), f.TargetBalance())
+		assert.Equal(t, assets.NewWeiI(1000), f.DailySpendCap())
+	})
+}
+
+func TestChainScopedConfig_UserOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+		u := cfg.EVM().Transactions().UserOperations()
+		assert.False(t, u.Enabled())
+		assert.Equal(t, common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032"), u.EntryPoint())
+		assert.Equal(t, common.Address{}, u.Account())
+		assert.Nil(t, u.PaymasterURL())
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		account := testutils.NewAddress()
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			accountEIP55 := types.EIP55AddressFromAddress(account)
+			c.Transactions.UserOperations.Enabled = ptr(true)
+			c.Transactions.UserOperations.BundlerURL = commonconfig.MustParseURL("https://bundler.example.io")
+			c.Transactions.UserOperations.Account = &accountEIP55
+			c.Transactions.UserOperations.PaymasterURL = commonconfig.MustParseURL("https://paymaster.example.io")
+		})
+
+		u := cfg.EVM().Transactions().UserOperations()
+		assert.True(t, u.Enabled())
+		assert.Equal(t, "https://bundler.example.io", u.BundlerURL().String())
+		assert.Equal(t, account, u.Account())
+		assert.Equal(t, "https://paymaster.example.io", u.PaymasterURL().String())
+	})
+}
+
 func TestNodePoolConfig(t *testing.T) {
 	cfg := testutils.NewTestChainScopedConfig(t, nil)
 
@@ -356,6 +434,10 @@ func TestNodePoolConfig(t *testing.T) {
 	require.Equal(t, false, cfg.EVM().NodePool().NodeIsSyncingEnabled())
 	require.Equal(t, false, cfg.EVM().NodePool().EnforceRepeatableRead())
 	require.Equal(t, time.Duration(10000000000), cfg.EVM().NodePool().DeathDeclarationDelay())
+	require.Equal(t, uint32(0), cfg.EVM().NodePool().RateLimit().GetLogsPerSecond())
+	require.Equal(t, uint32(0), cfg.EVM().NodePool().RateLimit().CallPerSecond())
+	require.Equal(t, uint32(0), cfg.EVM().NodePool().RateLimit().OtherPerSecond())
+	require.Equal(t, uint64(0), cfg.EVM().NodePool().RateLimit().MonthlyRequestBudget())
 }
 
 func TestClientErrorsConfig(t *testing.T) {
@@ -711,6 +711,53 @@ func (_c *GasEstimator_LimitTransfer_Call) RunAndReturn(run func() uint64) *GasE
 	return _c
 }
 
+// Mempool provides a mock function with given fields:
+func (_m *GasEstimator) Mempool() config.Mempool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mempool")
+	}
+
+	var r0 config.Mempool
+	if rf, ok := ret.Get(0).(func() config.Mempool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Mempool)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_Mempool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mempool'
+type GasEstimator_Mempool_Call struct {
+	*mock.Call
+}
+
+// Mempool is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) Mempool() *GasEstimator_Mempool_Call {
+	return &GasEstimator_Mempool_Call{Call: _e.mock.On("Mempool")}
+}
+
+func (_c *GasEstimator_Mempool_Call) Run(run func()) *GasEstimator_Mempool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_Mempool_Call) Return(_a0 config.Mempool) *GasEstimator_Mempool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_Mempool_Call) RunAndReturn(run func() config.Mempool) *GasEstimator_Mempool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mode provides a mock function with given fields:
 func (_m *GasEstimator) Mode() string {
 	ret := _m.Called()
@@ -312,7 +312,7 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 	if len(c.Nodes) == 0 {
 		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes", Msg: "must have at least one node"})
 	} else {
-		var hasPrimary bool
+		var primaries uint32
 		var logBroadcasterEnabled bool
 		var newHeadsPollingInterval commonconfig.Duration
 		if c.LogBroadcasterEnabled != nil {
@@ -328,7 +328,7 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 				continue
 			}
 
-			hasPrimary = true
+			primaries++
 
 			// if the node is a primary node, then the WS URL is required when
 			//	1. LogBroadcaster is enabled
@@ -342,9 +342,13 @@ func (c *EVMConfig) ValidateConfig() (err error) {
 			}
 		}
 
-		if !hasPrimary {
+		if primaries == 0 {
 			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Nodes",
 				Msg: "must have at least one primary node"})
+		} else if c.NodePool.ReadMode != nil && *c.NodePool.ReadMode == "Quorum" &&
+			c.NodePool.ReadQuorum != nil && *c.NodePool.ReadQuorum > primaries {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "NodePool.ReadQuorum", Value: *c.NodePool.ReadQuorum,
+				Msg: fmt.Sprintf("must not exceed the number of primary nodes (%d)", primaries)})
 		}
 	}
 
@@ -375,6 +379,7 @@ type Chain struct {
 	LogPollInterval              *commonconfig.Duration
 	LogKeepBlocksDepth           *uint32
 	LogPrunePageSize             *uint32
+	LogCompactAfter              *commonconfig.Duration
 	BackupLogPollerBlockDelay    *uint64
 	MinIncomingConfirmations     *uint32
 	MinContractPayment           *commonassets.Link
@@ -479,7 +484,8 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge      AutoPurgeConfig      `toml:",omitempty"`
+	UserOperations UserOperationsConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -502,6 +508,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.UserOperations.setFrom(&f.UserOperations)
 }
 
 type AutoPurgeConfig struct {
@@ -526,6 +533,55 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type UserOperationsConfig struct {
+	Enabled      *bool
+	BundlerURL   *commonconfig.URL
+	EntryPoint   *types.EIP55Address
+	Account      *types.EIP55Address
+	PaymasterURL *commonconfig.URL
+}
+
+func (u *UserOperationsConfig) setFrom(f *UserOperationsConfig) {
+	if v := f.Enabled; v != nil {
+		u.Enabled = v
+	}
+	if v := f.BundlerURL; v != nil {
+		u.BundlerURL = v
+	}
+	if v := f.EntryPoint; v != nil {
+		u.EntryPoint = v
+	}
+	if v := f.Account; v != nil {
+		u.Account = v
+	}
+	if v := f.PaymasterURL; v != nil {
+		u.PaymasterURL = v
+	}
+}
+
+func (u *UserOperationsConfig) ValidateConfig() (err error) {
+	if u.Enabled == nil || !*u.Enabled {
+		return
+	}
+	if u.BundlerURL == nil || u.BundlerURL.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "BundlerURL", Msg: "must be set if user operations are enabled"})
+	} else if s := u.BundlerURL.Scheme; s != "http" && s != "https" {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BundlerURL", Value: s, Msg: "must be http or https"})
+	}
+	if u.EntryPoint == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "EntryPoint", Msg: "must be set if user operations are enabled"})
+	}
+	if u.Account == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Account", Msg: "must be set if user operations are enabled"})
+	}
+	if u.PaymasterURL != nil && !u.PaymasterURL.IsZero() {
+		if s := u.PaymasterURL.Scheme; s != "http" && s != "https" {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PaymasterURL", Value: s, Msg: "must be http or https"})
+		}
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -564,13 +620,71 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled        *bool
+	AlertThreshold *assets.Wei
+
+	Funding FundingConfig `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.AlertThreshold; v != nil {
+		m.AlertThreshold = v
+	}
+	m.Funding.setFrom(&f.Funding)
+}
+
+type FundingConfig struct {
+	Enabled         *bool
+	TreasuryAddress *types.EIP55Address
+	MinBalance      *assets.Wei
+	TargetBalance   *assets.Wei
+	DailySpendCap   *assets.Wei
+}
+
+func (f *FundingConfig) setFrom(o *FundingConfig) {
+	if v := o.Enabled; v != nil {
+		f.Enabled = v
+	}
+	if v := o.TreasuryAddress; v != nil {
+		f.TreasuryAddress = v
+	}
+	if v := o.MinBalance; v != nil {
+		f.MinBalance = v
+	}
+	if v := o.TargetBalance; v != nil {
+		f.TargetBalance = v
+	}
+	if v := o.DailySpendCap; v != nil {
+		f.DailySpendCap = v
+	}
+}
+
+func (f *FundingConfig) ValidateConfig() (err error) {
+	if f.Enabled == nil || !*f.Enabled {
+		return
+	}
+	if f.TreasuryAddress == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TreasuryAddress", Msg: "must be set if funding is enabled"})
+	}
+	if f.MinBalance == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "MinBalance", Msg: "must be set if funding is enabled"})
+	}
+	if f.TargetBalance == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TargetBalance", Msg: "must be set if funding is enabled"})
+	} else if f.MinBalance != nil && f.TargetBalance.Cmp(f.MinBalance) <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TargetBalance", Value: f.TargetBalance,
+			Msg: "must be greater than MinBalance"})
+	}
+	if f.DailySpendCap == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "DailySpendCap", Msg: "must be set if funding is enabled"})
+	} else if f.DailySpendCap.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DailySpendCap", Value: f.DailySpendCap,
+			Msg: "must be greater than zero"})
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -600,6 +714,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Mempool      MempoolEstimator      `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -637,6 +752,16 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Mempool" {
+		if *e.Mempool.TargetBlocks == 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mempool.TargetBlocks", Value: *e.Mempool.TargetBlocks,
+				Msg: "must be greater than or equal to 1 with Mempool Mode"})
+		}
+		if *e.Mempool.Percentile > 100 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mempool.Percentile", Value: *e.Mempool.Percentile,
+				Msg: "must be in range 0-100"})
+		}
+	}
 
 	return
 }
@@ -696,6 +821,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Mempool.setFrom(&f.Mempool)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -769,6 +895,24 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type MempoolEstimator struct {
+	TargetBlocks   *uint16
+	Percentile     *uint16
+	TxPoolInterval *commonconfig.Duration
+}
+
+func (m *MempoolEstimator) setFrom(f *MempoolEstimator) {
+	if v := f.TargetBlocks; v != nil {
+		m.TargetBlocks = v
+	}
+	if v := f.Percentile; v != nil {
+		m.Percentile = v
+	}
+	if v := f.TxPoolInterval; v != nil {
+		m.TxPoolInterval = v
+	}
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -971,6 +1115,9 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	ReadMode                   *string
+	ReadQuorum                 *uint32
+	RateLimit                  RPCRateLimit
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -1008,7 +1155,16 @@ func (p *NodePool) setFrom(f *NodePool) {
 		p.NewHeadsPollInterval = v
 	}
 
+	if v := f.ReadMode; v != nil {
+		p.ReadMode = v
+	}
+
+	if v := f.ReadQuorum; v != nil {
+		p.ReadQuorum = v
+	}
+
 	p.Errors.setFrom(&f.Errors)
+	p.RateLimit.setFrom(&f.RateLimit)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
@@ -1022,9 +1178,43 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 				Msg: "must be greater than 0"})
 		}
 	}
+	if p.ReadMode != nil {
+		switch *p.ReadMode {
+		case "Single", "Hedged", "Quorum":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadMode", Value: *p.ReadMode,
+				Msg: "must be one of: Single, Hedged, Quorum"})
+		}
+	}
+	if p.ReadQuorum != nil && *p.ReadQuorum == 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ReadQuorum", Value: *p.ReadQuorum,
+			Msg: "must be greater than 0"})
+	}
 	return
 }
 
+type RPCRateLimit struct {
+	GetLogsPerSecond     *uint32
+	CallPerSecond        *uint32
+	OtherPerSecond       *uint32
+	MonthlyRequestBudget *uint64
+}
+
+func (r *RPCRateLimit) setFrom(f *RPCRateLimit) {
+	if v := f.GetLogsPerSecond; v != nil {
+		r.GetLogsPerSecond = v
+	}
+	if v := f.CallPerSecond; v != nil {
+		r.CallPerSecond = v
+	}
+	if v := f.OtherPerSecond; v != nil {
+		r.OtherPerSecond = v
+	}
+	if v := f.MonthlyRequestBudget; v != nil {
+		r.MonthlyRequestBudget = v
+	}
+}
+
 type OCR struct {
 	ContractConfirmations              *uint16
 	ContractTransmitterTransmitTimeout *commonconfig.Duration
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -29,3 +32,46 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestFundingConfig_ValidateConfig(t *testing.T) {
+	treasury := types.EIP55AddressFromAddress(common.HexToAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"))
+	enabled := true
+
+	t.Run("disabled", func(t *testing.T) {
+		f := &toml.FundingConfig{}
+		assert.NoError(t, f.ValidateConfig())
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		f := &toml.FundingConfig{
+			Enabled:         &enabled,
+			TreasuryAddress: &treasury,
+			MinBalance:      assets.NewWeiI(1),
+			TargetBalance:   assets.NewWeiI(2),
+			DailySpendCap:   assets.NewWeiI(10),
+		}
+		assert.NoError(t, f.ValidateConfig())
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		f := &toml.FundingConfig{Enabled: &enabled}
+		err := f.ValidateConfig()
+		assert.ErrorContains(t, err, "TreasuryAddress: missing")
+		assert.ErrorContains(t, err, "MinBalance: missing")
+		assert.ErrorContains(t, err, "TargetBalance: missing")
+		assert.ErrorContains(t, err, "DailySpendCap: missing")
+	})
+
+	t.Run("target not above min", func(t *testing.T) {
+		f := &toml.FundingConfig{
+			Enabled:         &enabled,
+			TreasuryAddress: &treasury,
+			MinBalance:      assets.NewWeiI(2),
+			TargetBalance:   assets.NewWeiI(2),
+			DailySpendCap:   assets.NewWeiI(0),
+		}
+		err := f.ValidateConfig()
+		assert.ErrorContains(t, err, "TargetBalance: invalid value (2 wei): must be greater than MinBalance")
+		assert.ErrorContains(t, err, "DailySpendCap: invalid value (0): must be greater than zero")
+	})
+}
@@ -206,6 +206,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogPrunePageSize; v != nil {
 		c.LogPrunePageSize = v
 	}
+	if v := f.LogCompactAfter; v != nil {
+		c.LogCompactAfter = v
+	}
 	if v := f.BackupLogPollerBlockDelay; v != nil {
 		c.BackupLogPollerBlockDelay = v
 	}
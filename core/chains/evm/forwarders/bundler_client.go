@@ -0,0 +1,97 @@
+package forwarders
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// BundlerClient is the ERC-4337 RPC API of a bundler.
+type BundlerClient interface {
+	SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error)
+	// GetUserOperationReceipts returns the receipts of the given user operations, with a nil receipt for each one
+	// which has not been included yet.
+	GetUserOperationReceipts(ctx context.Context, opHashes []common.Hash) ([]*UserOperationReceipt, error)
+	Close()
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	Success       bool           `json:"success"`
+	Reason        string         `json:"reason"`
+	Receipt       struct {
+		TransactionHash common.Hash  `json:"transactionHash"`
+		BlockNumber     *hexutil.Big `json:"blockNumber"`
+	} `json:"receipt"`
+}
+
+type bundlerClient struct {
+	rpc *rpc.Client
+}
+
+var _ BundlerClient = &bundlerClient{}
+
+// NewBundlerClient returns a client of the bundler at the given URL.
+func NewBundlerClient(ctx context.Context, u *url.URL) (BundlerClient, error) {
+	c, err := rpc.DialContext(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial bundler: %w", err)
+	}
+	return &bundlerClient{rpc: c}, nil
+}
+
+func (c *bundlerClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (opHash common.Hash, err error) {
+	err = c.rpc.CallContext(ctx, &opHash, "eth_sendUserOperation", op, entryPoint)
+	return opHash, err
+}
+
+func (c *bundlerClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error) {
+	var estimate UserOperationGasEstimate
+	if err := c.rpc.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint); err != nil {
+		return nil, err
+	}
+	if estimate.PreVerificationGas == nil || estimate.VerificationGasLimit == nil || estimate.CallGasLimit == nil {
+		return nil, fmt.Errorf("incomplete gas estimate returned by bundler: %+v", estimate)
+	}
+	return &estimate, nil
+}
+
+func (c *bundlerClient) GetUserOperationReceipts(ctx context.Context, opHashes []common.Hash) ([]*UserOperationReceipt, error) {
+	receipts := make([]*UserOperationReceipt, len(opHashes))
+	reqs := make([]rpc.BatchElem, len(opHashes))
+	for i, h := range opHashes {
+		reqs[i] = rpc.BatchElem{Method: "eth_getUserOperationReceipt", Args: []any{h}, Result: &receipts[i]}
+	}
+	if err := c.rpc.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+	for i, req := range reqs {
+		if req.Error != nil {
+			return nil, fmt.Errorf("failed to get receipt of user operation %s: %w", opHashes[i], req.Error)
+		}
+	}
+	return receipts, nil
+}
+
+func (c *bundlerClient) Close() {
+	c.rpc.Close()
+}
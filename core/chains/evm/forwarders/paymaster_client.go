@@ -0,0 +1,90 @@
+package forwarders
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// PaymasterClient is the ERC-7677 RPC API of a paymaster web service.
+type PaymasterClient interface {
+	// GetPaymasterStubData returns the paymaster fields used to estimate the gas of a user operation.
+	GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error)
+	// GetPaymasterData returns the final paymaster fields of a user operation, once all its other fields are set.
+	GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error)
+	Close()
+}
+
+// PaymasterData is the result of pm_getPaymasterStubData and pm_getPaymasterData. The gas limits are only returned
+// with the stub data, and IsFinal is set if the stub data can be sent as is.
+type PaymasterData struct {
+	Paymaster                     common.Address `json:"paymaster"`
+	PaymasterData                 hexutil.Bytes  `json:"paymasterData"`
+	PaymasterVerificationGasLimit *hexutil.Big   `json:"paymasterVerificationGasLimit"`
+	PaymasterPostOpGasLimit       *hexutil.Big   `json:"paymasterPostOpGasLimit"`
+	IsFinal                       bool           `json:"isFinal"`
+}
+
+// apply sets the paymaster fields of op, keeping its gas limits if none were returned.
+func (d *PaymasterData) apply(op *UserOperation) {
+	paymaster, data := d.Paymaster, d.PaymasterData
+	op.Paymaster = &paymaster
+	op.PaymasterData = &data
+	if d.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = d.PaymasterVerificationGasLimit
+	}
+	if d.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = d.PaymasterPostOpGasLimit
+	}
+	zero := (*hexutil.Big)(big.NewInt(0))
+	if op.PaymasterVerificationGasLimit == nil {
+		op.PaymasterVerificationGasLimit = zero
+	}
+	if op.PaymasterPostOpGasLimit == nil {
+		op.PaymasterPostOpGasLimit = zero
+	}
+}
+
+type paymasterClient struct {
+	rpc *rpc.Client
+}
+
+var _ PaymasterClient = &paymasterClient{}
+
+// NewPaymasterClient returns a client of the paymaster web service at the given URL.
+func NewPaymasterClient(ctx context.Context, u *url.URL) (PaymasterClient, error) {
+	c, err := rpc.DialContext(ctx, u.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial paymaster: %w", err)
+	}
+	return &paymasterClient{rpc: c}, nil
+}
+
+func (c *paymasterClient) GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error) {
+	return c.call(ctx, "pm_getPaymasterStubData", op, entryPoint, chainID)
+}
+
+func (c *paymasterClient) GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error) {
+	return c.call(ctx, "pm_getPaymasterData", op, entryPoint, chainID)
+}
+
+func (c *paymasterClient) call(ctx context.Context, method string, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error) {
+	var data PaymasterData
+	// no context is sent, as its content is specific to each paymaster service
+	if err := c.rpc.CallContext(ctx, &data, method, op, entryPoint, (*hexutil.Big)(chainID), map[string]any{}); err != nil {
+		return nil, err
+	}
+	if data.Paymaster == (common.Address{}) {
+		return nil, fmt.Errorf("no paymaster returned by %s", method)
+	}
+	return &data, nil
+}
+
+func (c *paymasterClient) Close() {
+	c.rpc.Close()
+}
@@ -0,0 +1,138 @@
+package forwarders
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// EntryPointV07Address is the address of the canonical ERC-4337 v0.7 EntryPoint contract, deployed on most chains.
+var EntryPointV07Address = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+
+var entryPointABI = evmtypes.MustGetABI(`[{"inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"name":"getNonce","outputs":[{"name":"nonce","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+
+// accountABI has the execute method of the SimpleAccount reference implementation, which most smart accounts implement.
+var accountABI = evmtypes.MustGetABI(`[{"inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
+
+// dummySignature is a well-formed ECDSA signature used to estimate the gas of a user operation before it is signed.
+// The validation of most accounts reverts on malformed signatures, so an empty signature cannot be used.
+var dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+// UserOperation is an ERC-4337 v0.7 user operation, in the unpacked form used by the bundler RPC.
+// Accounts must already be deployed, so the factory fields are never set.
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 *hexutil.Bytes  `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// Hash returns the hash of the user operation signed by the owner of the account, as computed by EntryPoint.getUserOpHash.
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed, err := op.pack()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(crypto.Keccak256(packed), common.LeftPadBytes(entryPoint.Bytes(), 32), common.LeftPadBytes(chainID.Bytes(), 32)), nil
+}
+
+// pack ABI encodes the user operation the way the EntryPoint does before hashing it, with the dynamic fields hashed
+// and the gas limits and fees packed in pairs of uint128.
+func (op *UserOperation) pack() ([]byte, error) {
+	nonce, err := uintBytes("nonce", op.Nonce, 256)
+	if err != nil {
+		return nil, err
+	}
+	preVerificationGas, err := uintBytes("preVerificationGas", op.PreVerificationGas, 256)
+	if err != nil {
+		return nil, err
+	}
+	verificationGasLimit, err := uintBytes("verificationGasLimit", op.VerificationGasLimit, 128)
+	if err != nil {
+		return nil, err
+	}
+	callGasLimit, err := uintBytes("callGasLimit", op.CallGasLimit, 128)
+	if err != nil {
+		return nil, err
+	}
+	maxPriorityFeePerGas, err := uintBytes("maxPriorityFeePerGas", op.MaxPriorityFeePerGas, 128)
+	if err != nil {
+		return nil, err
+	}
+	maxFeePerGas, err := uintBytes("maxFeePerGas", op.MaxFeePerGas, 128)
+	if err != nil {
+		return nil, err
+	}
+	paymasterAndData, err := op.paymasterAndData()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Join([][]byte{
+		common.LeftPadBytes(op.Sender.Bytes(), 32),
+		nonce,
+		crypto.Keccak256(nil), // initCode
+		crypto.Keccak256(op.CallData),
+		append(verificationGasLimit, callGasLimit...),
+		preVerificationGas,
+		append(maxPriorityFeePerGas, maxFeePerGas...),
+		crypto.Keccak256(paymasterAndData),
+	}, nil), nil
+}
+
+func (op *UserOperation) paymasterAndData() ([]byte, error) {
+	if op.Paymaster == nil {
+		return nil, nil
+	}
+	verificationGasLimit, err := uintBytes("paymasterVerificationGasLimit", op.PaymasterVerificationGasLimit, 128)
+	if err != nil {
+		return nil, err
+	}
+	postOpGasLimit, err := uintBytes("paymasterPostOpGasLimit", op.PaymasterPostOpGasLimit, 128)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	if op.PaymasterData != nil {
+		data = *op.PaymasterData
+	}
+	return bytes.Join([][]byte{op.Paymaster.Bytes(), verificationGasLimit, postOpGasLimit, data}, nil), nil
+}
+
+// signUserOperationHash signs the hash of a user operation as an EIP-191 personal message, with a recovery id of 27 or
+// 28 as expected by ecrecover in the signature validation of the account.
+func signUserOperationHash(key ethkey.KeyV2, opHash common.Hash) ([]byte, error) {
+	sig, err := crypto.Sign(accounts.TextHash(opHash.Bytes()), key.ToEcdsaPrivKey())
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// uintBytes returns x as a big endian unsigned integer of the given number of bits.
+func uintBytes(name string, x *hexutil.Big, bits int) ([]byte, error) {
+	if x == nil {
+		return nil, fmt.Errorf("%s of user operation is not set", name)
+	}
+	if i := x.ToInt(); i.Sign() < 0 || i.BitLen() > bits {
+		return nil, fmt.Errorf("%s of user operation must be a uint%d, got %s", name, bits, i)
+	}
+	return common.LeftPadBytes(x.ToInt().Bytes(), bits/8), nil
+}
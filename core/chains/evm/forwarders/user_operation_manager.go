@@ -0,0 +1,422 @@
+package forwarders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// UserOperationPriceBump is the minimum percentage by which both fees of a user operation must be bumped for a
+// bundler to replace it in its mempool.
+const UserOperationPriceBump = 10
+
+type UserOperationsConfig interface {
+	BundlerURL() *url.URL
+	EntryPoint() common.Address
+	Account() common.Address
+	PaymasterURL() *url.URL
+}
+
+type UserOperationsFeeConfig interface {
+	BumpPercent() uint16
+	BumpThreshold() uint64
+	PriceMaxKey(common.Address) *assets.Wei
+}
+
+// UserOperationKeyStore gives access to the keys owning the smart account. They are only used to sign the hashes of
+// user operations, see signUserOperationHash.
+type UserOperationKeyStore interface {
+	Get(ctx context.Context, id string) (ethkey.KeyV2, error)
+}
+
+// UserOpMgr sends transaction requests as ERC-4337 user operations of a smart account through a bundler, so that
+// their fees are paid by the account or a paymaster instead of the sending key, which only signs them as the owner
+// of the account. If a paymaster service is configured, it sponsors the user operations. The user operations are
+// tracked by the Confirmer through CheckUserOperations.
+type UserOpMgr struct {
+	services.Service
+	eng *services.Engine
+
+	ORM       UserOperationORM
+	evmClient evmclient.Client
+	bundler   BundlerClient
+	paymaster PaymasterClient
+	estimator gas.EvmFeeEstimator
+	keyStore  UserOperationKeyStore
+	cfg       UserOperationsConfig
+	feeCfg    UserOperationsFeeConfig
+	logger    logger.SugaredLogger
+
+	// sendMu serializes the assignment of nonces to new user operations.
+	sendMu sync.Mutex
+}
+
+var _ txmgrtypes.UserOperationManager[common.Address, common.Hash] = (*UserOpMgr)(nil)
+
+func NewUserOpMgr(ds sqlutil.DataSource, client evmclient.Client, estimator gas.EvmFeeEstimator, keyStore UserOperationKeyStore, lggr logger.Logger, cfg UserOperationsConfig, feeCfg UserOperationsFeeConfig) *UserOpMgr {
+	m := UserOpMgr{
+		ORM:       NewUserOperationORM(ds),
+		evmClient: client,
+		estimator: estimator,
+		keyStore:  keyStore,
+		cfg:       cfg,
+		feeCfg:    feeCfg,
+	}
+	m.Service, m.eng = services.Config{
+		Name:  "UserOperationManager",
+		Start: m.start,
+		Close: m.close,
+	}.NewServiceEngine(lggr)
+	m.logger = logger.Sugared(m.eng)
+	return &m
+}
+
+func (m *UserOpMgr) start(ctx context.Context) (err error) {
+	m.bundler, err = NewBundlerClient(ctx, m.cfg.BundlerURL())
+	if err != nil {
+		return err
+	}
+	if u := m.cfg.PaymasterURL(); u != nil {
+		m.paymaster, err = NewPaymasterClient(ctx, u)
+	}
+	return err
+}
+
+func (m *UserOpMgr) close() error {
+	if m.bundler != nil {
+		m.bundler.Close()
+	}
+	if m.paymaster != nil {
+		m.paymaster.Close()
+	}
+	return nil
+}
+
+// SendUserOperation sends the request as a call of the smart account to the request's ToAddress, signed by its
+// FromAddress, which must own the account.
+func (m *UserOpMgr) SendUserOperation(ctx context.Context, txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (opHash common.Hash, err error) {
+	chainID := m.evmClient.ConfiguredChainID()
+	if txRequest.IdempotencyKey != nil {
+		existing, ferr := m.ORM.FindUserOperationWithIdempotencyKey(ctx, ubig.Big(*chainID), *txRequest.IdempotencyKey)
+		if ferr != nil {
+			return opHash, fmt.Errorf("failed to search for user operation with IdempotencyKey: %w", ferr)
+		}
+		if existing != nil {
+			m.logger.Infow("Found a user operation with IdempotencyKey. Returning existing user operation without sending a new one.", "IdempotencyKey", *txRequest.IdempotencyKey)
+			return existing.OpHash, nil
+		}
+	}
+
+	callData, err := accountABI.Pack("execute", txRequest.ToAddress, &txRequest.Value, txRequest.EncodedPayload)
+	if err != nil {
+		return opHash, fmt.Errorf("failed to encode account call: %w", err)
+	}
+	zero := (*hexutil.Big)(big.NewInt(0))
+	op := UserOperation{
+		Sender:               m.cfg.Account(),
+		CallData:             callData,
+		CallGasLimit:         zero,
+		VerificationGasLimit: zero,
+		PreVerificationGas:   zero,
+		Signature:            dummySignature,
+	}
+	if err = m.setFees(ctx, &op, txRequest.FromAddress, txRequest.FeeLimit); err != nil {
+		return opHash, err
+	}
+
+	m.sendMu.Lock()
+	defer m.sendMu.Unlock()
+
+	nonce, err := m.nextNonce(ctx)
+	if err != nil {
+		return opHash, err
+	}
+	op.Nonce = (*hexutil.Big)(nonce)
+	entryPoint := m.cfg.EntryPoint()
+	final := true
+	if m.paymaster != nil {
+		stub, perr := m.paymaster.GetPaymasterStubData(ctx, &op, entryPoint, chainID)
+		if perr != nil {
+			return opHash, fmt.Errorf("failed to get paymaster stub data: %w", perr)
+		}
+		stub.apply(&op)
+		final = stub.IsFinal
+	}
+	if err = m.setGasLimits(ctx, &op, txRequest.FeeLimit); err != nil {
+		return opHash, err
+	}
+	if !final {
+		if err = m.setPaymasterData(ctx, &op, entryPoint); err != nil {
+			return opHash, err
+		}
+	}
+	if opHash, err = m.sign(ctx, &op, entryPoint, txRequest.FromAddress); err != nil {
+		return opHash, err
+	}
+
+	sent, err := m.ORM.CreateUserOperation(ctx, ubig.Big(*chainID), opHash, entryPoint, txRequest.FromAddress, op, txRequest)
+	if err != nil {
+		return opHash, fmt.Errorf("failed to save user operation: %w", err)
+	}
+	bundlerOpHash, err := m.bundler.SendUserOperation(ctx, &op, entryPoint)
+	if err != nil {
+		var rpcErr rpc.Error
+		if !errors.As(err, &rpcErr) {
+			// the bundler may have received the user operation, so it is kept to be tracked and resent, and is
+			// returned when the request is retried with the same IdempotencyKey
+			m.logger.Warnw("Failed to send user operation, it will be resent", "opHash", opHash, "err", err)
+			return opHash, fmt.Errorf("failed to send user operation: %w", err)
+		}
+		if derr := m.ORM.DeleteUserOperation(ctx, sent.ID); derr != nil {
+			m.logger.Errorw("Failed to delete rejected user operation, it will be resent", "opHash", opHash, "err", derr)
+		}
+		return opHash, fmt.Errorf("bundler rejected user operation: %w", err)
+	}
+	if bundlerOpHash != opHash {
+		m.logger.Errorw("Bundler returned a different user operation hash, check that EntryPoint matches the bundler", "opHash", opHash, "bundlerOpHash", bundlerOpHash, "entryPoint", entryPoint)
+	}
+	m.logger.Infow("Sent user operation", "opHash", opHash, "sender", op.Sender, "nonce", nonce, "owner", txRequest.FromAddress)
+	return opHash, nil
+}
+
+// CheckUserOperations saves the receipts of the unconfirmed user operations, and resends the ones which were sent
+// more than GasEstimator.BumpThreshold blocks ago with bumped fees.
+func (m *UserOpMgr) CheckUserOperations(ctx context.Context, blockNum int64) error {
+	chainID := ubig.Big(*m.evmClient.ConfiguredChainID())
+	if err := m.ORM.SetSentBeforeBlockNum(ctx, chainID, blockNum); err != nil {
+		return fmt.Errorf("failed to set sent before block num: %w", err)
+	}
+	ops, err := m.ORM.FindUnconfirmedUserOperations(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("failed to find unconfirmed user operations: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	// a user operation resent with higher fees may still be included with any of its previous hashes
+	var opHashes []common.Hash
+	var opIDs []int64
+	for _, op := range ops {
+		for _, h := range op.OpHashes() {
+			opHashes = append(opHashes, h)
+			opIDs = append(opIDs, op.ID)
+		}
+	}
+	receipts, err := m.bundler.GetUserOperationReceipts(ctx, opHashes)
+	if err != nil {
+		return fmt.Errorf("failed to get user operation receipts: %w", err)
+	}
+	confirmed := make(map[int64]bool)
+	for i, receipt := range receipts {
+		if receipt == nil || confirmed[opIDs[i]] {
+			continue
+		}
+		if err = m.ORM.ConfirmUserOperation(ctx, opIDs[i], *receipt); err != nil {
+			return fmt.Errorf("failed to confirm user operation %s: %w", receipt.UserOpHash, err)
+		}
+		confirmed[opIDs[i]] = true
+		if receipt.Success {
+			m.logger.Infow("User operation confirmed", "opHash", receipt.UserOpHash, "txHash", receipt.Receipt.TransactionHash)
+		} else {
+			m.logger.Warnw("User operation reverted", "opHash", receipt.UserOpHash, "txHash", receipt.Receipt.TransactionHash, "reason", receipt.Reason)
+		}
+	}
+
+	threshold := int64(m.feeCfg.BumpThreshold())
+	if threshold == 0 {
+		return nil
+	}
+	var stale []SentUserOperation
+	for _, op := range ops {
+		if !confirmed[op.ID] && op.SentBeforeBlockNum != nil && blockNum-*op.SentBeforeBlockNum >= threshold {
+			stale = append(stale, op)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	onChainNonce, err := m.getNonce(ctx)
+	if err != nil {
+		return err
+	}
+	for _, op := range stale {
+		if err = m.resend(ctx, op, onChainNonce); err != nil {
+			m.logger.Errorw("Failed to resend user operation", "opHash", op.OpHash, "nonce", op.Nonce.String(), "err", err)
+		}
+	}
+	return nil
+}
+
+func (m *UserOpMgr) resend(ctx context.Context, sent SentUserOperation, onChainNonce *big.Int) error {
+	if sent.Sender == m.cfg.Account() && sent.Nonce.ToInt().Cmp(onChainNonce) < 0 {
+		m.logger.Errorw("User operation nonce was used without it being included, it will never be included", "opHash", sent.OpHash, "nonce", sent.Nonce.String())
+		return m.ORM.MarkUserOperationFatal(ctx, sent.ID, "nonce already used")
+	}
+	op := sent.UserOperation
+	if err := m.bumpFees(ctx, &op, sent.Owner); err != nil {
+		return err
+	}
+	// the paymaster usually signs the fees, so it has to sponsor the bumped ones again
+	if op.Paymaster != nil {
+		if m.paymaster == nil {
+			return errors.New("user operation is sponsored by a paymaster, but PaymasterURL is not set")
+		}
+		if err := m.setPaymasterData(ctx, &op, sent.EntryPoint); err != nil {
+			return err
+		}
+	}
+	opHash, err := m.sign(ctx, &op, sent.EntryPoint, sent.Owner)
+	if err != nil {
+		return err
+	}
+	// saved first, so that the user operation is resent again if the bundler rejects it
+	if err = m.ORM.UpdateResentUserOperation(ctx, sent.ID, opHash, op); err != nil {
+		return fmt.Errorf("failed to save resent user operation: %w", err)
+	}
+	if _, err = m.bundler.SendUserOperation(ctx, &op, sent.EntryPoint); err != nil {
+		return fmt.Errorf("bundler rejected user operation: %w", err)
+	}
+	m.logger.Infow("Resent user operation with bumped fees", "opHash", opHash, "previousOpHash", sent.OpHash, "maxFeePerGas", op.MaxFeePerGas, "maxPriorityFeePerGas", op.MaxPriorityFeePerGas)
+	return nil
+}
+
+// setFees sets the fees of the user operation from the gas estimator. Legacy chains use the gas price for both fees.
+func (m *UserOpMgr) setFees(ctx context.Context, op *UserOperation, owner common.Address, feeLimit uint64) error {
+	fee, _, err := m.estimator.GetFee(ctx, op.CallData, feeLimit, m.feeCfg.PriceMaxKey(owner), &owner, &op.Sender)
+	if err != nil {
+		return fmt.Errorf("failed to estimate user operation fees: %w", err)
+	}
+	if fee.ValidDynamic() {
+		op.MaxFeePerGas, op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.GasFeeCap), (*hexutil.Big)(fee.GasTipCap)
+	} else {
+		op.MaxFeePerGas, op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.GasPrice), (*hexutil.Big)(fee.GasPrice)
+	}
+	return nil
+}
+
+// bumpFees raises both fees of the user operation by the bump percent, or to the current fees if they are higher.
+func (m *UserOpMgr) bumpFees(ctx context.Context, op *UserOperation, owner common.Address) error {
+	maxFeePerGas, maxPriorityFeePerGas := (*assets.Wei)(op.MaxFeePerGas), (*assets.Wei)(op.MaxPriorityFeePerGas)
+	if err := m.setFees(ctx, op, owner, op.CallGasLimit.ToInt().Uint64()); err != nil {
+		return err
+	}
+	bumpPercent := max(m.feeCfg.BumpPercent(), UserOperationPriceBump)
+	bumpedMaxFeePerGas := assets.WeiMax(maxFeePerGas.AddPercentage(bumpPercent), (*assets.Wei)(op.MaxFeePerGas))
+	bumpedMaxPriorityFeePerGas := assets.WeiMax(maxPriorityFeePerGas.AddPercentage(bumpPercent), (*assets.Wei)(op.MaxPriorityFeePerGas))
+	if priceMax := m.feeCfg.PriceMaxKey(owner); bumpedMaxFeePerGas.Cmp(priceMax) > 0 {
+		return fmt.Errorf("bumped max fee per gas of %s would exceed configured max gas price of %s: %w", bumpedMaxFeePerGas, priceMax, commonfee.ErrBumpFeeExceedsLimit)
+	}
+	op.MaxFeePerGas, op.MaxPriorityFeePerGas = (*hexutil.Big)(bumpedMaxFeePerGas), (*hexutil.Big)(bumpedMaxPriorityFeePerGas)
+	return nil
+}
+
+// setGasLimits sets the gas limits estimated by the bundler. The call gas limit is at least the requested fee limit.
+func (m *UserOpMgr) setGasLimits(ctx context.Context, op *UserOperation, feeLimit uint64) error {
+	estimate, err := m.bundler.EstimateUserOperationGas(ctx, op, m.cfg.EntryPoint())
+	if err != nil {
+		return fmt.Errorf("failed to estimate user operation gas: %w", err)
+	}
+	op.PreVerificationGas = estimate.PreVerificationGas
+	op.VerificationGasLimit = estimate.VerificationGasLimit
+	op.CallGasLimit = estimate.CallGasLimit
+	if limit := new(big.Int).SetUint64(feeLimit); limit.Cmp(op.CallGasLimit.ToInt()) > 0 {
+		op.CallGasLimit = (*hexutil.Big)(limit)
+	}
+	if op.Paymaster != nil {
+		if estimate.PaymasterVerificationGasLimit != nil {
+			op.PaymasterVerificationGasLimit = estimate.PaymasterVerificationGasLimit
+		}
+		if estimate.PaymasterPostOpGasLimit != nil {
+			op.PaymasterPostOpGasLimit = estimate.PaymasterPostOpGasLimit
+		}
+	}
+	return nil
+}
+
+// setPaymasterData sets the final paymaster fields of the user operation, once all its other fields are set.
+func (m *UserOpMgr) setPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address) error {
+	data, err := m.paymaster.GetPaymasterData(ctx, op, entryPoint, m.evmClient.ConfiguredChainID())
+	if err != nil {
+		return fmt.Errorf("failed to get paymaster data: %w", err)
+	}
+	data.apply(op)
+	return nil
+}
+
+func (m *UserOpMgr) sign(ctx context.Context, op *UserOperation, entryPoint, owner common.Address) (common.Hash, error) {
+	opHash, err := op.Hash(entryPoint, m.evmClient.ConfiguredChainID())
+	if err != nil {
+		return opHash, err
+	}
+	key, err := m.keyStore.Get(ctx, owner.Hex())
+	if err != nil {
+		return opHash, fmt.Errorf("failed to get key %s owning the account: %w", owner, err)
+	}
+	sig, err := signUserOperationHash(key, opHash)
+	if err != nil {
+		return opHash, fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	op.Signature = sig
+	return opHash, nil
+}
+
+// nextNonce returns the nonce of a new user operation of the account. The EntryPoint only counts the included user
+// operations, so the nonces of the ones still waiting in the bundler's mempool are skipped.
+func (m *UserOpMgr) nextNonce(ctx context.Context) (*big.Int, error) {
+	nonce, err := m.getNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := m.ORM.FindUnconfirmedUserOperations(ctx, ubig.Big(*m.evmClient.ConfiguredChainID()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unconfirmed user operations: %w", err)
+	}
+	for _, op := range ops {
+		if op.Sender == m.cfg.Account() && op.Nonce.Cmp(ubig.New(nonce)) >= 0 {
+			nonce = new(big.Int).Add(op.Nonce.ToInt(), big.NewInt(1))
+		}
+	}
+	return nonce, nil
+}
+
+func (m *UserOpMgr) getNonce(ctx context.Context) (*big.Int, error) {
+	data, err := entryPointABI.Pack("getNonce", m.cfg.Account(), big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	entryPoint := m.cfg.EntryPoint()
+	res, err := m.evmClient.CallContract(ctx, ethereum.CallMsg{To: &entryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of account %s: %w", m.cfg.Account(), err)
+	}
+	out, err := entryPointABI.Unpack("getNonce", res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack nonce of account %s: %w", m.cfg.Account(), err)
+	}
+	nonce, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("unexpected nonce returned by EntryPoint")
+	}
+	return nonce, nil
+}
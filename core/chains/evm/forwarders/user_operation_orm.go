@@ -0,0 +1,175 @@
+package forwarders
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+type UserOperationState string
+
+const (
+	// UserOperationUnconfirmed is the state of a user operation sent to the bundler, which has not been included yet.
+	UserOperationUnconfirmed = UserOperationState("unconfirmed")
+	// UserOperationConfirmed is the state of a user operation included on chain. It may still have reverted, see Success.
+	UserOperationConfirmed = UserOperationState("confirmed")
+	// UserOperationFatalError is the state of a user operation which can never be included, e.g. because its nonce was used.
+	UserOperationFatalError = UserOperationState("fatal_error")
+)
+
+// SentUserOperation is a user operation sent to the bundler, with its receipt once it has been included.
+type SentUserOperation struct {
+	ID         int64       `db:"id"`
+	EVMChainID big.Big     `db:"evm_chain_id"`
+	OpHash     common.Hash `db:"op_hash"`
+	// PreviousOpHashes are the hashes of the user operation before it was resent with higher fees.
+	PreviousOpHashes   pq.ByteaArray      `db:"previous_op_hashes"`
+	EntryPoint         common.Address     `db:"entry_point"`
+	Sender             common.Address     `db:"sender"`
+	Owner              common.Address     `db:"owner"`
+	Nonce              big.Big            `db:"nonce"`
+	UserOperation      UserOperation      `db:"user_operation"`
+	IdempotencyKey     *string            `db:"idempotency_key"`
+	Meta               *sqlutil.JSON      `db:"meta"`
+	State              UserOperationState `db:"state"`
+	SentBeforeBlockNum *int64             `db:"sent_before_block_num"`
+	TxHash             *common.Hash       `db:"tx_hash"`
+	BlockNumber        *int64             `db:"block_number"`
+	Success            *bool              `db:"success"`
+	// Error is the revert reason of a reverted user operation, or why it can never be included.
+	Error         *string   `db:"error"`
+	ActualGasCost *big.Big  `db:"actual_gas_cost"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// OpHashes returns every hash the user operation was sent with, the current one last.
+func (o SentUserOperation) OpHashes() []common.Hash {
+	hashes := make([]common.Hash, 0, len(o.PreviousOpHashes)+1)
+	for _, h := range o.PreviousOpHashes {
+		hashes = append(hashes, common.BytesToHash(h))
+	}
+	return append(hashes, o.OpHash)
+}
+
+func (op UserOperation) Value() (driver.Value, error) {
+	return json.Marshal(op)
+}
+
+func (op *UserOperation) Scan(value any) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("unable to convert %v of %T to UserOperation", value, value)
+	}
+	return json.Unmarshal(b, op)
+}
+
+type UserOperationORM interface {
+	// CreateUserOperation saves a user operation before it is sent to the bundler.
+	CreateUserOperation(ctx context.Context, evmChainID big.Big, opHash common.Hash, entryPoint, owner common.Address, op UserOperation, txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (SentUserOperation, error)
+	// DeleteUserOperation deletes a user operation which was rejected by the bundler.
+	DeleteUserOperation(ctx context.Context, id int64) error
+	// FindUserOperationWithIdempotencyKey returns nil if there is no user operation with the key.
+	FindUserOperationWithIdempotencyKey(ctx context.Context, evmChainID big.Big, idempotencyKey string) (*SentUserOperation, error)
+	// FindUnconfirmedUserOperations returns the unconfirmed user operations of the chain, ordered by sender and nonce.
+	FindUnconfirmedUserOperations(ctx context.Context, evmChainID big.Big) ([]SentUserOperation, error)
+	// SetSentBeforeBlockNum records the block number before which the unconfirmed user operations were sent.
+	SetSentBeforeBlockNum(ctx context.Context, evmChainID big.Big, blockNum int64) error
+	// UpdateResentUserOperation replaces the user operation after it was resent with higher fees, keeping its previous hash.
+	UpdateResentUserOperation(ctx context.Context, id int64, opHash common.Hash, op UserOperation) error
+	// ConfirmUserOperation saves the receipt of an included user operation.
+	ConfirmUserOperation(ctx context.Context, id int64, receipt UserOperationReceipt) error
+	// MarkUserOperationFatal stops tracking a user operation which can never be included.
+	MarkUserOperationFatal(ctx context.Context, id int64, reason string) error
+}
+
+type userOperationORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ UserOperationORM = (*userOperationORM)(nil)
+
+func NewUserOperationORM(ds sqlutil.DataSource) UserOperationORM {
+	return &userOperationORM{ds: ds}
+}
+
+func (o *userOperationORM) CreateUserOperation(ctx context.Context, evmChainID big.Big, opHash common.Hash, entryPoint, owner common.Address, op UserOperation, txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (sent SentUserOperation, err error) {
+	var meta *sqlutil.JSON
+	if txRequest.Meta != nil {
+		b, merr := json.Marshal(txRequest.Meta)
+		if merr != nil {
+			return sent, fmt.Errorf("failed to marshal meta: %w", merr)
+		}
+		meta = (*sqlutil.JSON)(&b)
+	}
+	err = o.ds.GetContext(ctx, &sent, `INSERT INTO evm.user_operations (evm_chain_id, op_hash, entry_point, sender, owner, nonce, user_operation, idempotency_key, meta, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now()) RETURNING *`,
+		evmChainID, opHash, entryPoint, op.Sender, owner, big.New(op.Nonce.ToInt()), op, txRequest.IdempotencyKey, meta)
+	return
+}
+
+func (o *userOperationORM) DeleteUserOperation(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.user_operations WHERE id = $1`, id)
+	return err
+}
+
+func (o *userOperationORM) FindUserOperationWithIdempotencyKey(ctx context.Context, evmChainID big.Big, idempotencyKey string) (*SentUserOperation, error) {
+	var sent SentUserOperation
+	err := o.ds.GetContext(ctx, &sent, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 AND idempotency_key = $2`, evmChainID, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sent, nil
+}
+
+func (o *userOperationORM) FindUnconfirmedUserOperations(ctx context.Context, evmChainID big.Big) (ops []SentUserOperation, err error) {
+	err = o.ds.SelectContext(ctx, &ops, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 AND state = 'unconfirmed' ORDER BY sender, nonce`, evmChainID)
+	return
+}
+
+func (o *userOperationORM) SetSentBeforeBlockNum(ctx context.Context, evmChainID big.Big, blockNum int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET sent_before_block_num = $2, updated_at = now()
+WHERE evm_chain_id = $1 AND state = 'unconfirmed' AND sent_before_block_num IS NULL`, evmChainID, blockNum)
+	return err
+}
+
+func (o *userOperationORM) UpdateResentUserOperation(ctx context.Context, id int64, opHash common.Hash, op UserOperation) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET previous_op_hashes = array_append(previous_op_hashes, op_hash),
+op_hash = $2, user_operation = $3, sent_before_block_num = NULL, updated_at = now() WHERE id = $1`, id, opHash, op)
+	return err
+}
+
+func (o *userOperationORM) ConfirmUserOperation(ctx context.Context, id int64, receipt UserOperationReceipt) error {
+	var actualGasCost *big.Big
+	if receipt.ActualGasCost != nil {
+		actualGasCost = big.New(receipt.ActualGasCost.ToInt())
+	}
+	var blockNumber int64
+	if receipt.Receipt.BlockNumber != nil {
+		blockNumber = receipt.Receipt.BlockNumber.ToInt().Int64()
+	}
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET state = 'confirmed', op_hash = $2, tx_hash = $3, block_number = $4,
+success = $5, error = NULLIF($6, ''), actual_gas_cost = $7, updated_at = now() WHERE id = $1`,
+		id, receipt.UserOpHash, receipt.Receipt.TransactionHash, blockNumber, receipt.Success, receipt.Reason, actualGasCost)
+	return err
+}
+
+func (o *userOperationORM) MarkUserOperationFatal(ctx context.Context, id int64, reason string) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_operations SET state = 'fatal_error', error = $2, updated_at = now() WHERE id = $1`, id, reason)
+	return err
+}
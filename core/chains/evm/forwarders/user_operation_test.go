@@ -0,0 +1,178 @@
+package forwarders
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// expectedUserOpHash computes the hash of op the way EntryPoint.getUserOpHash does, using the ABI encoder.
+func expectedUserOpHash(t *testing.T, op *UserOperation, entryPoint common.Address, chainID *big.Int) common.Hash {
+	mustType := func(s string) abi.Type {
+		typ, err := abi.NewType(s, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	packed := func(hi, lo *hexutil.Big) [32]byte {
+		var b [32]byte
+		copy(b[:], common.LeftPadBytes(new(big.Int).Add(new(big.Int).Lsh(hi.ToInt(), 128), lo.ToInt()).Bytes(), 32))
+		return b
+	}
+	var paymasterAndData []byte
+	if op.Paymaster != nil {
+		paymasterAndData = append(paymasterAndData, op.Paymaster.Bytes()...)
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(op.PaymasterVerificationGasLimit.ToInt().Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(op.PaymasterPostOpGasLimit.ToInt().Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, *op.PaymasterData...)
+	}
+
+	inner, err := abi.Arguments{
+		{Type: mustType("address")},
+		{Type: mustType("uint256")},
+		{Type: mustType("bytes32")},
+		{Type: mustType("bytes32")},
+		{Type: mustType("bytes32")},
+		{Type: mustType("uint256")},
+		{Type: mustType("bytes32")},
+		{Type: mustType("bytes32")},
+	}.Pack(
+		op.Sender,
+		op.Nonce.ToInt(),
+		crypto.Keccak256Hash(nil),
+		crypto.Keccak256Hash(op.CallData),
+		packed(op.VerificationGasLimit, op.CallGasLimit),
+		op.PreVerificationGas.ToInt(),
+		packed(op.MaxPriorityFeePerGas, op.MaxFeePerGas),
+		crypto.Keccak256Hash(paymasterAndData),
+	)
+	require.NoError(t, err)
+
+	outer, err := abi.Arguments{
+		{Type: mustType("bytes32")},
+		{Type: mustType("address")},
+		{Type: mustType("uint256")},
+	}.Pack(crypto.Keccak256Hash(inner), entryPoint, chainID)
+	require.NoError(t, err)
+	return crypto.Keccak256Hash(outer)
+}
+
+func newTestUserOperation() *UserOperation {
+	return &UserOperation{
+		Sender:               common.HexToAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418"),
+		Nonce:                (*hexutil.Big)(big.NewInt(7)),
+		CallData:             hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(150_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(30_000_000_000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1_000_000_000)),
+		Signature:            dummySignature,
+	}
+}
+
+func TestUserOperation_Hash(t *testing.T) {
+	t.Parallel()
+	chainID := big.NewInt(11155111)
+
+	t.Run("without paymaster", func(t *testing.T) {
+		op := newTestUserOperation()
+		h, err := op.Hash(EntryPointV07Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedUserOpHash(t, op, EntryPointV07Address, chainID), h)
+	})
+
+	t.Run("with paymaster", func(t *testing.T) {
+		op := newTestUserOperation()
+		paymaster := common.HexToAddress("0xae4E781a6218A8031764928E88d457937A954fC3")
+		data := hexutil.Bytes(hexutil.MustDecode("0x1234"))
+		op.Paymaster = &paymaster
+		op.PaymasterVerificationGasLimit = (*hexutil.Big)(big.NewInt(40_000))
+		op.PaymasterPostOpGasLimit = (*hexutil.Big)(big.NewInt(20_000))
+		op.PaymasterData = &data
+		h, err := op.Hash(EntryPointV07Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedUserOpHash(t, op, EntryPointV07Address, chainID), h)
+	})
+
+	t.Run("signature is not hashed", func(t *testing.T) {
+		op := newTestUserOperation()
+		h1, err := op.Hash(EntryPointV07Address, chainID)
+		require.NoError(t, err)
+		op.Signature = hexutil.MustDecode("0x01")
+		h2, err := op.Hash(EntryPointV07Address, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, h1, h2)
+	})
+
+	t.Run("gas limit overflows uint128", func(t *testing.T) {
+		op := newTestUserOperation()
+		op.CallGasLimit = (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 128))
+		_, err := op.Hash(EntryPointV07Address, chainID)
+		require.ErrorContains(t, err, "callGasLimit of user operation must be a uint128")
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		op := newTestUserOperation()
+		op.MaxFeePerGas = nil
+		_, err := op.Hash(EntryPointV07Address, chainID)
+		require.ErrorContains(t, err, "maxFeePerGas of user operation is not set")
+	})
+}
+
+func TestSignUserOperationHash(t *testing.T) {
+	t.Parallel()
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	opHash, err := newTestUserOperation().Hash(EntryPointV07Address, big.NewInt(11155111))
+	require.NoError(t, err)
+
+	sig, err := signUserOperationHash(key, opHash)
+	require.NoError(t, err)
+	require.Len(t, sig, crypto.SignatureLength)
+	require.Contains(t, []byte{27, 28}, sig[crypto.RecoveryIDOffset])
+
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(opHash.Bytes()), sig)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pub))
+}
+
+func TestPaymasterData_apply(t *testing.T) {
+	t.Parallel()
+
+	paymaster := common.HexToAddress("0xae4E781a6218A8031764928E88d457937A954fC3")
+
+	t.Run("stub data", func(t *testing.T) {
+		op := newTestUserOperation()
+		stub := PaymasterData{Paymaster: paymaster, PaymasterData: hexutil.MustDecode("0x1234"), PaymasterPostOpGasLimit: (*hexutil.Big)(big.NewInt(20_000))}
+		stub.apply(op)
+		assert.Equal(t, &paymaster, op.Paymaster)
+		assert.Equal(t, hexutil.Bytes(hexutil.MustDecode("0x1234")), *op.PaymasterData)
+		assert.Equal(t, big.NewInt(0), op.PaymasterVerificationGasLimit.ToInt())
+		assert.Equal(t, big.NewInt(20_000), op.PaymasterPostOpGasLimit.ToInt())
+		_, err := op.Hash(EntryPointV07Address, big.NewInt(11155111))
+		require.NoError(t, err)
+	})
+
+	t.Run("final data keeps the estimated gas limits", func(t *testing.T) {
+		op := newTestUserOperation()
+		op.Paymaster = &paymaster
+		op.PaymasterVerificationGasLimit = (*hexutil.Big)(big.NewInt(40_000))
+		op.PaymasterPostOpGasLimit = (*hexutil.Big)(big.NewInt(20_000))
+		final := PaymasterData{Paymaster: paymaster, PaymasterData: hexutil.MustDecode("0x5678")}
+		final.apply(op)
+		assert.Equal(t, hexutil.Bytes(hexutil.MustDecode("0x5678")), *op.PaymasterData)
+		assert.Equal(t, big.NewInt(40_000), op.PaymasterVerificationGasLimit.ToInt())
+		assert.Equal(t, big.NewInt(20_000), op.PaymasterPostOpGasLimit.ToInt())
+	})
+}
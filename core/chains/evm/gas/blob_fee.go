@@ -0,0 +1,74 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	pkgerrors "github.com/pkg/errors"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+)
+
+const (
+	// BlobBaseFeeMultiplier is applied to the blob base fee of the next block to get the blob fee cap of
+	// a new blob transaction. The blob base fee can increase by at most 12.5% per block, so this keeps the
+	// transaction includable for at least 5 full blocks.
+	BlobBaseFeeMultiplier = 2
+	// BlobTxPriceBump is the percentage by which every fee of a blob transaction must be bumped to
+	// replace it in the blob pool. It is much higher than the 10% required by the legacy pool.
+	BlobTxPriceBump = 100
+)
+
+// GetBlobFee returns the fee cap per unit of blob gas, from the blob base fee of the next block reported by eth_feeHistory.
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	var history struct {
+		BaseFeePerBlobGas []*hexutil.Big `json:"baseFeePerBlobGas"`
+	}
+	if err := e.ethClient.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(1), "latest", nil); err != nil {
+		return nil, fmt.Errorf("failed to fetch fee history: %w", err)
+	}
+	if len(history.BaseFeePerBlobGas) == 0 {
+		return nil, errors.New("eth_feeHistory did not return baseFeePerBlobGas, the chain or RPC node may not support blob transactions")
+	}
+	// the last entry is the blob base fee of the next block
+	nextBlobBaseFee := assets.NewWei(history.BaseFeePerBlobGas[len(history.BaseFeePerBlobGas)-1].ToInt())
+	blobFeeCap := assets.MaxWei(nextBlobBaseFee.Mul(big.NewInt(BlobBaseFeeMultiplier)), assets.NewWeiI(params.BlobTxMinBlobGasprice))
+
+	maxGasPrice := getMaxGasPrice(maxFeePrice, e.geCfg.PriceMax())
+	if blobFeeCap.Cmp(maxGasPrice) > 0 {
+		e.lggr.Warnw("Blob fee cap exceeds max gas price, capping it", "blobFeeCap", blobFeeCap, "nextBlobBaseFee", nextBlobBaseFee, "maxGasPrice", maxGasPrice)
+		blobFeeCap = maxGasPrice
+	}
+	return blobFeeCap, nil
+}
+
+// bumpBlobFee raises the bumped dynamic fee of a blob transaction and its blob fee cap to satisfy the replacement
+// rules of the blob pool, which require every fee to be bumped by BlobTxPriceBump.
+func (e *evmFeeEstimator) bumpBlobFee(ctx context.Context, originalFee EvmFee, bumpedFee EvmFee, maxFeePrice *assets.Wei) (EvmFee, error) {
+	bumpedFee.GasTipCap = assets.WeiMax(bumpedFee.GasTipCap, originalFee.GasTipCap.AddPercentage(BlobTxPriceBump))
+	bumpedFee.GasFeeCap = assets.WeiMax(bumpedFee.GasFeeCap, originalFee.GasFeeCap.AddPercentage(BlobTxPriceBump))
+	bumpedFee.BlobFeeCap = originalFee.BlobFeeCap.AddPercentage(BlobTxPriceBump)
+	if currentBlobFeeCap, err := e.GetBlobFee(ctx, maxFeePrice); err != nil {
+		e.lggr.Warnw("Failed to get current blob fee, bumping the original blob fee cap", "err", err)
+	} else {
+		bumpedFee.BlobFeeCap = assets.WeiMax(bumpedFee.BlobFeeCap, currentBlobFeeCap)
+	}
+
+	maxGasPrice := getMaxGasPrice(maxFeePrice, e.geCfg.PriceMax())
+	for _, f := range []struct {
+		name string
+		fee  *assets.Wei
+	}{{"tip cap", bumpedFee.GasTipCap}, {"fee cap", bumpedFee.GasFeeCap}, {"blob fee cap", bumpedFee.BlobFeeCap}} {
+		if f.fee.Cmp(maxGasPrice) > 0 {
+			return bumpedFee, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped %s of %s would exceed configured max gas price of %s (original fee: %s). %s",
+				f.name, f.fee.String(), maxGasPrice, originalFee.String(), label.NodeConnectivityProblemWarning)
+		}
+	}
+	return bumpedFee, nil
+}
@@ -0,0 +1,125 @@
+package gas_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func TestWrappedEvmEstimator_BlobFee(t *testing.T) {
+	t.Parallel()
+	maxPrice := assets.NewWeiI(1000)
+	geCfg := gas.NewMockGasConfig()
+	geCfg.PriceMaxF = maxPrice
+	geCfg.LimitMultiplierF = 1
+
+	newEstimator := func(t *testing.T, client *mocks.FeeEstimatorClient) gas.EvmFeeEstimator {
+		est := mocks.NewEvmEstimator(t)
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(60), GasTipCap: assets.NewWeiI(6)}, nil).Maybe()
+		return gas.NewEvmFeeEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, client)
+	}
+	mockFeeHistory := func(client *mocks.FeeEstimatorClient, resp string, err error) {
+		mockCallContext(client, resp, err, "eth_feeHistory", hexutil.Uint(1), "latest", nil)
+	}
+
+	t.Run("GetBlobFee uses the blob base fee of the next block", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x10","0x14"]}`, nil)
+
+		blobFeeCap, err := newEstimator(t, client).GetBlobFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(40), blobFeeCap)
+	})
+
+	t.Run("GetBlobFee is at least the minimum blob gas price and at most the max gas price", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x0","0x0"]}`, nil)
+		blobFeeCap, err := newEstimator(t, client).GetBlobFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(1), blobFeeCap)
+
+		client = mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x1000","0x1000"]}`, nil)
+		blobFeeCap, err = newEstimator(t, client).GetBlobFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, maxPrice, blobFeeCap)
+	})
+
+	t.Run("GetBlobFee fails if the chain does not support blobs", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerGas":["0x10","0x14"]}`, nil)
+		_, err := newEstimator(t, client).GetBlobFee(tests.Context(t), maxPrice)
+		assert.ErrorContains(t, err, "baseFeePerBlobGas")
+	})
+
+	t.Run("GetMaxCost includes the blob gas of every blob", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x10","0x14"]}`, nil)
+		est := mocks.NewEvmEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, maxPrice).
+			Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(5)}, nil).Once()
+		estimator := gas.NewEvmFeeEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, client)
+
+		total, err := estimator.GetMaxCost(tests.Context(t), assets.NewEthValue(1), nil, 2, 100_000, maxPrice, nil, nil)
+		require.NoError(t, err)
+		// 1 wei transferred + 50 wei x 100,000 gas + 40 wei x 2 blobs x 131,072 blob gas
+		assert.Equal(t, big.NewInt(1+50*100_000+40*2*params.BlobTxBlobGasPerBlob), total)
+	})
+
+	t.Run("BumpFee doubles every fee of a blob transaction", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x10","0x10"]}`, nil)
+
+		original := gas.EvmFee{
+			DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(5)},
+			BlobFeeCap: assets.NewWeiI(20),
+		}
+		fee, _, err := newEstimator(t, client).BumpFee(tests.Context(t), original, 100_000, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), fee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(10), fee.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(40), fee.BlobFeeCap)
+		assert.Nil(t, fee.GasPrice)
+	})
+
+	t.Run("BumpFee uses the current blob fee if it is higher", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, `{"baseFeePerBlobGas":["0x20","0x40"]}`, nil)
+
+		original := gas.EvmFee{
+			DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(5)},
+			BlobFeeCap: assets.NewWeiI(20),
+		}
+		fee, _, err := newEstimator(t, client).BumpFee(tests.Context(t), original, 100_000, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(128), fee.BlobFeeCap)
+	})
+
+	t.Run("BumpFee fails if a bumped fee exceeds the max gas price", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockFeeHistory(client, "", errors.New("kaboom"))
+
+		original := gas.EvmFee{
+			DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(5)},
+			BlobFeeCap: assets.NewWeiI(600),
+		}
+		_, _, err := newEstimator(t, client).BumpFee(tests.Context(t), original, 100_000, maxPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+		assert.ErrorContains(t, err, "blob fee cap of 1.2 kwei")
+	})
+}
@@ -0,0 +1,378 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// metrics are thread safe
+var (
+	promMempoolEstimatorSampleSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_mempool_estimator_sample_size",
+		Help: "Number of pending transactions used in the latest mempool estimation",
+	},
+		[]string{"evmChainID", "source"},
+	)
+	promMempoolEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_mempool_estimator_gas_price",
+		Help: "Latest gas price estimated from pending transactions (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+	promMempoolEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_mempool_estimator_tip_cap",
+		Help: "Latest maxPriorityFeePerGas estimated from pending transactions (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+const (
+	mempoolSourceTxPool       = "txpool"
+	mempoolSourcePendingBlock = "pendingBlock"
+)
+
+// jsonRpcMethodNotFound is returned by RPCs which don't expose the txpool namespace
+const jsonRpcMethodNotFound = -32601
+
+var errNoPendingTransactions = errors.New("no usable pending transactions")
+
+type MempoolEstimatorConfig struct {
+	CacheTimeout time.Duration
+	EIP1559      bool
+	// TargetBlocks is the number of blocks the pending transactions are expected to be included in.
+	TargetBlocks uint64
+	// Percentile is the percentile of the fees of the pending transactions fitting in TargetBlocks to use.
+	Percentile uint16
+	// TxPoolInterval is how often txpool_content is fetched. The last sample is reused in between.
+	TxPoolInterval time.Duration
+}
+
+type mempoolEstimatorClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// pendingBlock is the subset of the pending block used by the MempoolEstimator
+type pendingBlock struct {
+	BaseFeePerGas *hexutil.Big           `json:"baseFeePerGas"`
+	GasLimit      hexutil.Uint64         `json:"gasLimit"`
+	Transactions  []evmtypes.Transaction `json:"transactions"`
+}
+
+// txPoolContent is the subset of the txpool_content response used by the MempoolEstimator
+type txPoolContent struct {
+	Pending map[string]map[string]evmtypes.Transaction `json:"pending"`
+}
+
+// mempoolFee is the cached estimation of the MempoolEstimator
+type mempoolFee struct {
+	gasPrice   *assets.Wei
+	dynamicFee DynamicFee
+}
+
+// pendingFee is the fee a pending transaction pays per unit of gas, if it is included in the next block
+type pendingFee struct {
+	gasPrice *assets.Wei
+	tipCap   *assets.Wei
+	gas      uint64
+}
+
+// MempoolEstimator estimates the fee needed to be included in the next TargetBlocks blocks, using the pending
+// transactions of the RPC. It prefers the txpool_content namespace, and falls back to the transactions of the pending
+// block for RPCs which don't expose it. If neither is available, or there are no pending transactions, estimations and
+// bumping are delegated to the fallback estimator (usually the FeeHistoryEstimator).
+type MempoolEstimator struct {
+	services.StateMachine
+
+	client   mempoolEstimatorClient
+	logger   logger.Logger
+	config   MempoolEstimatorConfig
+	chainID  *big.Int
+	fallback EvmEstimator
+
+	// txPoolUnsupported is set once the RPC reports that txpool_content doesn't exist, so that it is not queried again
+	txPoolUnsupported atomic.Bool
+
+	txPoolMu        sync.Mutex
+	txPool          []evmtypes.Transaction
+	txPoolFetchedAt time.Time
+
+	feeMu sync.RWMutex
+	fee   *mempoolFee
+
+	wg     *sync.WaitGroup
+	stopCh services.StopChan
+}
+
+func NewMempoolEstimator(lggr logger.Logger, client mempoolEstimatorClient, cfg MempoolEstimatorConfig, chainID *big.Int, fallback EvmEstimator) *MempoolEstimator {
+	return &MempoolEstimator{
+		client:   client,
+		logger:   logger.Named(lggr, "MempoolEstimator"),
+		config:   cfg,
+		chainID:  chainID,
+		fallback: fallback,
+		wg:       new(sync.WaitGroup),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+func (m *MempoolEstimator) Start(ctx context.Context) error {
+	return m.StartOnce("MempoolEstimator", func() error {
+		if m.config.TargetBlocks == 0 {
+			return fmt.Errorf("TargetBlocks: must be greater than 0")
+		}
+		if m.config.Percentile > 100 {
+			return fmt.Errorf("Percentile: %d must be in range 0-100", m.config.Percentile)
+		}
+		if err := m.fallback.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start fallback estimator: %w", err)
+		}
+		m.wg.Add(1)
+		go m.run()
+
+		return nil
+	})
+}
+
+func (m *MempoolEstimator) Close() error {
+	return m.StopOnce("MempoolEstimator", func() error {
+		close(m.stopCh)
+		m.wg.Wait()
+		return m.fallback.Close()
+	})
+}
+
+func (m *MempoolEstimator) run() {
+	defer m.wg.Done()
+
+	t := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(m.config.CacheTimeout)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-t.C:
+			if err := m.Refresh(); err != nil {
+				m.logger.Debugw("Failed to estimate fee from pending transactions, using fallback estimator", "err", err)
+			}
+		}
+	}
+}
+
+// Refresh fetches the pending transactions and caches the fee at the configured percentile of those that fit in the
+// next TargetBlocks blocks. On failure the cached fee is cleared, so that the fallback estimator is used until the
+// next successful refresh.
+func (m *MempoolEstimator) Refresh() error {
+	ctx, cancel := m.stopCh.CtxWithTimeout(commonclient.QueryTimeout)
+	defer cancel()
+
+	fee, err := m.estimate(ctx)
+	m.feeMu.Lock()
+	defer m.feeMu.Unlock()
+	m.fee = fee
+	return err
+}
+
+func (m *MempoolEstimator) estimate(ctx context.Context) (*mempoolFee, error) {
+	var block *pendingBlock
+	if err := m.client.CallContext(ctx, &block, "eth_getBlockByNumber", "pending", true); err != nil {
+		return nil, fmt.Errorf("failed to fetch pending block: %w", err)
+	}
+	if block == nil {
+		return nil, errors.New("RPC returned no pending block")
+	}
+	var baseFee *assets.Wei
+	if block.BaseFeePerGas != nil {
+		baseFee = assets.NewWei(block.BaseFeePerGas.ToInt())
+	}
+	if m.config.EIP1559 && baseFee == nil {
+		return nil, errors.New("pending block has no base fee")
+	}
+
+	txs, source := m.fetchTxPool(ctx), mempoolSourceTxPool
+	if len(txs) == 0 {
+		txs, source = block.Transactions, mempoolSourcePendingBlock
+	}
+	fees := pendingFees(txs, baseFee)
+	promMempoolEstimatorSampleSize.WithLabelValues(m.chainID.String(), source).Set(float64(len(fees)))
+	if len(fees) == 0 {
+		return nil, errNoPendingTransactions
+	}
+
+	// The highest paying transactions are included first, so only the ones which fit in the target blocks compete
+	// with ours.
+	sort.Slice(fees, func(i, j int) bool { return fees[i].tipCap.Cmp(fees[j].tipCap) > 0 })
+	capacity := m.config.TargetBlocks * uint64(block.GasLimit)
+	var used uint64
+	n := 0
+	for n < len(fees) && (capacity == 0 || n == 0 || used+fees[n].gas <= capacity) {
+		used += fees[n].gas
+		n++
+	}
+	fees = fees[:n]
+
+	// fees are sorted in descending order, so the Nth percentile is counted from the end
+	p := fees[len(fees)-1-((len(fees)-1)*int(m.config.Percentile))/100]
+	fee := &mempoolFee{gasPrice: p.gasPrice}
+	if m.config.EIP1559 {
+		// BaseFeeBufferPercentage is used as a safety to catch any fluctuations in the Base Fee during the next blocks.
+		fee.dynamicFee = DynamicFee{GasFeeCap: baseFee.AddPercentage(BaseFeeBufferPercentage).Add(p.tipCap), GasTipCap: p.tipCap}
+		promMempoolEstimatorTipCap.WithLabelValues(m.chainID.String()).Set(float64(p.tipCap.Int64()))
+	} else {
+		promMempoolEstimatorGasPrice.WithLabelValues(m.chainID.String()).Set(float64(p.gasPrice.Int64()))
+	}
+
+	m.logger.Debugw("Estimated fee from pending transactions", "source", source, "sampleSize", n, "sampleGas", used,
+		"baseFee", baseFee, "gasPrice", p.gasPrice, "tipCap", p.tipCap)
+	return fee, nil
+}
+
+// fetchTxPool returns the pending transactions of the txpool_content namespace, or nil if the RPC doesn't support it.
+// The transactions are fetched at most once every TxPoolInterval, and the last sample is returned in between. Failed
+// fetches are retried on the next refresh, unless the RPC reports that the method doesn't exist.
+func (m *MempoolEstimator) fetchTxPool(ctx context.Context) []evmtypes.Transaction {
+	if m.txPoolUnsupported.Load() {
+		return nil
+	}
+	m.txPoolMu.Lock()
+	defer m.txPoolMu.Unlock()
+	if !m.txPoolFetchedAt.IsZero() && time.Since(m.txPoolFetchedAt) < m.config.TxPoolInterval {
+		return m.txPool
+	}
+	var content txPoolContent
+	if err := m.client.CallContext(ctx, &content, "txpool_content"); err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == jsonRpcMethodNotFound {
+			m.logger.Infow("RPC does not support txpool_content, falling back to the pending block", "err", err)
+			m.txPoolUnsupported.Store(true)
+		} else {
+			m.logger.Debugw("Failed to fetch txpool_content, falling back to the pending block", "err", err)
+		}
+		m.txPool, m.txPoolFetchedAt = nil, time.Time{}
+		return nil
+	}
+	var txs []evmtypes.Transaction
+	for _, byNonce := range content.Pending {
+		for _, tx := range byNonce {
+			txs = append(txs, tx)
+		}
+	}
+	m.txPool, m.txPoolFetchedAt = txs, time.Now()
+	return txs
+}
+
+// pendingFees returns the effective fees of the transactions, if they were included in a block with baseFee.
+// Transactions which can't pay the baseFee are skipped.
+func pendingFees(txs []evmtypes.Transaction, baseFee *assets.Wei) []pendingFee {
+	fees := make([]pendingFee, 0, len(txs))
+	for _, tx := range txs {
+		var gasPrice, tipCap *assets.Wei
+		switch {
+		case tx.MaxFeePerGas != nil && tx.MaxPriorityFeePerGas != nil:
+			if baseFee == nil {
+				continue
+			}
+			tipCap = assets.WeiMin(tx.MaxPriorityFeePerGas, tx.MaxFeePerGas.Sub(baseFee))
+		case tx.GasPrice != nil:
+			if baseFee == nil {
+				gasPrice, tipCap = tx.GasPrice, tx.GasPrice
+				break
+			}
+			tipCap = tx.GasPrice.Sub(baseFee)
+		default:
+			continue
+		}
+		if tipCap.Cmp(assets.NewWeiI(0)) < 0 {
+			continue
+		}
+		if gasPrice == nil {
+			gasPrice = baseFee.Add(tipCap)
+		}
+		fees = append(fees, pendingFee{gasPrice: gasPrice, tipCap: tipCap, gas: uint64(tx.GasLimit)})
+	}
+	return fees
+}
+
+func (m *MempoolEstimator) getFee() *mempoolFee {
+	m.feeMu.RLock()
+	defer m.feeMu.RUnlock()
+	return m.fee
+}
+
+// GetLegacyGas returns the cached gas price estimated from pending transactions, or the fallback estimation if there is none.
+func (m *MempoolEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxPrice *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	fee := m.getFee()
+	if fee == nil || m.config.EIP1559 {
+		return m.fallback.GetLegacyGas(ctx, calldata, gasLimit, maxPrice, opts...)
+	}
+	gasPrice = fee.gasPrice
+	if gasPrice.Cmp(maxPrice) > 0 {
+		m.logger.Warnf("estimated gas price: %s is greater than the maximum gas price configured: %s, returning the maximum price instead.", gasPrice, maxPrice)
+		gasPrice = maxPrice
+	}
+	return gasPrice, gasLimit, nil
+}
+
+// GetDynamicFee returns the cached dynamic fee estimated from pending transactions, or the fallback estimation if there is none.
+func (m *MempoolEstimator) GetDynamicFee(ctx context.Context, maxPrice *assets.Wei) (fee DynamicFee, err error) {
+	cached := m.getFee()
+	if cached == nil || !m.config.EIP1559 {
+		return m.fallback.GetDynamicFee(ctx, maxPrice)
+	}
+	fee = cached.dynamicFee
+	if fee.GasFeeCap.Cmp(maxPrice) > 0 {
+		m.logger.Warnf("estimated maxFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+			fee.GasFeeCap, maxPrice)
+		fee.GasFeeCap = maxPrice
+		if fee.GasTipCap.Cmp(maxPrice) > 0 {
+			m.logger.Warnf("estimated maxPriorityFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+				fee.GasTipCap, maxPrice)
+			fee.GasTipCap = maxPrice
+		}
+	}
+	return fee, nil
+}
+
+// BumpLegacyGas is delegated to the fallback estimator, which owns the connectivity checks.
+func (m *MempoolEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxPrice *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	return m.fallback.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxPrice, attempts)
+}
+
+// BumpDynamicFee is delegated to the fallback estimator, which owns the connectivity checks.
+func (m *MempoolEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, maxPrice *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	return m.fallback.BumpDynamicFee(ctx, originalFee, maxPrice, attempts)
+}
+
+func (m *MempoolEstimator) Name() string               { return m.logger.Name() }
+func (m *MempoolEstimator) L1Oracle() rollups.L1Oracle { return m.fallback.L1Oracle() }
+func (m *MempoolEstimator) HealthReport() map[string]error {
+	report := map[string]error{m.Name(): m.Healthy()}
+	services.CopyHealth(report, m.fallback.HealthReport())
+	return report
+}
+func (m *MempoolEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	m.fallback.OnNewLongestChain(ctx, head)
+}
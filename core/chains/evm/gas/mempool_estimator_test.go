@@ -0,0 +1,193 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func dynamicTxJSON(tipCap, feeCap, gasLimit int64) string {
+	return fmt.Sprintf(`{"type":"0x2","gas":"0x%x","maxPriorityFeePerGas":"0x%x","maxFeePerGas":"0x%x","gasPrice":"0x%x"}`,
+		gasLimit, tipCap, feeCap, feeCap)
+}
+
+func pendingBlockJSON(baseFee, gasLimit int64, txs ...string) string {
+	return fmt.Sprintf(`{"baseFeePerGas":"0x%x","gasLimit":"0x%x","transactions":[%s]}`, baseFee, gasLimit, strings.Join(txs, ","))
+}
+
+func mockCallContext(client *mocks.FeeEstimatorClient, resp string, err error, method string, args ...interface{}) {
+	client.On("CallContext", append([]interface{}{mock.Anything, mock.Anything, method}, args...)...).Return(err).Run(func(args mock.Arguments) {
+		if err == nil {
+			if jsonErr := json.Unmarshal([]byte(resp), args.Get(1)); jsonErr != nil {
+				panic(jsonErr)
+			}
+		}
+	})
+}
+
+// rpcError is a JSON-RPC error with a code, like those returned by go-ethereum's rpc.Client.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func (e *rpcError) Error() string  { return e.msg }
+func (e *rpcError) ErrorCode() int { return e.code }
+
+func TestMempoolEstimator(t *testing.T) {
+	t.Parallel()
+	var gasLimit uint64 = 21000
+	maxPrice := assets.NewWeiI(100)
+	chainID := big.NewInt(0)
+	cfg := gas.MempoolEstimatorConfig{
+		CacheTimeout: time.Hour,
+		TargetBlocks: 1,
+		Percentile:   50,
+	}
+	// only the three highest paying transactions fit in the next block
+	block := pendingBlockJSON(10, 100_000,
+		dynamicTxJSON(1, 30, 30_000),
+		dynamicTxJSON(5, 30, 30_000),
+		dynamicTxJSON(2, 30, 30_000),
+		dynamicTxJSON(4, 30, 30_000),
+		dynamicTxJSON(3, 30, 30_000),
+	)
+
+	t.Run("fails to start if TargetBlocks is 0", func(t *testing.T) {
+		cfg := gas.MempoolEstimatorConfig{Percentile: 50}
+		m := gas.NewMempoolEstimator(logger.Test(t), nil, cfg, chainID, mocks.NewEvmEstimator(t))
+		assert.ErrorContains(t, m.Start(tests.Context(t)), "TargetBlocks")
+	})
+
+	t.Run("starts and closes the fallback estimator", func(t *testing.T) {
+		fallback := mocks.NewEvmEstimator(t)
+		fallback.On("Start", mock.Anything).Return(nil).Once()
+		fallback.On("Close").Return(nil).Once()
+		fallback.On("HealthReport").Return(map[string]error{"fallback": nil})
+		m := gas.NewMempoolEstimator(logger.Test(t), mocks.NewFeeEstimatorClient(t), cfg, chainID, fallback)
+		servicetest.RunHealthy(t, m)
+	})
+
+	t.Run("estimates legacy gas price from the pending block if txpool is not supported", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, "", &rpcError{code: -32601, msg: "the method txpool_content does not exist"}, "txpool_content")
+		mockCallContext(client, block, nil, "eth_getBlockByNumber", "pending", true)
+
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, mocks.NewEvmEstimator(t))
+		require.NoError(t, m.Refresh())
+		gasPrice, chainSpecificGasLimit, err := m.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		// base fee + median tip of the transactions fitting in the next block
+		assert.Equal(t, assets.NewWeiI(14), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		// txpool_content is not queried again
+		require.NoError(t, m.Refresh())
+		client.AssertNumberOfCalls(t, "CallContext", 3)
+	})
+
+	t.Run("retries txpool_content after transient errors", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, "", errors.New("timeout"), "txpool_content")
+		mockCallContext(client, block, nil, "eth_getBlockByNumber", "pending", true)
+
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, mocks.NewEvmEstimator(t))
+		require.NoError(t, m.Refresh())
+		require.NoError(t, m.Refresh())
+		client.AssertNumberOfCalls(t, "CallContext", 4)
+	})
+
+	t.Run("reuses the txpool sample until TxPoolInterval elapses", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, fmt.Sprintf(`{"pending":{"0x01":{"1":%s}}}`, dynamicTxJSON(20, 40, 21_000)), nil, "txpool_content")
+		mockCallContext(client, block, nil, "eth_getBlockByNumber", "pending", true)
+
+		cfg := cfg
+		cfg.EIP1559 = true
+		cfg.TxPoolInterval = time.Hour
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, mocks.NewEvmEstimator(t))
+		require.NoError(t, m.Refresh())
+		require.NoError(t, m.Refresh())
+		client.AssertNumberOfCalls(t, "CallContext", 3)
+		fee, err := m.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), fee.GasTipCap)
+	})
+
+	t.Run("estimates dynamic fee from the txpool", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, fmt.Sprintf(`{"pending":{"0x01":{"1":%s,"2":%s},"0x02":{"1":%s}}}`,
+			dynamicTxJSON(20, 40, 21_000), dynamicTxJSON(30, 40, 21_000), dynamicTxJSON(25, 40, 21_000)), nil, "txpool_content")
+		mockCallContext(client, block, nil, "eth_getBlockByNumber", "pending", true)
+
+		cfg := cfg
+		cfg.EIP1559 = true
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, mocks.NewEvmEstimator(t))
+		require.NoError(t, m.Refresh())
+		fee, err := m.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(25), fee.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(39), fee.GasFeeCap) // base fee with 40% buffer + tip
+
+		fee, err = m.GetDynamicFee(tests.Context(t), assets.NewWeiI(20))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), fee.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(20), fee.GasFeeCap)
+	})
+
+	t.Run("skips transactions which can't pay the base fee", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, `{"pending":{}}`, nil, "txpool_content")
+		mockCallContext(client, pendingBlockJSON(50, 100_000, dynamicTxJSON(5, 40, 21_000)), nil, "eth_getBlockByNumber", "pending", true)
+		fallback := mocks.NewEvmEstimator(t)
+		fallback.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, maxPrice).Return(assets.NewWeiI(42), gasLimit, nil).Once()
+
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, fallback)
+		assert.ErrorContains(t, m.Refresh(), "no usable pending transactions")
+		gasPrice, _, err := m.GetLegacyGas(tests.Context(t), nil, gasLimit, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+	})
+
+	t.Run("falls back if the pending block is not available", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		mockCallContext(client, "", errors.New("kaboom"), "eth_getBlockByNumber", "pending", true)
+		fallback := mocks.NewEvmEstimator(t)
+		fallbackFee := gas.DynamicFee{GasFeeCap: assets.NewWeiI(20), GasTipCap: assets.NewWeiI(2)}
+		fallback.On("GetDynamicFee", mock.Anything, maxPrice).Return(fallbackFee, nil).Once()
+
+		cfg := cfg
+		cfg.EIP1559 = true
+		m := gas.NewMempoolEstimator(logger.Test(t), client, cfg, chainID, fallback)
+		assert.ErrorContains(t, m.Refresh(), "kaboom")
+		fee, err := m.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, fallbackFee, fee)
+	})
+
+	t.Run("delegates bumping to the fallback estimator", func(t *testing.T) {
+		fallback := mocks.NewEvmEstimator(t)
+		fallback.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(10), gasLimit, maxPrice, mock.Anything).Return(assets.NewWeiI(12), gasLimit, nil).Once()
+
+		m := gas.NewMempoolEstimator(logger.Test(t), nil, cfg, chainID, fallback)
+		bumped, _, err := m.BumpLegacyGas(tests.Context(t), assets.NewWeiI(10), gasLimit, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(12), bumped)
+	})
+}
@@ -147,6 +147,65 @@ func (_c *EvmFeeEstimator_Close_Call) RunAndReturn(run func() error) *EvmFeeEsti
 	return _c
 }
 
+// GetBlobFee provides a mock function with given fields: ctx, maxFeePrice
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	ret := _m.Called(ctx, maxFeePrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) (*assets.Wei, error)); ok {
+		return rf(ctx, maxFeePrice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) *assets.Wei); ok {
+		r0 = rf(ctx, maxFeePrice)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei) error); ok {
+		r1 = rf(ctx, maxFeePrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_GetBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobFee'
+type EvmFeeEstimator_GetBlobFee_Call struct {
+	*mock.Call
+}
+
+// GetBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxFeePrice *assets.Wei
+func (_e *EvmFeeEstimator_Expecter) GetBlobFee(ctx interface{}, maxFeePrice interface{}) *EvmFeeEstimator_GetBlobFee_Call {
+	return &EvmFeeEstimator_GetBlobFee_Call{Call: _e.mock.On("GetBlobFee", ctx, maxFeePrice)}
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Run(run func(ctx context.Context, maxFeePrice *assets.Wei)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*assets.Wei))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Return(blobFeeCap *assets.Wei, err error) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(blobFeeCap, err)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) RunAndReturn(run func(context.Context, *assets.Wei) (*assets.Wei, error)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
@@ -230,14 +289,14 @@ func (_c *EvmFeeEstimator_GetFee_Call) RunAndReturn(run func(context.Context, []
 	return _c
 }
 
-// GetMaxCost provides a mock function with given fields: ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
-func (_m *EvmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (*big.Int, error) {
+// GetMaxCost provides a mock function with given fields: ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress, opts
+func (_m *EvmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, blobCount int, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (*big.Int, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	_ca = append(_ca, ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
@@ -247,19 +306,19 @@ func (_m *EvmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, ca
 
 	var r0 *big.Int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, assets.Eth, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (*big.Int, error)); ok {
-		return rf(ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, assets.Eth, []byte, int, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (*big.Int, error)); ok {
+		return rf(ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, assets.Eth, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) *big.Int); ok {
-		r0 = rf(ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, assets.Eth, []byte, int, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) *big.Int); ok {
+		r0 = rf(ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, assets.Eth, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) error); ok {
-		r1 = rf(ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, assets.Eth, []byte, int, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) error); ok {
+		r1 = rf(ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -276,25 +335,26 @@ type EvmFeeEstimator_GetMaxCost_Call struct {
 //   - ctx context.Context
 //   - amount assets.Eth
 //   - calldata []byte
+//   - blobCount int
 //   - feeLimit uint64
 //   - maxFeePrice *assets.Wei
 //   - fromAddress *common.Address
 //   - toAddress *common.Address
 //   - opts ...types.Opt
-func (_e *EvmFeeEstimator_Expecter) GetMaxCost(ctx interface{}, amount interface{}, calldata interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}, opts ...interface{}) *EvmFeeEstimator_GetMaxCost_Call {
+func (_e *EvmFeeEstimator_Expecter) GetMaxCost(ctx interface{}, amount interface{}, calldata interface{}, blobCount interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}, opts ...interface{}) *EvmFeeEstimator_GetMaxCost_Call {
 	return &EvmFeeEstimator_GetMaxCost_Call{Call: _e.mock.On("GetMaxCost",
-		append([]interface{}{ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress}, opts...)...)}
+		append([]interface{}{ctx, amount, calldata, blobCount, feeLimit, maxFeePrice, fromAddress, toAddress}, opts...)...)}
 }
 
-func (_c *EvmFeeEstimator_GetMaxCost_Call) Run(run func(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt)) *EvmFeeEstimator_GetMaxCost_Call {
+func (_c *EvmFeeEstimator_GetMaxCost_Call) Run(run func(ctx context.Context, amount assets.Eth, calldata []byte, blobCount int, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt)) *EvmFeeEstimator_GetMaxCost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]types.Opt, len(args)-7)
-		for i, a := range args[7:] {
+		variadicArgs := make([]types.Opt, len(args)-8)
+		for i, a := range args[8:] {
 			if a != nil {
 				variadicArgs[i] = a.(types.Opt)
 			}
 		}
-		run(args[0].(context.Context), args[1].(assets.Eth), args[2].([]byte), args[3].(uint64), args[4].(*assets.Wei), args[5].(*common.Address), args[6].(*common.Address), variadicArgs...)
+		run(args[0].(context.Context), args[1].(assets.Eth), args[2].([]byte), args[3].(int), args[4].(uint64), args[5].(*assets.Wei), args[6].(*common.Address), args[7].(*common.Address), variadicArgs...)
 	})
 	return _c
 }
@@ -304,7 +364,7 @@ func (_c *EvmFeeEstimator_GetMaxCost_Call) Return(_a0 *big.Int, _a1 error) *EvmF
 	return _c
 }
 
-func (_c *EvmFeeEstimator_GetMaxCost_Call) RunAndReturn(run func(context.Context, assets.Eth, []byte, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (*big.Int, error)) *EvmFeeEstimator_GetMaxCost_Call {
+func (_c *EvmFeeEstimator_GetMaxCost_Call) RunAndReturn(run func(context.Context, assets.Eth, []byte, int, uint64, *assets.Wei, *common.Address, *common.Address, ...types.Opt) (*big.Int, error)) *EvmFeeEstimator_GetMaxCost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 
@@ -37,9 +38,11 @@ type EvmFeeEstimator interface {
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
+	// GetBlobFee returns the fee cap per unit of blob gas for an EIP-4844 blob transaction, based on the blob base fee of the next block.
+	GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (blobFeeCap *assets.Wei, err error)
 
-	// GetMaxCost returns the total value = max price x fee units + transferred value
-	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error)
+	// GetMaxCost returns the total value = max price x fee units + blob fee cap x blob gas + transferred value
+	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, blobCount int, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error)
 }
 
 type feeEstimatorClient interface {
@@ -123,6 +126,25 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
 		}
+	case "Mempool":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			fcfg := FeeHistoryEstimatorConfig{
+				BumpPercent:      geCfg.BumpPercent(),
+				CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
+				EIP1559:          geCfg.EIP1559DynamicFees(),
+				BlockHistorySize: uint64(geCfg.BlockHistory().BlockHistorySize()),
+				RewardPercentile: float64(geCfg.BlockHistory().TransactionPercentile()),
+			}
+			mcfg := MempoolEstimatorConfig{
+				CacheTimeout:   geCfg.FeeHistory().CacheTimeout(),
+				EIP1559:        geCfg.EIP1559DynamicFees(),
+				TargetBlocks:   uint64(geCfg.Mempool().TargetBlocks()),
+				Percentile:     geCfg.Mempool().Percentile(),
+				TxPoolInterval: geCfg.Mempool().TxPoolInterval(),
+			}
+			fallback := NewFeeHistoryEstimator(lggr, ethClient, fcfg, ethClient.ConfiguredChainID(), l1Oracle)
+			return NewMempoolEstimator(lggr, ethClient, mcfg, ethClient.ConfiguredChainID(), fallback)
+		}
 
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
@@ -180,9 +202,14 @@ var _ feetypes.Fee = (*EvmFee)(nil)
 type EvmFee struct {
 	GasPrice *assets.Wei
 	DynamicFee
+	// BlobFeeCap is the max fee per unit of blob gas, only set for EIP-4844 blob transactions
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s, BlobFeeCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap)
 }
 
@@ -305,7 +332,7 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 	return
 }
 
-func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
+func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, blobCount int, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
 	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -319,6 +346,14 @@ func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, cal
 	}
 
 	fee := new(big.Int).Mul(gasPrice.ToInt(), big.NewInt(int64(gasLimit)))
+	if blobCount > 0 {
+		blobFeeCap, err := e.GetBlobFee(ctx, maxFeePrice)
+		if err != nil {
+			return nil, err
+		}
+		blobGas := new(big.Int).SetUint64(uint64(blobCount) * params.BlobTxBlobGasPerBlob)
+		fee.Add(fee, blobGas.Mul(blobGas, blobFeeCap.ToInt()))
+	}
 	amountWithFees := new(big.Int).Add(amount.ToInt(), fee)
 	return amountWithFees, nil
 }
@@ -345,6 +380,9 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
 		bumpedFee.GasFeeCap = bumpedDynamic.GasFeeCap
 		bumpedFee.GasTipCap = bumpedDynamic.GasTipCap
+		if err == nil && originalFee.BlobFeeCap != nil {
+			bumpedFee, err = e.bumpBlobFee(ctx, originalFee, bumpedFee, maxFeePrice)
+		}
 		return
 	}
 
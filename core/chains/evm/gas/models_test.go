@@ -148,7 +148,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		// expect legacy fee data
 		dynamicFees := false
 		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, dynamicFees, geCfg, nil)
-		total, err := estimator.GetMaxCost(ctx, val, nil, gasLimit, nil, nil, nil)
+		total, err := estimator.GetMaxCost(ctx, val, nil, 0, gasLimit, nil, nil, nil)
 		require.NoError(t, err)
 		fee := new(big.Int).Mul(legacyFee.ToInt(), big.NewInt(int64(gasLimit)))
 		fee, _ = new(big.Float).Mul(new(big.Float).SetInt(fee), big.NewFloat(float64(limitMultiplier))).Int(nil)
@@ -157,7 +157,7 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		// expect dynamic fee data
 		dynamicFees = true
 		estimator = gas.NewEvmFeeEstimator(lggr, getRootEst, dynamicFees, geCfg, nil)
-		total, err = estimator.GetMaxCost(ctx, val, nil, gasLimit, nil, nil, nil)
+		total, err = estimator.GetMaxCost(ctx, val, nil, 0, gasLimit, nil, nil, nil)
 		require.NoError(t, err)
 		fee = new(big.Int).Mul(dynamicFee.GasFeeCap.ToInt(), big.NewInt(10))
 		fee, _ = new(big.Float).Mul(new(big.Float).SetInt(fee), big.NewFloat(float64(limitMultiplier))).Int(nil)
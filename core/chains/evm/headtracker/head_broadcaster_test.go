@@ -75,7 +75,9 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 	servicetest.Run(t, mailMon)
 	hb := headtracker.NewHeadBroadcaster(logger)
 	servicetest.Run(t, hb)
-	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg.EVM(), evmCfg.EVM().HeadTracker(), hb, hs, mailMon)
+	rb := headtracker.NewReorgBus(logger, headtracker.NewNullReorgORM())
+	servicetest.Run(t, rb)
+	ht := headtracker.NewHeadTracker(logger, ethClient, evmCfg.EVM(), evmCfg.EVM().HeadTracker(), hb, hs, rb, mailMon)
 	servicetest.Run(t, ht)
 
 	latest1, unsubscribe1 := hb.Subscribe(checker1)
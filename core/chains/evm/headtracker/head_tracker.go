@@ -22,6 +22,7 @@ func NewHeadTracker(
 	htConfig commontypes.HeadTrackerConfig,
 	headBroadcaster httypes.HeadBroadcaster,
 	headSaver httypes.HeadSaver,
+	reorgBus httypes.ReorgBus,
 	mailMon *mailbox.Monitor,
 ) httypes.HeadTracker {
 	return headtracker.NewHeadTracker[*evmtypes.Head, ethereum.Subscription](
@@ -31,6 +32,7 @@ func NewHeadTracker(
 		htConfig,
 		headBroadcaster,
 		headSaver,
+		reorgBus,
 		mailMon,
 		func() *evmtypes.Head { return nil },
 	)
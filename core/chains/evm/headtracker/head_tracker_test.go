@@ -793,6 +793,89 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 	}
 }
 
+func TestHeadTracker_PublishesReorgEvents(t *testing.T) {
+	t.Parallel()
+
+	config := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.FinalityDepth = ptr[uint32](2)
+		c.HeadTracker.MaxBufferSize = ptr[uint32](100)
+		c.HeadTracker.SamplingInterval = commonconfig.MustNewDuration(0)
+	})
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ht := createHeadTracker(t, ethClient, config.EVM(), config.EVM().HeadTracker(), headtracker.NewNullORM())
+	listener := newReorgListener()
+	ht.reorgBus.Subscribe(listener)
+
+	chchHeaders := make(chan testutils.RawSub[*evmtypes.Head], 1)
+	mockEth := &testutils.MockEth{EthClient: ethClient}
+	chHead := make(chan *evmtypes.Head)
+	ethClient.On("SubscribeToHeads", mock.Anything).
+		Return(
+			func(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- testutils.NewRawSub(chHead, sub.Err())
+				return chHead, sub, nil
+			},
+		)
+
+	blocks := NewBlocks(t, 10)
+	// shallow reorg forking after block 4, then deep reorg forking after block 2
+	shallowFork := blocks.ForkAt(t, 5, 2)
+	deepFork := shallowFork.ForkAt(t, 3, 2)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(0)).Return(blocks.Head(0), nil)
+	ethClient.On("HeadByHash", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, hash common.Hash) (*evmtypes.Head, error) {
+			for _, heads := range []map[int64]*evmtypes.Head{blocks.Heads, shallowFork.Heads, deepFork.Heads} {
+				for _, h := range heads {
+					if h.Hash == hash {
+						return h, nil
+					}
+				}
+			}
+			return nil, nil
+		}).Maybe()
+
+	ht.Start(t)
+	headers := <-chchHeaders
+	for i := uint64(1); i <= 6; i++ {
+		headers.TrySend(blocks.Head(i))
+		time.Sleep(tests.TestInterval)
+	}
+
+	for i := uint64(5); i <= 7; i++ {
+		headers.TrySend(shallowFork.Head(i))
+		time.Sleep(tests.TestInterval)
+	}
+	event := listener.await(t)
+	assert.Equal(t, commonht.BlockRef[common.Hash]{Number: 6, Hash: blocks.Head(6).Hash}, event.OldHead)
+	assert.Equal(t, commonht.BlockRef[common.Hash]{Number: 7, Hash: shallowFork.Head(7).Hash}, event.NewHead)
+	require.NotNil(t, event.CommonAncestor)
+	assert.Equal(t, commonht.BlockRef[common.Hash]{Number: 4, Hash: blocks.Head(4).Hash}, *event.CommonAncestor)
+	assert.Equal(t, int64(5), event.FromBlock)
+	assert.Equal(t, int64(6), event.ToBlock)
+	assert.Equal(t, int64(2), event.Depth)
+	assert.Equal(t, int64(4), event.LatestFinalizedBlock)
+	assert.False(t, event.FinalityViolated)
+
+	for i := uint64(3); i <= 8; i++ {
+		headers.TrySend(deepFork.Head(i))
+		time.Sleep(tests.TestInterval)
+	}
+	event = listener.await(t)
+	assert.Equal(t, commonht.BlockRef[common.Hash]{Number: 7, Hash: shallowFork.Head(7).Hash}, event.OldHead)
+	assert.Equal(t, commonht.BlockRef[common.Hash]{Number: 8, Hash: deepFork.Head(8).Hash}, event.NewHead)
+	require.NotNil(t, event.CommonAncestor)
+	assert.Equal(t, int64(2), event.CommonAncestor.Number)
+	assert.Equal(t, int64(3), event.FromBlock)
+	assert.Equal(t, int64(7), event.ToBlock)
+	assert.Equal(t, int64(5), event.Depth)
+	assert.Equal(t, int64(5), event.LatestFinalizedBlock)
+	assert.True(t, event.FinalityViolated)
+	tests.AssertLogEventually(t, ht.observer, "Reorg replaced finalized blocks")
+}
+
 func TestHeadTracker_Backfill(t *testing.T) {
 	t.Parallel()
 	t.Run("Enabled Persistence", func(t *testing.T) {
@@ -1268,12 +1351,14 @@ func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commo
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
+	rb := headtracker.NewReorgBus(lggr, headtracker.NewNullReorgORM())
 	mailMon := mailboxtest.NewMonitor(t)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon),
+		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, rb, mailMon),
 		headBroadcaster: hb,
 		headSaver:       hs,
+		reorgBus:        rb,
 		mailMon:         mailMon,
 		observer:        ob,
 		orm:             orm,
@@ -1286,13 +1371,15 @@ func createHeadTrackerWithChecker(t *testing.T, ethClient *evmclimocks.Client, c
 	hb := headtracker.NewHeadBroadcaster(lggr)
 	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
 	hb.Subscribe(checker)
+	rb := headtracker.NewReorgBus(lggr, headtracker.NewNullReorgORM())
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, rb, mailMon)
 	return &headTrackerUniverse{
 		mu:              new(sync.Mutex),
 		headTracker:     ht,
 		headBroadcaster: hb,
 		headSaver:       hs,
+		reorgBus:        rb,
 		mailMon:         mailMon,
 		observer:        ob,
 		orm:             orm,
@@ -1306,6 +1393,7 @@ type headTrackerUniverse struct {
 	headTracker     httypes.HeadTracker
 	headBroadcaster httypes.HeadBroadcaster
 	headSaver       httypes.HeadSaver
+	reorgBus        httypes.ReorgBus
 	mailMon         *mailbox.Monitor
 	observer        *observer.ObservedLogs
 	orm             headtracker.ORM
@@ -1321,6 +1409,7 @@ func (u *headTrackerUniverse) Start(t *testing.T) {
 	defer u.mu.Unlock()
 	ctx := tests.Context(t)
 	require.NoError(t, u.headBroadcaster.Start(ctx))
+	require.NoError(t, u.reorgBus.Start(ctx))
 	require.NoError(t, u.headTracker.Start(ctx))
 	require.NoError(t, u.mailMon.Start(ctx))
 
@@ -1344,6 +1433,7 @@ func (u *headTrackerUniverse) Stop(t *testing.T) {
 	u.stopped = true
 	require.NoError(t, u.headBroadcaster.Close())
 	require.NoError(t, u.headTracker.Close())
+	require.NoError(t, u.reorgBus.Close())
 	require.NoError(t, u.mailMon.Close())
 }
 
@@ -0,0 +1,173 @@
+package headtracker
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// NewReorgBus creates a ReorgBus persisting the reorg events with orm.
+func NewReorgBus(lggr logger.Logger, orm ReorgORM) httypes.ReorgBus {
+	return headtracker.NewReorgBus[common.Hash](lggr, orm)
+}
+
+// MaxReorgEvents is the number of reorg events kept for each chain. Older events are deleted as new ones are saved,
+// except for the finality violations which were not acknowledged yet.
+const MaxReorgEvents = 1000
+
+// ReorgORM persists the reorgs detected by the HeadTracker.
+type ReorgORM interface {
+	// SaveReorgEvent inserts the reorg event, and deletes the events beyond the latest MaxReorgEvents.
+	SaveReorgEvent(ctx context.Context, event httypes.ReorgEvent) error
+	// ReorgEvents returns up to limit reorg events, most recent first.
+	ReorgEvents(ctx context.Context, limit int64) ([]httypes.ReorgEvent, error)
+	// UnacknowledgedFinalityViolations returns the reorg events which violated finality and were not acknowledged yet,
+	// oldest first.
+	UnacknowledgedFinalityViolations(ctx context.Context) ([]httypes.ReorgEvent, error)
+	// AcknowledgeFinalityViolations marks the finality violations detected up to detectedBefore as acknowledged, and
+	// returns them.
+	AcknowledgeFinalityViolations(ctx context.Context, detectedBefore time.Time) ([]httypes.ReorgEvent, error)
+}
+
+var _ ReorgORM = &DbReorgORM{}
+
+type DbReorgORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+// NewReorgORM creates a ReorgORM scoped to chainID.
+func NewReorgORM(chainID big.Int, ds sqlutil.DataSource) *DbReorgORM {
+	return &DbReorgORM{
+		chainID: ubig.Big(chainID),
+		ds:      ds,
+	}
+}
+
+type dbReorgEvent struct {
+	OldHeadNumber        int64
+	OldHeadHash          common.Hash
+	NewHeadNumber        int64
+	NewHeadHash          common.Hash
+	CommonAncestorNumber *int64
+	CommonAncestorHash   *common.Hash
+	FromBlock            int64
+	ToBlock              int64
+	Depth                int64
+	LatestFinalizedBlock int64
+	FinalityViolated     bool
+	DetectedAt           time.Time
+}
+
+func (e dbReorgEvent) toReorgEvent() httypes.ReorgEvent {
+	event := httypes.ReorgEvent{
+		OldHead:              headtracker.BlockRef[common.Hash]{Number: e.OldHeadNumber, Hash: e.OldHeadHash},
+		NewHead:              headtracker.BlockRef[common.Hash]{Number: e.NewHeadNumber, Hash: e.NewHeadHash},
+		FromBlock:            e.FromBlock,
+		ToBlock:              e.ToBlock,
+		Depth:                e.Depth,
+		LatestFinalizedBlock: e.LatestFinalizedBlock,
+		FinalityViolated:     e.FinalityViolated,
+		DetectedAt:           e.DetectedAt,
+	}
+	if e.CommonAncestorNumber != nil && e.CommonAncestorHash != nil {
+		event.CommonAncestor = &headtracker.BlockRef[common.Hash]{Number: *e.CommonAncestorNumber, Hash: *e.CommonAncestorHash}
+	}
+	return event
+}
+
+func (orm *DbReorgORM) SaveReorgEvent(ctx context.Context, event httypes.ReorgEvent) error {
+	var ancestorNumber *int64
+	var ancestorHash *common.Hash
+	if event.CommonAncestor != nil {
+		ancestorNumber, ancestorHash = &event.CommonAncestor.Number, &event.CommonAncestor.Hash
+	}
+	query := `
+	INSERT INTO evm.reorg_events (evm_chain_id, old_head_number, old_head_hash, new_head_number, new_head_hash,
+		common_ancestor_number, common_ancestor_hash, from_block, to_block, depth, latest_finalized_block, finality_violated, detected_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	_, err := orm.ds.ExecContext(ctx, query, orm.chainID, event.OldHead.Number, event.OldHead.Hash, event.NewHead.Number,
+		event.NewHead.Hash, ancestorNumber, ancestorHash, event.FromBlock, event.ToBlock, event.Depth,
+		event.LatestFinalizedBlock, event.FinalityViolated, event.DetectedAt)
+	if err != nil {
+		return pkgerrors.Wrap(err, "SaveReorgEvent failed to insert reorg event")
+	}
+	_, err = orm.ds.ExecContext(ctx, `DELETE FROM evm.reorg_events
+	WHERE evm_chain_id = $1 AND NOT (finality_violated AND acknowledged_at IS NULL) AND id IN (
+		SELECT id FROM evm.reorg_events WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC OFFSET $2
+	)`, orm.chainID, MaxReorgEvents)
+	return pkgerrors.Wrap(err, "SaveReorgEvent failed to delete old reorg events")
+}
+
+const reorgEventFields = `old_head_number, old_head_hash, new_head_number, new_head_hash, common_ancestor_number,
+	common_ancestor_hash, from_block, to_block, depth, latest_finalized_block, finality_violated, detected_at`
+
+func (orm *DbReorgORM) ReorgEvents(ctx context.Context, limit int64) ([]httypes.ReorgEvent, error) {
+	var rows []dbReorgEvent
+	err := orm.ds.SelectContext(ctx, &rows, `SELECT `+reorgEventFields+` FROM evm.reorg_events
+	WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC LIMIT $2`, orm.chainID, limit)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "ReorgEvents failed")
+	}
+	return toReorgEvents(rows), nil
+}
+
+func (orm *DbReorgORM) UnacknowledgedFinalityViolations(ctx context.Context) ([]httypes.ReorgEvent, error) {
+	var rows []dbReorgEvent
+	err := orm.ds.SelectContext(ctx, &rows, `SELECT `+reorgEventFields+` FROM evm.reorg_events
+	WHERE evm_chain_id = $1 AND finality_violated AND acknowledged_at IS NULL ORDER BY id`, orm.chainID)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "UnacknowledgedFinalityViolations failed")
+	}
+	return toReorgEvents(rows), nil
+}
+
+func (orm *DbReorgORM) AcknowledgeFinalityViolations(ctx context.Context, detectedBefore time.Time) ([]httypes.ReorgEvent, error) {
+	var rows []dbReorgEvent
+	err := orm.ds.SelectContext(ctx, &rows, `UPDATE evm.reorg_events SET acknowledged_at = NOW()
+	WHERE evm_chain_id = $1 AND finality_violated AND acknowledged_at IS NULL AND detected_at <= $2 RETURNING `+reorgEventFields, orm.chainID, detectedBefore)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "AcknowledgeFinalityViolations failed")
+	}
+	return toReorgEvents(rows), nil
+}
+
+func toReorgEvents(rows []dbReorgEvent) []httypes.ReorgEvent {
+	events := make([]httypes.ReorgEvent, len(rows))
+	for i := range rows {
+		events[i] = rows[i].toReorgEvent()
+	}
+	return events
+}
+
+type nullReorgORM struct{}
+
+func NewNullReorgORM() ReorgORM {
+	return &nullReorgORM{}
+}
+
+func (orm *nullReorgORM) SaveReorgEvent(ctx context.Context, event httypes.ReorgEvent) error {
+	return nil
+}
+
+func (orm *nullReorgORM) ReorgEvents(ctx context.Context, limit int64) ([]httypes.ReorgEvent, error) {
+	return nil, nil
+}
+
+func (orm *nullReorgORM) UnacknowledgedFinalityViolations(ctx context.Context) ([]httypes.ReorgEvent, error) {
+	return nil, nil
+}
+
+func (orm *nullReorgORM) AcknowledgeFinalityViolations(ctx context.Context, detectedBefore time.Time) ([]httypes.ReorgEvent, error) {
+	return nil, nil
+}
@@ -0,0 +1,158 @@
+package headtracker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonht "github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type reorgListener struct {
+	events chan httypes.ReorgEvent
+}
+
+func newReorgListener() *reorgListener {
+	return &reorgListener{events: make(chan httypes.ReorgEvent, 10)}
+}
+
+func (l *reorgListener) OnReorg(_ context.Context, event httypes.ReorgEvent) {
+	l.events <- event
+}
+
+func (l *reorgListener) await(t *testing.T) httypes.ReorgEvent {
+	select {
+	case event := <-l.events:
+		return event
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for reorg event")
+		return httypes.ReorgEvent{}
+	}
+}
+
+type failingReorgORM struct {
+	headtracker.ReorgORM
+}
+
+func (failingReorgORM) SaveReorgEvent(context.Context, httypes.ReorgEvent) error {
+	return errors.New("db is down")
+}
+
+func newReorgEvent(oldHead, newHead, ancestor int64) httypes.ReorgEvent {
+	return httypes.ReorgEvent{
+		OldHead:              commonht.BlockRef[common.Hash]{Number: oldHead, Hash: testutils.NewHash()},
+		NewHead:              commonht.BlockRef[common.Hash]{Number: newHead, Hash: testutils.NewHash()},
+		CommonAncestor:       &commonht.BlockRef[common.Hash]{Number: ancestor, Hash: testutils.NewHash()},
+		FromBlock:            ancestor + 1,
+		ToBlock:              oldHead,
+		Depth:                oldHead - ancestor,
+		LatestFinalizedBlock: ancestor - 1,
+		DetectedAt:           time.Now().UTC().Truncate(time.Microsecond),
+	}
+}
+
+func TestReorgORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewReorgORM(*testutils.FixtureChainID, db)
+	ctx := tests.Context(t)
+
+	events, err := orm.ReorgEvents(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	first := newReorgEvent(10, 11, 7)
+	second := newReorgEvent(20, 21, 0)
+	second.CommonAncestor = nil
+	second.FinalityViolated = true
+	second.DetectedAt = first.DetectedAt.Add(time.Second)
+	require.NoError(t, orm.SaveReorgEvent(ctx, first))
+	require.NoError(t, orm.SaveReorgEvent(ctx, second))
+
+	// events of other chains are ignored
+	otherORM := headtracker.NewReorgORM(*testutils.SimulatedChainID, db)
+	require.NoError(t, otherORM.SaveReorgEvent(ctx, newReorgEvent(30, 31, 29)))
+
+	events, err = orm.ReorgEvents(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, second, events[0])
+	assert.Equal(t, first, events[1])
+
+	events, err = orm.ReorgEvents(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, second, events[0])
+	t.Run("keeps the latest MaxReorgEvents and the unacknowledged finality violations", func(t *testing.T) {
+		for i := int64(0); i < headtracker.MaxReorgEvents; i++ {
+			event := newReorgEvent(100+i, 101+i, 99+i)
+			event.DetectedAt = second.DetectedAt.Add(time.Duration(i+1) * time.Second)
+			require.NoError(t, orm.SaveReorgEvent(ctx, event))
+		}
+
+		events, err := orm.ReorgEvents(ctx, 2*headtracker.MaxReorgEvents)
+		require.NoError(t, err)
+		require.Len(t, events, headtracker.MaxReorgEvents+1)
+		assert.Equal(t, second, events[len(events)-1])
+
+		violations, err := orm.UnacknowledgedFinalityViolations(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []httypes.ReorgEvent{second}, violations)
+	})
+}
+
+func TestReorgBus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("persists and relays events to subscribers", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		orm := headtracker.NewReorgORM(*testutils.FixtureChainID, db)
+		rb := headtracker.NewReorgBus(logger.Test(t), orm)
+		servicetest.Run(t, rb)
+
+		listener1, listener2 := newReorgListener(), newReorgListener()
+		rb.Subscribe(listener1)
+		unsubscribe2 := rb.Subscribe(listener2)
+
+		event := newReorgEvent(10, 11, 7)
+		rb.Publish(event)
+		assert.Equal(t, event, listener1.await(t))
+		assert.Equal(t, event, listener2.await(t))
+
+		events, err := orm.ReorgEvents(tests.Context(t), 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, event, events[0])
+
+		unsubscribe2()
+		event2 := newReorgEvent(20, 21, 15)
+		rb.Publish(event2)
+		assert.Equal(t, event2, listener1.await(t))
+		assert.Empty(t, listener2.events)
+	})
+
+	t.Run("relays events even if they fail to persist", func(t *testing.T) {
+		rb := headtracker.NewReorgBus(logger.Test(t), failingReorgORM{})
+		servicetest.Run(t, rb)
+
+		listener := newReorgListener()
+		rb.Subscribe(listener)
+		event := newReorgEvent(10, 11, 7)
+		rb.Publish(event)
+		assert.Equal(t, event, listener.await(t))
+	})
+}
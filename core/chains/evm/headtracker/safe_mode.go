@@ -0,0 +1,131 @@
+package headtracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+)
+
+// ErrSafeMode is returned when sending transactions on a chain in safe mode.
+var ErrSafeMode = errors.New("chain is in safe mode after a finality violation")
+
+const safeModeHealthCond = "SafeMode"
+
+type safeMode struct {
+	services.Service
+	eng *services.Engine
+
+	orm ReorgORM
+
+	mu sync.RWMutex
+	// violation is the first finality violation which was not acknowledged, nil if the chain is not in safe mode.
+	violation *httypes.ReorgEvent
+	// lastDetectedAt is the detection time of the latest finality violation relayed to SafeMode. Only the violations
+	// detected until then are acknowledged, since the ReorgBus persists the violations before relaying them.
+	lastDetectedAt time.Time
+}
+
+var _ httypes.SafeMode = &safeMode{}
+
+// NewSafeMode creates a SafeMode entering safe mode on every finality violation published on the ReorgBus it is
+// subscribed to. Since the violations are persisted with orm, the chain is still in safe mode after a restart until an
+// operator acknowledges them.
+func NewSafeMode(lggr logger.Logger, orm ReorgORM) httypes.SafeMode {
+	s := &safeMode{orm: orm}
+	s.Service, s.eng = services.Config{
+		Name:  "SafeMode",
+		Start: s.start,
+	}.NewServiceEngine(lggr)
+	return s
+}
+
+func (s *safeMode) start(ctx context.Context) error {
+	violations, err := s.orm.UnacknowledgedFinalityViolations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load finality violations: %w", err)
+	}
+	if len(violations) > 0 {
+		s.mu.Lock()
+		s.lastDetectedAt = violations[len(violations)-1].DetectedAt
+		s.mu.Unlock()
+		s.enter(violations[0])
+	}
+	return nil
+}
+
+// OnReorg enters safe mode if the reorg violated finality.
+func (s *safeMode) OnReorg(_ context.Context, event httypes.ReorgEvent) {
+	if event.FinalityViolated {
+		s.enter(event)
+	}
+}
+
+func (s *safeMode) enter(event httypes.ReorgEvent) {
+	s.mu.Lock()
+	if s.violation == nil {
+		s.violation = &event
+	}
+	if event.DetectedAt.After(s.lastDetectedAt) {
+		s.lastDetectedAt = event.DetectedAt
+	}
+	s.mu.Unlock()
+
+	err := s.CheckSafeMode()
+	s.eng.SetHealthCond(safeModeHealthCond, err)
+	s.eng.Criticalw("Chain entered safe mode, transactions are paused until an operator acknowledges the finality violation with `chainlink blocks ack-safe-mode`",
+		"err", err, "fromBlock", event.FromBlock, "toBlock", event.ToBlock, "latestFinalizedBlock", event.LatestFinalizedBlock)
+}
+
+func (s *safeMode) CheckSafeMode() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.checkSafeMode()
+}
+
+// checkSafeMode must be called with mu held.
+func (s *safeMode) checkSafeMode() error {
+	if s.violation == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: blocks %d to %d were reorged out while block %d was finalized, detected at %s",
+		ErrSafeMode, s.violation.FromBlock, s.violation.ToBlock, s.violation.LatestFinalizedBlock, s.violation.DetectedAt)
+}
+
+// Acknowledge acknowledges the finality violations relayed to SafeMode so far, and leaves safe mode unless more
+// violations were persisted in the meantime. Holding mu while updating the orm keeps the state in memory consistent with
+// the persisted one, so that a restart does not change whether the chain is in safe mode.
+func (s *safeMode) Acknowledge(ctx context.Context) ([]httypes.ReorgEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	violations, err := s.orm.AcknowledgeFinalityViolations(ctx, s.lastDetectedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge finality violations: %w", err)
+	}
+	// Violations persisted by the ReorgBus but not relayed yet are still unacknowledged
+	pending, err := s.orm.UnacknowledgedFinalityViolations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load finality violations: %w", err)
+	}
+	wasInSafeMode := s.violation != nil
+	s.violation = nil
+	if len(pending) > 0 {
+		s.violation = &pending[0]
+		s.eng.SetHealthCond(safeModeHealthCond, s.checkSafeMode())
+		s.eng.Warnw("Finality violations acknowledged, but the chain stays in safe mode after more violations", "violations", len(violations), "pending", len(pending))
+		return violations, nil
+	}
+
+	s.eng.ClearHealthCond(safeModeHealthCond)
+	if wasInSafeMode {
+		s.eng.Infow("Finality violations acknowledged, chain left safe mode", "violations", len(violations))
+	}
+	return violations, nil
+}
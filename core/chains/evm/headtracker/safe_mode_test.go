@@ -0,0 +1,130 @@
+package headtracker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+// violationsReorgORM keeps the finality violations in memory.
+type violationsReorgORM struct {
+	headtracker.ReorgORM
+	violations []httypes.ReorgEvent
+}
+
+func (orm *violationsReorgORM) UnacknowledgedFinalityViolations(context.Context) ([]httypes.ReorgEvent, error) {
+	return orm.violations, nil
+}
+
+func (orm *violationsReorgORM) AcknowledgeFinalityViolations(_ context.Context, detectedBefore time.Time) (acknowledged []httypes.ReorgEvent, err error) {
+	var pending []httypes.ReorgEvent
+	for _, violation := range orm.violations {
+		if violation.DetectedAt.After(detectedBefore) {
+			pending = append(pending, violation)
+		} else {
+			acknowledged = append(acknowledged, violation)
+		}
+	}
+	orm.violations = pending
+	return acknowledged, nil
+}
+
+func TestSafeMode(t *testing.T) {
+	t.Parallel()
+
+	violation := newReorgEvent(20, 21, 10)
+	violation.FinalityViolated = true
+
+	t.Run("enters safe mode on finality violations only", func(t *testing.T) {
+		sm := headtracker.NewSafeMode(logger.Test(t), &violationsReorgORM{})
+		servicetest.Run(t, sm)
+		require.NoError(t, sm.CheckSafeMode())
+
+		sm.OnReorg(tests.Context(t), newReorgEvent(10, 11, 7))
+		require.NoError(t, sm.CheckSafeMode())
+		require.NoError(t, sm.Ready())
+
+		sm.OnReorg(tests.Context(t), violation)
+		require.ErrorIs(t, sm.CheckSafeMode(), headtracker.ErrSafeMode)
+		assert.ErrorIs(t, sm.HealthReport()[sm.Name()], headtracker.ErrSafeMode)
+	})
+
+	t.Run("stays in safe mode after a restart until acknowledged", func(t *testing.T) {
+		orm := &violationsReorgORM{violations: []httypes.ReorgEvent{violation}}
+		sm := headtracker.NewSafeMode(logger.Test(t), orm)
+		servicetest.Run(t, sm)
+		require.ErrorIs(t, sm.CheckSafeMode(), headtracker.ErrSafeMode)
+		assert.ErrorContains(t, sm.CheckSafeMode(), "blocks 11 to 20 were reorged out while block 9 was finalized")
+
+		violations, err := sm.Acknowledge(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, []httypes.ReorgEvent{violation}, violations)
+		require.NoError(t, sm.CheckSafeMode())
+		assert.NoError(t, sm.HealthReport()[sm.Name()])
+	})
+
+	t.Run("stays in safe mode if a violation was persisted but not relayed yet", func(t *testing.T) {
+		orm := &violationsReorgORM{violations: []httypes.ReorgEvent{violation}}
+		sm := headtracker.NewSafeMode(logger.Test(t), orm)
+		servicetest.Run(t, sm)
+
+		// The ReorgBus saved the next violation, and is about to relay it
+		next := newReorgEvent(30, 31, 25)
+		next.FinalityViolated = true
+		next.DetectedAt = violation.DetectedAt.Add(time.Second)
+		orm.violations = append(orm.violations, next)
+
+		violations, err := sm.Acknowledge(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, []httypes.ReorgEvent{violation}, violations)
+		require.ErrorIs(t, sm.CheckSafeMode(), headtracker.ErrSafeMode)
+		assert.Equal(t, []httypes.ReorgEvent{next}, orm.violations)
+
+		sm.OnReorg(tests.Context(t), next)
+		violations, err = sm.Acknowledge(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, []httypes.ReorgEvent{next}, violations)
+		require.NoError(t, sm.CheckSafeMode())
+	})
+}
+
+func TestReorgORM_FinalityViolations(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewReorgORM(*testutils.FixtureChainID, db)
+	ctx := tests.Context(t)
+
+	violation := newReorgEvent(20, 21, 10)
+	violation.FinalityViolated = true
+	require.NoError(t, orm.SaveReorgEvent(ctx, newReorgEvent(10, 11, 7)))
+	require.NoError(t, orm.SaveReorgEvent(ctx, violation))
+
+	violations, err := orm.UnacknowledgedFinalityViolations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []httypes.ReorgEvent{violation}, violations)
+
+	violations, err = orm.AcknowledgeFinalityViolations(ctx, violation.DetectedAt.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = orm.AcknowledgeFinalityViolations(ctx, violation.DetectedAt)
+	require.NoError(t, err)
+	assert.Equal(t, []httypes.ReorgEvent{violation}, violations)
+
+	violations, err = orm.UnacknowledgedFinalityViolations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
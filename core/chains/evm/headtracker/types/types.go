@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
 	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
 	htrktypes "github.com/smartcontractkit/chainlink/v2/common/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -19,11 +21,24 @@ type HeadSaver interface {
 	LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error)
 }
 
+// SafeMode pauses the transactions of a chain after a finality violation, until an operator acknowledges it.
+type SafeMode interface {
+	services.Service
+	ReorgListener
+	// CheckSafeMode returns an error describing the finality violation if the chain is in safe mode, or nil.
+	CheckSafeMode() error
+	// Acknowledge takes the chain out of safe mode, and returns the finality violations which were acknowledged.
+	Acknowledge(ctx context.Context) ([]ReorgEvent, error)
+}
+
 // Type Alias for EVM Head Tracker Components
 type (
 	HeadTracker     = headtracker.HeadTracker[*evmtypes.Head, common.Hash]
 	HeadTrackable   = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
 	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	ReorgBus        = headtracker.ReorgBus[common.Hash]
+	ReorgListener   = headtracker.ReorgListener[common.Hash]
+	ReorgEvent      = headtracker.ReorgEvent[common.Hash]
 	Client          = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
 )
@@ -0,0 +1,228 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// backfillJobGrowthThreshold is the number of consecutive chunks a backfill job must process without the RPC rejecting
+// their range before its batch size is doubled again, up to the configured BackfillBatchSize.
+const backfillJobGrowthThreshold = 10
+
+var ErrBackfillJobNotFound = pkgerrors.New("backfill job not found")
+
+// CreateBackfillJob creates a persistent job backfilling the logs of the named filters, or of all registered filters
+// if filterNames is empty, in the block range [fromBlock, toBlock]. If toBlock is 0, the job ends at the latest
+// finalized block. The job runs in the background, processing the blocks in chunks as soon as they are finalized,
+// and resumes where it stopped after a restart. Its progress is available through GetBackfillJob.
+func (lp *logPoller) CreateBackfillJob(ctx context.Context, filterNames []string, fromBlock, toBlock int64) (*BackfillJob, error) {
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to load filters")
+	}
+	for _, name := range filterNames {
+		if _, ok := filters[name]; !ok {
+			return nil, pkgerrors.Errorf("filter %q is not registered", name)
+		}
+	}
+
+	latest, latestFinalizedBlockNumber, err := lp.latestBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if toBlock == 0 {
+		toBlock = latestFinalizedBlockNumber
+	}
+	if fromBlock < 1 || fromBlock > toBlock || toBlock > latest.Number {
+		return nil, pkgerrors.Errorf("Invalid backfill block range [%v, %v], acceptable range [1, %v]", fromBlock, toBlock, latest.Number)
+	}
+
+	job, err := lp.orm.InsertBackfillJob(ctx, filterNames, fromBlock, toBlock, lp.backfillBatchSize)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to create backfill job")
+	}
+	lp.lggr.Infow("Created backfill job", "jobID", job.ID, "filters", filterNames, "fromBlock", fromBlock, "toBlock", toBlock)
+	select {
+	case lp.backfillJobCreated <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// GetBackfillJob returns the backfill job with the given id, or ErrBackfillJobNotFound.
+func (lp *logPoller) GetBackfillJob(ctx context.Context, id int64) (*BackfillJob, error) {
+	job, err := lp.orm.SelectBackfillJob(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBackfillJobNotFound
+	}
+	return job, err
+}
+
+// GetBackfillJobs returns all backfill jobs, the most recent first.
+func (lp *logPoller) GetBackfillJobs(ctx context.Context) ([]BackfillJob, error) {
+	return lp.orm.SelectBackfillJobs(ctx)
+}
+
+func (lp *logPoller) backfillJobsRun() {
+	defer lp.wg.Done()
+	ctx, cancel := lp.stopCh.NewCtx()
+	defer cancel()
+	ticker := services.TickerConfig{
+		Initial:   lp.pollPeriod,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(10 * lp.pollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-lp.backfillJobCreated:
+		}
+		lp.runBackfillJobs(ctx)
+	}
+}
+
+// runBackfillJobs runs the active backfill jobs one after the other, until they reach the saved finalized block.
+// Only finalized blocks are backfilled, so the jobs never race with the reorg handling of the main loop.
+func (lp *logPoller) runBackfillJobs(ctx context.Context) {
+	jobs, err := lp.orm.SelectActiveBackfillJobs(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to load backfill jobs", "err", err)
+		return
+	}
+	if len(jobs) == 0 {
+		return
+	}
+	savedFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to get saved finalized block for backfill jobs", "err", err)
+		return
+	}
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to load filters for backfill jobs", "err", err)
+		return
+	}
+	for i := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		lp.runBackfillJob(ctx, &jobs[i], filters, savedFinalizedBlockNumber)
+	}
+}
+
+func (lp *logPoller) runBackfillJob(ctx context.Context, job *BackfillJob, filters map[string]Filter, lastFinalizedBlockNumber int64) {
+	lggr := lp.lggr.With("jobID", job.ID, "fromBlock", job.FromBlock, "toBlock", job.ToBlock)
+	addresses, eventSigs, err := backfillJobFilter(filters, job.FilterNames)
+	if err != nil {
+		lp.failBackfillJob(ctx, lggr, job, err)
+		return
+	}
+
+	successes := 0
+	for job.NextBlock <= job.ToBlock && job.NextBlock <= lastFinalizedBlockNumber {
+		from := job.NextBlock
+		to := min(from+job.BatchSize-1, job.ToBlock, lastFinalizedBlockNumber)
+		if job.State == BackfillJobPending {
+			lggr.Infow("Starting backfill job", "filters", job.FilterNames)
+			job.State = BackfillJobRunning
+		}
+
+		q := ethereum.FilterQuery{FromBlock: big.NewInt(from), ToBlock: big.NewInt(to), Addresses: addresses, Topics: [][]common.Hash{eventSigs}}
+		gethLogs, err := lp.ec.FilterLogs(ctx, q)
+		if err == nil {
+			err = lp.insertBackfilledLogs(ctx, gethLogs, from, to)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && client.IsTooManyResults(err, lp.clientErrors) {
+			if job.BatchSize == 1 {
+				lggr.Criticalw("Too many log results in a single block, failed to retrieve logs! Node may be running in a degraded state.", "err", err, "block", from)
+				lp.failBackfillJob(ctx, lggr, job, err)
+				return
+			}
+			job.BatchSize /= 2
+			successes = 0
+			lggr.Warnw("Too many log results, halving block range batch size of backfill job", "err", err, "from", from, "to", to, "newBatchSize", job.BatchSize)
+		} else if err != nil {
+			// The chunk is retried on the next run, so a transient RPC or database error does not fail the job.
+			lggr.Warnw("Unable to backfill logs, retrying later", "err", err, "from", from, "to", to)
+			msg := err.Error()
+			job.Error = &msg
+			lp.saveBackfillJob(ctx, lggr, job)
+			return
+		} else {
+			job.NextBlock = to + 1
+			job.Error = nil
+			successes++
+			if successes >= backfillJobGrowthThreshold && job.BatchSize < lp.backfillBatchSize {
+				job.BatchSize = min(2*job.BatchSize, lp.backfillBatchSize)
+				successes = 0
+			}
+			if job.NextBlock > job.ToBlock {
+				job.State = BackfillJobCompleted
+			}
+		}
+		if !lp.saveBackfillJob(ctx, lggr, job) {
+			return
+		}
+	}
+
+	if job.State == BackfillJobCompleted {
+		lggr.Infow("Backfill job completed", "elapsed", time.Since(job.CreatedAt))
+	} else {
+		lggr.Debugw("Backfill job waiting for blocks to be finalized", "nextBlock", job.NextBlock, "finalizedBlock", lastFinalizedBlockNumber)
+	}
+}
+
+func (lp *logPoller) failBackfillJob(ctx context.Context, lggr logger.SugaredLogger, job *BackfillJob, err error) {
+	lggr.Errorw("Backfill job failed", "err", err, "nextBlock", job.NextBlock)
+	msg := err.Error()
+	job.State = BackfillJobFailed
+	job.Error = &msg
+	lp.saveBackfillJob(ctx, lggr, job)
+}
+
+func (lp *logPoller) saveBackfillJob(ctx context.Context, lggr logger.SugaredLogger, job *BackfillJob) bool {
+	if err := lp.orm.UpdateBackfillJob(ctx, *job); err != nil {
+		lggr.Errorw("Unable to save backfill job progress, retrying later", "err", err, "nextBlock", job.NextBlock)
+		return false
+	}
+	return true
+}
+
+// backfillJobFilter returns the addresses and event signatures of the named filters, or of all filters if filterNames
+// is empty.
+func backfillJobFilter(filters map[string]Filter, filterNames []string) ([]common.Address, []common.Hash, error) {
+	if len(filterNames) > 0 {
+		selected := make(map[string]Filter, len(filterNames))
+		for _, name := range filterNames {
+			filter, ok := filters[name]
+			if !ok {
+				return nil, nil, pkgerrors.Errorf("filter %q is no longer registered", name)
+			}
+			selected[name] = filter
+		}
+		filters = selected
+	}
+	addresses, eventSigs := mergeFilters(filters)
+	if len(addresses) == 0 {
+		return nil, nil, pkgerrors.New("no filters to backfill")
+	}
+	return addresses, eventSigs, nil
+}
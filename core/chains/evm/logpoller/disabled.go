@@ -129,3 +129,23 @@ func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) CreateBackfillJob(ctx context.Context, filterNames []string, fromBlock, toBlock int64) (*BackfillJob, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) GetBackfillJob(ctx context.Context, id int64) (*BackfillJob, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) GetBackfillJobs(ctx context.Context) ([]BackfillJob, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) Subscribe(ctx context.Context, filter query.Expression, confs evmtypes.Confirmations) (<-chan SubscriptionEvent, func(), error) {
+	return nil, nil, ErrDisabled
+}
+
+func (d disabled) LogsStats(ctx context.Context) ([]FilterLogsStats, error) {
+	return nil, ErrDisabled
+}
@@ -16,7 +16,12 @@
 //     despite node crashes and reorgs. The granularity of the filter is always at least one block (more when backfilling).
 //   - Old logs stored in the db will only be deleted if all filters matching them have explicit retention periods set, and all
 //     of them have expired.  Default retention of 0 on any matching filter guarantees permanent retention.
+//     Similarly, the data of old logs is dropped, keeping only their topics and tx hash, only once they are older
+//     than the CompactAfter of every filter matching them.
 //   - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 //     with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
 //     existing logs.
+//   - After calling Subscribe, every log matching the query is delivered exactly once on the subscription channel as
+//     soon as it reaches the requested confirmation depth. Reorgs removing delivered logs are notified on the same
+//     channel, and the logs of the new canonical blocks are delivered again.
 package logpoller
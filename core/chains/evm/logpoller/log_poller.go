@@ -48,6 +48,11 @@ type LogPoller interface {
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]LogPollerBlock, error)
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	CreateBackfillJob(ctx context.Context, filterNames []string, fromBlock, toBlock int64) (*BackfillJob, error)
+	GetBackfillJob(ctx context.Context, id int64) (*BackfillJob, error)
+	GetBackfillJobs(ctx context.Context) ([]BackfillJob, error)
+	LogsStats(ctx context.Context) ([]FilterLogsStats, error)
+	Subscribe(ctx context.Context, filter query.Expression, confs evmtypes.Confirmations) (<-chan SubscriptionEvent, func(), error)
 
 	// General querying
 	Logs(ctx context.Context, start, end int64, eventSig common.Hash, address common.Address) ([]Log, error)
@@ -80,6 +85,7 @@ type LogPollerTest interface {
 	Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery
 	GetReplayFromBlock(ctx context.Context, requested int64) (int64, error)
 	PruneOldBlocks(ctx context.Context) (bool, error)
+	CompactExpiredLogs(ctx context.Context) (bool, error)
 }
 
 type Client interface {
@@ -115,6 +121,7 @@ type logPoller struct {
 	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
+	logCompactAfter          time.Duration
 	clientErrors             config.ClientErrors
 	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
 	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
@@ -125,10 +132,18 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subscriptionsMu     sync.Mutex
+	subscriptions       map[int64]*subscription
+	subscriptionID      int64
+	subscriptionReorgs  int64 // number of reorgs notified to the subscriptions, to discard the logs selected before one
+	subscriptionsClosed bool
+
 	replayStart    chan int64
 	replayComplete chan error
-	stopCh         services.StopChan
-	wg             sync.WaitGroup
+	// backfillJobCreated wakes up the worker running the backfill jobs
+	backfillJobCreated chan struct{}
+	stopCh             services.StopChan
+	wg                 sync.WaitGroup
 	// This flag is raised whenever the log poller detects that the chain's finality has been violated.
 	// It can happen when reorg is deeper than the latest finalized block that LogPoller saw in a previous PollAndSave tick.
 	// Usually the only way to recover is to manually remove the offending logs and block from the database.
@@ -147,6 +162,7 @@ type Opts struct {
 	KeepFinalizedBlocksDepth int64
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
+	LogCompactAfter          time.Duration // default CompactAfter of the filters which don't set it
 	ClientErrors             config.ClientErrors
 }
 
@@ -169,6 +185,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		lggr:                     logger.Sugared(logger.Named(lggr, "LogPoller")),
 		replayStart:              make(chan int64),
 		replayComplete:           make(chan error),
+		backfillJobCreated:       make(chan struct{}, 1),
 		pollPeriod:               opts.PollPeriod,
 		backupPollerBlockDelay:   opts.BackupPollerBlockDelay,
 		finalityDepth:            opts.FinalityDepth,
@@ -177,8 +194,10 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		rpcBatchSize:             opts.RpcBatchSize,
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
+		logCompactAfter:          opts.LogCompactAfter,
 		clientErrors:             opts.ClientErrors,
 		filters:                  make(map[string]Filter),
+		subscriptions:            make(map[int64]*subscription),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
@@ -193,6 +212,7 @@ type Filter struct {
 	Retention    time.Duration      // maximum amount of time to retain logs
 	MaxLogsKept  uint64             // maximum number of logs to retain ( 0 = unlimited )
 	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
+	CompactAfter time.Duration      // amount of time after which only the topics and tx hash of logs are kept ( 0 = never )
 }
 
 // FilterName is a suggested convenience function for clients to construct unique filter names
@@ -223,6 +243,9 @@ func (filter *Filter) Contains(other *Filter) bool {
 	if other.MaxLogsKept != filter.MaxLogsKept {
 		return false
 	}
+	if other.CompactAfter != filter.CompactAfter {
+		return false
+	}
 	addresses := make(map[common.Address]interface{})
 	for _, addr := range filter.Addresses {
 		addresses[addr] = struct{}{}
@@ -279,6 +302,10 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 		}
 	}
 
+	if filter.CompactAfter == 0 {
+		filter.CompactAfter = lp.logCompactAfter
+	}
+
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
 
@@ -367,14 +394,25 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 	if !lp.filterDirty {
 		return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{lp.cachedEventSigs}, Addresses: lp.cachedAddresses}
 	}
-	var (
-		addresses  []common.Address
-		eventSigs  []common.Hash
-		addressMp  = make(map[common.Address]struct{})
-		eventSigMp = make(map[common.Hash]struct{})
-	)
-	// Merge filters.
-	for _, filter := range lp.filters {
+	addresses, eventSigs := mergeFilters(lp.filters)
+	if len(eventSigs) == 0 && len(addresses) == 0 {
+		// If no filter specified, ignore everything.
+		// This allows us to keep the log poller up and running with no filters present (e.g. no jobs on the node),
+		// then as jobs are added dynamically start using their filters.
+		addresses = []common.Address{common.HexToAddress("0x0000000000000000000000000000000000000000")}
+		eventSigs = []common.Hash{}
+	}
+	lp.cachedAddresses = addresses
+	lp.cachedEventSigs = eventSigs
+	lp.filterDirty = false
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+}
+
+// mergeFilters returns the sorted union of the addresses and event signatures of the filters.
+func mergeFilters(filters map[string]Filter) (addresses []common.Address, eventSigs []common.Hash) {
+	addressMp := make(map[common.Address]struct{})
+	eventSigMp := make(map[common.Hash]struct{})
+	for _, filter := range filters {
 		for _, addr := range filter.Addresses {
 			addressMp[addr] = struct{}{}
 		}
@@ -394,17 +432,7 @@ func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 	sort.Slice(eventSigs, func(i, j int) bool {
 		return bytes.Compare(eventSigs[i][:], eventSigs[j][:]) < 0
 	})
-	if len(eventSigs) == 0 && len(addresses) == 0 {
-		// If no filter specified, ignore everything.
-		// This allows us to keep the log poller up and running with no filters present (e.g. no jobs on the node),
-		// then as jobs are added dynamically start using their filters.
-		addresses = []common.Address{common.HexToAddress("0x0000000000000000000000000000000000000000")}
-		eventSigs = []common.Hash{}
-	}
-	lp.cachedAddresses = addresses
-	lp.cachedEventSigs = eventSigs
-	lp.filterDirty = false
-	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: [][]common.Hash{eventSigs}, Addresses: addresses}
+	return addresses, eventSigs
 }
 
 // Replay signals that the poller should resume from a new block.
@@ -504,9 +532,10 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 
 func (lp *logPoller) Start(context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
-		lp.wg.Add(2)
+		lp.wg.Add(3)
 		go lp.run()
 		go lp.backgroundWorkerRun()
+		go lp.backfillJobsRun()
 		return nil
 	})
 }
@@ -519,6 +548,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -617,6 +647,7 @@ func (lp *logPoller) run() {
 			return
 		case fromBlockReq := <-lp.replayStart:
 			lp.handleReplayRequest(ctx, fromBlockReq, filtersLoaded)
+			lp.dispatchSubscriptions(ctx)
 		case <-logPollTicker.C:
 			if !filtersLoaded {
 				if err := lp.loadFilters(ctx); err != nil {
@@ -648,6 +679,7 @@ func (lp *logPoller) run() {
 				start = lastProcessed.BlockNumber + 1
 			}
 			lp.PollAndSaveLogs(ctx, start)
+			lp.dispatchSubscriptions(ctx)
 		case <-backupLogPollTicker.C:
 			if lp.backupPollerBlockDelay == 0 {
 				continue // backup poller is disabled
@@ -731,6 +763,12 @@ func (lp *logPoller) backgroundWorkerRun() {
 				lp.lggr.Debugw("finished pruning expired logs")
 				successfulExpiredLogPrunes++
 			}
+			if allCompacted, err := lp.CompactExpiredLogs(ctx); err != nil {
+				lp.lggr.Errorw("unable to compact expired logs", "err", err)
+			} else if !allCompacted {
+				lp.lggr.Warnw("reached page limit while compacting expired logs")
+				logPruneTick = tickWithDefaultJitter(logPruneShortInterval)
+			}
 		}
 	}
 }
@@ -886,26 +924,34 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 			from -= batchSize // counteract +=batchSize on next loop iteration, so starting block does not change
 			continue
 		}
-		if len(gethLogs) == 0 {
-			continue
-		}
-		blocks, err := lp.blocksFromLogs(ctx, gethLogs, uint64(to))
-		if err != nil {
+		if err = lp.insertBackfilledLogs(ctx, gethLogs, from, to); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		endblock := blocks[len(blocks)-1]
-		if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) {
-			// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
-			blocks = blocks[:len(blocks)-1]
-		}
+// insertBackfilledLogs saves the logs found in the block range [from, to], along with the block to.
+func (lp *logPoller) insertBackfilledLogs(ctx context.Context, gethLogs []types.Log, from, to int64) error {
+	if len(gethLogs) == 0 {
+		return nil
+	}
+	blocks, err := lp.blocksFromLogs(ctx, gethLogs, uint64(to))
+	if err != nil {
+		return err
+	}
 
-		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
-		if err != nil {
-			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
-			return err
-		}
+	endblock := blocks[len(blocks)-1]
+	if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) {
+		// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
+		blocks = blocks[:len(blocks)-1]
+	}
+
+	lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
+	err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+	if err != nil {
+		lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
+		return err
 	}
 	return nil
 }
@@ -982,6 +1028,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifyReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -1192,6 +1239,22 @@ func (lp *logPoller) PruneExpiredLogs(ctx context.Context) (bool, error) {
 	return done, err
 }
 
+// CompactExpiredLogs drops the data of the logs older than the CompactAfter of every filter they match. Returns whether
+// all logs eligible for compaction were compacted. If logPrunePageSize is set to 0, it will always return true unless
+// an actual error is encountered.
+func (lp *logPoller) CompactExpiredLogs(ctx context.Context) (bool, error) {
+	rowsCompacted, err := lp.orm.CompactExpiredLogs(ctx, lp.logPrunePageSize)
+	if err != nil {
+		return false, err
+	}
+	return lp.logPrunePageSize == 0 || rowsCompacted < lp.logPrunePageSize, nil
+}
+
+// LogsStats returns the number of logs stored for each registered filter, and their size in the database.
+func (lp *logPoller) LogsStats(ctx context.Context) ([]FilterLogsStats, error) {
+	return lp.orm.SelectLogsStats(ctx)
+}
+
 // PruneUnmatchedLogs will attempt to remove any logs which no longer match a registered filter. Returns whether all unmatched
 // logs were removed. If logPrunePageSize is set to 0, it will always return true unless an actual error is encountered
 func (lp *logPoller) PruneUnmatchedLogs(ctx context.Context) (bool, error) {
@@ -1525,7 +1588,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.notifyReorg(start)
+	return nil
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -32,6 +33,7 @@ import (
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/log_emitter"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
@@ -149,6 +151,26 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	assert.Len(t, lp.Filter(nil, nil, nil).Topics[0], 0)
 }
 
+func TestLogPoller_RegisterFilter_DefaultCompactAfter(t *testing.T) {
+	t.Parallel()
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	orm := NewORM(chainID, db, lggr)
+	lp := NewLogPoller(orm, nil, lggr, nil, Opts{PollPeriod: time.Hour, LogCompactAfter: time.Hour})
+
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "default", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}}))
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "own", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}, CompactAfter: time.Minute}))
+
+	filters, err := orm.LoadFilters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, filters["default"].CompactAfter)
+	assert.Equal(t, time.Minute, filters["own"].CompactAfter)
+}
+
 func TestLogPoller_ConvertLogs(t *testing.T) {
 	t.Parallel()
 	lggr := logger.Test(t)
@@ -257,6 +279,130 @@ func TestLogPoller_BackupPollerStartup(t *testing.T) {
 	assert.Equal(t, int64(2), lp.backupPollerNextBlock)
 }
 
+// tooManyResultsError is the error returned by RPCs which reject the block range of eth_getLogs.
+type tooManyResultsError struct{}
+
+func (tooManyResultsError) Error() string {
+	return "response size should not greater than 10000000 bytes"
+}
+func (tooManyResultsError) ErrorCode() int { return -32003 }
+
+func TestLogPoller_BackfillJobs(t *testing.T) {
+	t.Parallel()
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	event := EmitterABI.Events["Log1"].ID
+	log1 := types.Log{
+		Index:       0,
+		BlockHash:   common.Hash{},
+		BlockNumber: 3,
+		Topics:      []common.Hash{event},
+		Address:     addr,
+		TxHash:      common.HexToHash("0x1234"),
+		Data:        EvmWord(uint64(300)).Bytes(),
+	}
+
+	// The RPC rejects ranges of more than 2 blocks
+	var ranges [][2]int64
+	ec := evmclimocks.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID)
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(func(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+		from, to := q.FromBlock.Int64(), q.ToBlock.Int64()
+		if to-from+1 > 2 {
+			return nil, tooManyResultsError{}
+		}
+		ranges = append(ranges, [2]int64{from, to})
+		if from <= 3 && to >= 3 {
+			return []types.Log{log1}, nil
+		}
+		return nil, nil
+	})
+	mockBatchCallContext(t, ec)
+
+	headTracker := htMocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+	headTracker.On("LatestAndFinalizedBlock", mock.Anything).Return(&evmtypes.Head{Number: 20}, &evmtypes.Head{Number: 15}, nil)
+
+	lpOpts := Opts{
+		PollPeriod:               time.Hour,
+		FinalityDepth:            5,
+		BackfillBatchSize:        4,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	lp := NewLogPoller(orm, ec, lggr, headTracker, lpOpts)
+	filter := Filter{Name: "backfill filter", EventSigs: []common.Hash{event}, Addresses: []common.Address{addr}}
+	require.NoError(t, lp.RegisterFilter(ctx, filter))
+
+	t.Run("invalid jobs", func(t *testing.T) {
+		_, err := lp.CreateBackfillJob(ctx, []string{"unknown filter"}, 1, 10)
+		require.ErrorContains(t, err, `filter "unknown filter" is not registered`)
+		_, err = lp.CreateBackfillJob(ctx, nil, 10, 5)
+		require.ErrorContains(t, err, "Invalid backfill block range")
+		_, err = lp.CreateBackfillJob(ctx, nil, 1, 21)
+		require.ErrorContains(t, err, "Invalid backfill block range")
+		_, err = lp.GetBackfillJob(ctx, 1_000_000)
+		require.ErrorIs(t, err, ErrBackfillJobNotFound)
+	})
+
+	job, err := lp.CreateBackfillJob(ctx, []string{filter.Name}, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, BackfillJobPending, job.State)
+	assert.Equal(t, int64(15), job.ToBlock, "ends at the latest finalized block by default")
+	assert.Equal(t, int64(1), job.NextBlock)
+	assert.Equal(t, lpOpts.BackfillBatchSize, job.BatchSize)
+
+	// Only the blocks up to the saved finalized block are backfilled
+	require.NoError(t, orm.InsertBlock(ctx, utils.RandomHash(), 12, time.Now(), 10))
+	lp.runBackfillJobs(ctx)
+
+	job, err = lp.GetBackfillJob(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, BackfillJobRunning, job.State)
+	assert.Equal(t, int64(11), job.NextBlock)
+	assert.Equal(t, int64(2), job.BatchSize, "batch size is halved when the RPC rejects the range")
+	assert.Nil(t, job.Error)
+	assert.Equal(t, [][2]int64{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}}, ranges)
+
+	logs, err := lp.Logs(ctx, 1, 15, event, addr)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, int64(3), logs[0].BlockNumber)
+
+	// The job resumes where it stopped once more blocks are finalized
+	require.NoError(t, orm.InsertBlock(ctx, utils.RandomHash(), 17, time.Now(), 15))
+	lp.runBackfillJobs(ctx)
+
+	job, err = lp.GetBackfillJob(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, BackfillJobCompleted, job.State)
+	assert.Equal(t, int64(16), job.NextBlock)
+	assert.Equal(t, job.BlocksTotal(), job.BlocksDone())
+	assert.Equal(t, [][2]int64{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {13, 14}, {15, 15}}, ranges)
+
+	t.Run("fails when its filter is unregistered", func(t *testing.T) {
+		job2, err := lp.CreateBackfillJob(ctx, []string{filter.Name}, 1, 15)
+		require.NoError(t, err)
+		require.NoError(t, lp.UnregisterFilter(ctx, filter.Name))
+		lp.runBackfillJobs(ctx)
+
+		job2, err = lp.GetBackfillJob(ctx, job2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, BackfillJobFailed, job2.State)
+		require.NotNil(t, job2.Error)
+		assert.Contains(t, *job2.Error, "no longer registered")
+
+		jobs, err := lp.GetBackfillJobs(ctx)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, job2.ID, jobs[0].ID, "most recent job first")
+	})
+}
+
 func mockBatchCallContext(t *testing.T, ec *evmclimocks.Client) {
 	ec.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		elems := args.Get(1).([]rpc.BatchElem)
@@ -279,6 +425,141 @@ func mockBatchCallContext(t *testing.T, ec *evmclimocks.Client) {
 	})
 }
 
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	otherAddr := common.HexToAddress("0x6e225058950f237371261c985db6bdd6f1b2d1e5")
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+	lp := NewLogPoller(orm, nil, lggr, nil, Opts{PollPeriod: time.Hour, FinalityDepth: 3, BackfillBatchSize: 3, RpcBatchSize: 2, KeepFinalizedBlocksDepth: 1000})
+
+	event := EmitterABI.Events["Log1"].ID
+	// insertBlock saves the block with a log emitted by addr and a log emitted by otherAddr.
+	insertBlock := func(n int64, hash common.Hash) {
+		logs := make([]Log, 0, 2)
+		for i, a := range []common.Address{addr, otherAddr} {
+			logs = append(logs, Log{
+				EvmChainId:     ubig.New(chainID),
+				LogIndex:       int64(i),
+				BlockHash:      hash,
+				BlockNumber:    n,
+				BlockTimestamp: time.Now(),
+				Topics:         [][]byte{event.Bytes()},
+				EventSig:       event,
+				Address:        a,
+				TxHash:         utils.NewHash(),
+				Data:           EvmWord(uint64(n)).Bytes(),
+			})
+		}
+		require.NoError(t, orm.InsertLogsWithBlock(ctx, logs, NewLogPollerBlock(hash, n, time.Now(), max(n-3, 1))))
+	}
+	// receive returns the events waiting in the channel of the subscription.
+	receive := func(ch <-chan SubscriptionEvent) (events []SubscriptionEvent) {
+		for {
+			select {
+			case e, ok := <-ch:
+				if !ok {
+					return events
+				}
+				events = append(events, e)
+			default:
+				return events
+			}
+		}
+	}
+	requireLogs := func(t *testing.T, events []SubscriptionEvent, hashes ...common.Hash) {
+		require.Len(t, events, len(hashes))
+		for i, h := range hashes {
+			require.NotNil(t, events[i].Log)
+			assert.Equal(t, h, events[i].Log.BlockHash)
+			assert.Equal(t, addr, events[i].Log.Address)
+		}
+	}
+
+	_, _, err := lp.Subscribe(ctx, NewAddressFilter(addr), evmtypes.Confirmations(-2))
+	require.ErrorContains(t, err, "invalid confirmations")
+
+	ch, unsubscribe, err := lp.Subscribe(ctx, NewAddressFilter(addr), 2)
+	require.NoError(t, err)
+	finalizedCh, unsubscribeFinalized, err := lp.Subscribe(ctx, NewAddressFilter(addr), evmtypes.Finalized)
+	require.NoError(t, err)
+	defer unsubscribeFinalized()
+
+	hashes := make(map[int64]common.Hash)
+	for n := int64(1); n <= 5; n++ {
+		hashes[n] = utils.NewHash()
+		insertBlock(n, hashes[n])
+	}
+	lp.dispatchSubscriptions(ctx)
+	requireLogs(t, receive(ch), hashes[1], hashes[2], hashes[3])
+	requireLogs(t, receive(finalizedCh), hashes[1], hashes[2])
+
+	// Logs are delivered only once
+	lp.dispatchSubscriptions(ctx)
+	assert.Empty(t, receive(ch))
+	assert.Empty(t, receive(finalizedCh))
+
+	hashes[6] = utils.NewHash()
+	insertBlock(6, hashes[6])
+	lp.dispatchSubscriptions(ctx)
+	requireLogs(t, receive(ch), hashes[4])
+	requireLogs(t, receive(finalizedCh), hashes[3])
+
+	t.Run("reorg", func(t *testing.T) {
+		require.NoError(t, lp.DeleteLogsAndBlocksAfter(ctx, 4))
+		for n := int64(4); n <= 7; n++ {
+			hashes[n] = utils.NewHash()
+			insertBlock(n, hashes[n])
+		}
+		lp.dispatchSubscriptions(ctx)
+		events := receive(ch)
+		require.NotEmpty(t, events)
+		require.NotNil(t, events[0].Reorg)
+		assert.Equal(t, int64(4), events[0].Reorg.BlockNumber)
+		requireLogs(t, events[1:], hashes[4], hashes[5])
+		// The reorg did not affect the finalized logs already delivered
+		requireLogs(t, receive(finalizedCh), hashes[4])
+	})
+
+	t.Run("lagging subscriber", func(t *testing.T) {
+		lagging, unsubscribeLagging, err := lp.Subscribe(ctx, NewAddressFilter(addr), 0)
+		require.NoError(t, err)
+		defer unsubscribeLagging()
+		first := int64(8)
+		last := first + subscriptionBufferSize + 10
+		for n := first; n <= last; n++ {
+			hashes[n] = utils.NewHash()
+			insertBlock(n, hashes[n])
+		}
+		lp.dispatchSubscriptions(ctx)
+		events := receive(lagging)
+		require.Len(t, events, subscriptionBufferSize)
+		lp.dispatchSubscriptions(ctx)
+		events = append(events, receive(lagging)...)
+		require.Len(t, events, int(last-first+1))
+		for i, e := range events {
+			require.NotNil(t, e.Log)
+			assert.Equal(t, first+int64(i), e.Log.BlockNumber)
+		}
+	})
+
+	receive(ch)
+	unsubscribe()
+	_, ok := <-ch
+	assert.False(t, ok, "channel should be closed once unsubscribed")
+	unsubscribe()
+
+	receive(finalizedCh)
+	lp.closeSubscriptions()
+	_, ok = <-finalizedCh
+	assert.False(t, ok, "channel should be closed when the LogPoller is closed")
+	_, _, err = lp.Subscribe(ctx, NewAddressFilter(addr), 0)
+	require.ErrorIs(t, err, ErrLogPollerShutdown)
+}
+
 func TestLogPoller_Replay(t *testing.T) {
 	t.Parallel()
 	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
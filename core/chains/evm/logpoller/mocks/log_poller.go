@@ -76,6 +76,67 @@ func (_c *LogPoller_Close_Call) RunAndReturn(run func() error) *LogPoller_Close_
 	return _c
 }
 
+// CreateBackfillJob provides a mock function with given fields: ctx, filterNames, fromBlock, toBlock
+func (_m *LogPoller) CreateBackfillJob(ctx context.Context, filterNames []string, fromBlock int64, toBlock int64) (*logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx, filterNames, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBackfillJob")
+	}
+
+	var r0 *logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int64, int64) (*logpoller.BackfillJob, error)); ok {
+		return rf(ctx, filterNames, fromBlock, toBlock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int64, int64) *logpoller.BackfillJob); ok {
+		r0 = rf(ctx, filterNames, fromBlock, toBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int64, int64) error); ok {
+		r1 = rf(ctx, filterNames, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_CreateBackfillJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBackfillJob'
+type LogPoller_CreateBackfillJob_Call struct {
+	*mock.Call
+}
+
+// CreateBackfillJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterNames []string
+//   - fromBlock int64
+//   - toBlock int64
+func (_e *LogPoller_Expecter) CreateBackfillJob(ctx interface{}, filterNames interface{}, fromBlock interface{}, toBlock interface{}) *LogPoller_CreateBackfillJob_Call {
+	return &LogPoller_CreateBackfillJob_Call{Call: _e.mock.On("CreateBackfillJob", ctx, filterNames, fromBlock, toBlock)}
+}
+
+func (_c *LogPoller_CreateBackfillJob_Call) Run(run func(ctx context.Context, filterNames []string, fromBlock int64, toBlock int64)) *LogPoller_CreateBackfillJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_CreateBackfillJob_Call) Return(_a0 *logpoller.BackfillJob, _a1 error) *LogPoller_CreateBackfillJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_CreateBackfillJob_Call) RunAndReturn(run func(context.Context, []string, int64, int64) (*logpoller.BackfillJob, error)) *LogPoller_CreateBackfillJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteLogsAndBlocksAfter provides a mock function with given fields: ctx, start
 func (_m *LogPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	ret := _m.Called(ctx, start)
@@ -242,6 +303,123 @@ func (_c *LogPoller_FindLCA_Call) RunAndReturn(run func(context.Context) (*logpo
 	return _c
 }
 
+// GetBackfillJob provides a mock function with given fields: ctx, id
+func (_m *LogPoller) GetBackfillJob(ctx context.Context, id int64) (*logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackfillJob")
+	}
+
+	var r0 *logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*logpoller.BackfillJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *logpoller.BackfillJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_GetBackfillJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackfillJob'
+type LogPoller_GetBackfillJob_Call struct {
+	*mock.Call
+}
+
+// GetBackfillJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *LogPoller_Expecter) GetBackfillJob(ctx interface{}, id interface{}) *LogPoller_GetBackfillJob_Call {
+	return &LogPoller_GetBackfillJob_Call{Call: _e.mock.On("GetBackfillJob", ctx, id)}
+}
+
+func (_c *LogPoller_GetBackfillJob_Call) Run(run func(ctx context.Context, id int64)) *LogPoller_GetBackfillJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_GetBackfillJob_Call) Return(_a0 *logpoller.BackfillJob, _a1 error) *LogPoller_GetBackfillJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_GetBackfillJob_Call) RunAndReturn(run func(context.Context, int64) (*logpoller.BackfillJob, error)) *LogPoller_GetBackfillJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBackfillJobs provides a mock function with given fields: ctx
+func (_m *LogPoller) GetBackfillJobs(ctx context.Context) ([]logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackfillJobs")
+	}
+
+	var r0 []logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]logpoller.BackfillJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []logpoller.BackfillJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_GetBackfillJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackfillJobs'
+type LogPoller_GetBackfillJobs_Call struct {
+	*mock.Call
+}
+
+// GetBackfillJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) GetBackfillJobs(ctx interface{}) *LogPoller_GetBackfillJobs_Call {
+	return &LogPoller_GetBackfillJobs_Call{Call: _e.mock.On("GetBackfillJobs", ctx)}
+}
+
+func (_c *LogPoller_GetBackfillJobs_Call) Run(run func(ctx context.Context)) *LogPoller_GetBackfillJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_GetBackfillJobs_Call) Return(_a0 []logpoller.BackfillJob, _a1 error) *LogPoller_GetBackfillJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_GetBackfillJobs_Call) RunAndReturn(run func(context.Context) ([]logpoller.BackfillJob, error)) *LogPoller_GetBackfillJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBlocksRange provides a mock function with given fields: ctx, numbers
 func (_m *LogPoller) GetBlocksRange(ctx context.Context, numbers []uint64) ([]logpoller.LogPollerBlock, error) {
 	ret := _m.Called(ctx, numbers)
@@ -1484,6 +1662,64 @@ func (_c *LogPoller_LogsDataWordRange_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// LogsStats provides a mock function with given fields: ctx
+func (_m *LogPoller) LogsStats(ctx context.Context) ([]logpoller.FilterLogsStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogsStats")
+	}
+
+	var r0 []logpoller.FilterLogsStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]logpoller.FilterLogsStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []logpoller.FilterLogsStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.FilterLogsStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_LogsStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogsStats'
+type LogPoller_LogsStats_Call struct {
+	*mock.Call
+}
+
+// LogsStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) LogsStats(ctx interface{}) *LogPoller_LogsStats_Call {
+	return &LogPoller_LogsStats_Call{Call: _e.mock.On("LogsStats", ctx)}
+}
+
+func (_c *LogPoller_LogsStats_Call) Run(run func(ctx context.Context)) *LogPoller_LogsStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_LogsStats_Call) Return(_a0 []logpoller.FilterLogsStats, _a1 error) *LogPoller_LogsStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_LogsStats_Call) RunAndReturn(run func(context.Context) ([]logpoller.FilterLogsStats, error)) *LogPoller_LogsStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogsWithSigs provides a mock function with given fields: ctx, start, end, eventSigs, address
 func (_m *LogPoller) LogsWithSigs(ctx context.Context, start int64, end int64, eventSigs []common.Hash, address common.Address) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, start, end, eventSigs, address)
@@ -1809,6 +2045,75 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, filter, confs
+func (_m *LogPoller) Subscribe(ctx context.Context, filter query.Expression, confs types.Confirmations) (<-chan logpoller.SubscriptionEvent, func(), error) {
+	ret := _m.Called(ctx, filter, confs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan logpoller.SubscriptionEvent
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, query.Expression, types.Confirmations) (<-chan logpoller.SubscriptionEvent, func(), error)); ok {
+		return rf(ctx, filter, confs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, query.Expression, types.Confirmations) <-chan logpoller.SubscriptionEvent); ok {
+		r0 = rf(ctx, filter, confs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan logpoller.SubscriptionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, query.Expression, types.Confirmations) func()); ok {
+		r1 = rf(ctx, filter, confs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, query.Expression, types.Confirmations) error); ok {
+		r2 = rf(ctx, filter, confs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LogPoller_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type LogPoller_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter query.Expression
+//   - confs types.Confirmations
+func (_e *LogPoller_Expecter) Subscribe(ctx interface{}, filter interface{}, confs interface{}) *LogPoller_Subscribe_Call {
+	return &LogPoller_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, filter, confs)}
+}
+
+func (_c *LogPoller_Subscribe_Call) Run(run func(ctx context.Context, filter query.Expression, confs types.Confirmations)) *LogPoller_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(query.Expression), args[2].(types.Confirmations))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) Return(_a0 <-chan logpoller.SubscriptionEvent, _a1 func(), _a2 error) *LogPoller_Subscribe_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) RunAndReturn(run func(context.Context, query.Expression, types.Confirmations) (<-chan logpoller.SubscriptionEvent, func(), error)) *LogPoller_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
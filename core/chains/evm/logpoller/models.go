@@ -22,6 +22,54 @@ type LogPollerBlock struct {
 	CreatedAt            time.Time
 }
 
+// BackfillJobState is the state of a BackfillJob.
+type BackfillJobState string
+
+const (
+	BackfillJobPending   BackfillJobState = "pending"
+	BackfillJobRunning   BackfillJobState = "running"
+	BackfillJobCompleted BackfillJobState = "completed"
+	BackfillJobFailed    BackfillJobState = "failed"
+)
+
+// BackfillJob is a persistent request to backfill the logs of a set of filters over a range of finalized blocks.
+// Blocks are processed in chunks of BatchSize starting at NextBlock, so that the job resumes where it stopped after
+// a restart.
+type BackfillJob struct {
+	ID         int64
+	EvmChainId *big.Big
+	// FilterNames are the names of the filters to backfill. All registered filters are backfilled when empty.
+	FilterNames pq.StringArray
+	FromBlock   int64
+	ToBlock     int64
+	// NextBlock is the first block which has not been backfilled yet.
+	NextBlock int64
+	// BatchSize is the current size of the block range of a chunk, reduced when the RPC rejects too large ranges.
+	BatchSize int64
+	State     BackfillJobState
+	Error     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BlocksDone returns the number of blocks which have been backfilled.
+func (j BackfillJob) BlocksDone() int64 {
+	return j.NextBlock - j.FromBlock
+}
+
+// BlocksTotal returns the number of blocks in the range of the job.
+func (j BackfillJob) BlocksTotal() int64 {
+	return j.ToBlock - j.FromBlock + 1
+}
+
+// FilterLogsStats reports the logs stored for a filter. Bytes is the size of their rows, excluding the indexes.
+type FilterLogsStats struct {
+	FilterName    string
+	Logs          int64
+	CompactedLogs int64
+	Bytes         int64
+}
+
 // Log represents an EVM log.
 type Log struct {
 	EvmChainId     *big.Big
@@ -35,6 +83,8 @@ type Log struct {
 	TxHash         common.Hash
 	Data           []byte
 	CreatedAt      time.Time
+	// Compacted is true when the log is older than the CompactAfter of its filters, and its Data was dropped.
+	Compacted bool
 }
 
 func (l *Log) GetTopics() []common.Hash {
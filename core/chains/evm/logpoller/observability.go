@@ -22,6 +22,7 @@ const (
 	create queryType = "create"
 	read   queryType = "read"
 	del    queryType = "delete"
+	update queryType = "update"
 )
 
 var (
@@ -142,6 +143,12 @@ func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64
 	})
 }
 
+func (o *ObservedORM) CompactExpiredLogs(ctx context.Context, limit int64) (int64, error) {
+	return withObservedExecAndRowsAffected(o, "CompactExpiredLogs", update, func() (int64, error) {
+		return o.ORM.CompactExpiredLogs(ctx, limit)
+	})
+}
+
 func (o *ObservedORM) SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error) {
 	return withObservedQueryAndResults[uint64](o, "SelectUnmatchedLogIDs", func() ([]uint64, error) {
 		return o.ORM.SelectUnmatchedLogIDs(ctx, limit)
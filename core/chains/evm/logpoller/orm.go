@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -40,6 +41,7 @@ type ORM interface {
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 	SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error)
 	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
+	CompactExpiredLogs(ctx context.Context, limit int64) (int64, error)
 	SelectExcessLogIDs(ctx context.Context, limit int64) (rowIDs []uint64, err error)
 
 	GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error)
@@ -48,6 +50,13 @@ type ORM interface {
 	SelectLatestBlock(ctx context.Context) (*LogPollerBlock, error)
 	SelectOldestBlock(ctx context.Context, minAllowedBlockNumber int64) (*LogPollerBlock, error)
 
+	InsertBackfillJob(ctx context.Context, filterNames []string, fromBlock, toBlock, batchSize int64) (*BackfillJob, error)
+	UpdateBackfillJob(ctx context.Context, job BackfillJob) error
+	SelectBackfillJob(ctx context.Context, id int64) (*BackfillJob, error)
+	SelectBackfillJobs(ctx context.Context) ([]BackfillJob, error)
+	SelectActiveBackfillJobs(ctx context.Context) ([]BackfillJob, error)
+	SelectLogsStats(ctx context.Context) ([]FilterLogsStats, error)
+
 	SelectLogs(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash) ([]Log, error)
 	SelectLogsWithSigs(ctx context.Context, start, end int64, address common.Address, eventSigs []common.Hash) ([]Log, error)
 	SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error)
@@ -125,6 +134,7 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 		withRetention(filter.Retention).
 		withMaxLogsKept(filter.MaxLogsKept).
 		withLogsPerBlock(filter.LogsPerBlock).
+		withCompactAfter(filter.CompactAfter).
 		withAddressArray(filter.Addresses).
 		withEventSigArray(filter.EventSigs).
 		withTopicArrays(filter.Topic2, filter.Topic3, filter.Topic4).
@@ -144,14 +154,14 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	// https://github.com/jmoiron/sqlx/issues/91, https://github.com/jmoiron/sqlx/issues/428
 	query := fmt.Sprintf(`
 		INSERT INTO evm.log_poller_filters
-	  		(name, evm_chain_id, retention, max_logs_kept, logs_per_block, created_at, address, event %s)
+	  		(name, evm_chain_id, retention, max_logs_kept, logs_per_block, compact_after, created_at, address, event %s)
 		SELECT * FROM
-			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, NOW()) x,
+			(SELECT :name, :evm_chain_id ::::NUMERIC, :retention ::::BIGINT, :max_logs_kept ::::NUMERIC, :logs_per_block ::::NUMERIC, :compact_after ::::BIGINT, NOW()) x,
 			(SELECT unnest(:address_array ::::BYTEA[]) addr) a,
 			(SELECT unnest(:event_sig_array ::::BYTEA[]) ev) e
 			%s
 		ON CONFLICT  (evm.f_log_poller_filter_hash(name, evm_chain_id, address, event, topic2, topic3, topic4))
-		DO UPDATE SET retention=:retention ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC`,
+		DO UPDATE SET retention=:retention ::::BIGINT, max_logs_kept=:max_logs_kept ::::NUMERIC, logs_per_block=:logs_per_block ::::NUMERIC, compact_after=:compact_after ::::BIGINT`,
 		topicsColumns.String(),
 		topicsSql.String())
 
@@ -177,7 +187,8 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 			ARRAY_AGG(DISTINCT topic4 ORDER BY topic4) FILTER(WHERE topic4 IS NOT NULL) AS topic4,
 			MAX(logs_per_block) AS logs_per_block,
 			MAX(retention) AS retention,
-			MAX(max_logs_kept) AS max_logs_kept
+			MAX(max_logs_kept) AS max_logs_kept,
+			MAX(compact_after) AS compact_after
 		FROM evm.log_poller_filters WHERE evm_chain_id = $1
 		GROUP BY name`
 	var rows []Filter
@@ -189,6 +200,58 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	return filters, err
 }
 
+// InsertBackfillJob creates a pending backfill job of the block range [fromBlock, toBlock].
+func (o *DSORM) InsertBackfillJob(ctx context.Context, filterNames []string, fromBlock, toBlock, batchSize int64) (*BackfillJob, error) {
+	if filterNames == nil {
+		filterNames = []string{}
+	}
+	var job BackfillJob
+	err := o.ds.GetContext(ctx, &job, `INSERT INTO evm.log_poller_backfill_jobs
+			(evm_chain_id, filter_names, from_block, to_block, next_block, batch_size, state, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $3, $5, 'pending', NOW(), NOW()) RETURNING *`,
+		ubig.New(o.chainID), pq.StringArray(filterNames), fromBlock, toBlock, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// UpdateBackfillJob saves the progress and state of a backfill job.
+func (o *DSORM) UpdateBackfillJob(ctx context.Context, job BackfillJob) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.log_poller_backfill_jobs
+		SET next_block = $3, batch_size = $4, state = $5, error = $6, updated_at = NOW()
+		WHERE id = $1 AND evm_chain_id = $2`,
+		job.ID, ubig.New(o.chainID), job.NextBlock, job.BatchSize, job.State, job.Error)
+	return err
+}
+
+func (o *DSORM) SelectBackfillJob(ctx context.Context, id int64) (*BackfillJob, error) {
+	var job BackfillJob
+	if err := o.ds.GetContext(ctx, &job,
+		`SELECT * FROM evm.log_poller_backfill_jobs WHERE id = $1 AND evm_chain_id = $2`, id, ubig.New(o.chainID),
+	); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// SelectBackfillJobs returns all backfill jobs for this chain, the most recent first.
+func (o *DSORM) SelectBackfillJobs(ctx context.Context) ([]BackfillJob, error) {
+	var jobs []BackfillJob
+	err := o.ds.SelectContext(ctx, &jobs,
+		`SELECT * FROM evm.log_poller_backfill_jobs WHERE evm_chain_id = $1 ORDER BY id DESC`, ubig.New(o.chainID))
+	return jobs, err
+}
+
+// SelectActiveBackfillJobs returns the pending and running backfill jobs for this chain, in the order they were created.
+func (o *DSORM) SelectActiveBackfillJobs(ctx context.Context) ([]BackfillJob, error) {
+	var jobs []BackfillJob
+	err := o.ds.SelectContext(ctx, &jobs,
+		`SELECT * FROM evm.log_poller_backfill_jobs WHERE evm_chain_id = $1 AND state IN ('pending', 'running') ORDER BY id`,
+		ubig.New(o.chainID))
+	return jobs, err
+}
+
 func blocksQuery(clause string) string {
 	return fmt.Sprintf(`SELECT %s FROM evm.log_poller_blocks %s`, strings.Join(blocksFields[:], ", "), clause)
 }
@@ -522,6 +585,52 @@ func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, erro
 	return result.RowsAffected()
 }
 
+// CompactExpiredLogs drops the data of the logs which have a timestamp older than the compact_after of every filter
+// they match, keeping their topics and tx hash. Logs matching a filter with compact_after=0 are left untouched.
+func (o *DSORM) CompactExpiredLogs(ctx context.Context, limit int64) (int64, error) {
+	limitClause := ""
+	if limit > 0 {
+		limitClause = fmt.Sprintf("LIMIT %d", limit)
+	}
+
+	query := fmt.Sprintf(`
+		WITH rows_to_compact AS (
+			SELECT l.id
+			FROM evm.logs l JOIN (
+				SELECT evm_chain_id, address, event, MAX(compact_after) AS compact_after
+				FROM evm.log_poller_filters
+				WHERE evm_chain_id = $1
+				GROUP BY evm_chain_id, address, event
+				HAVING MIN(compact_after) > 0
+			) r ON l.evm_chain_id = r.evm_chain_id AND l.address = r.address AND l.event_sig = r.event AND NOT l.compacted AND
+				l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.compact_after / 10^9 * interval '1 second') %s
+		) UPDATE evm.logs SET data = '', compacted = TRUE WHERE id IN (SELECT id FROM rows_to_compact)`, limitClause)
+	result, err := o.ds.ExecContext(ctx, query, ubig.New(o.chainID))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// SelectLogsStats returns the number of logs matching each filter, and their size. The logs matching several filters
+// are counted for each of them.
+func (o *DSORM) SelectLogsStats(ctx context.Context) ([]FilterLogsStats, error) {
+	var stats []FilterLogsStats
+	err := o.ds.SelectContext(ctx, &stats, `
+		SELECT f.name AS filter_name,
+			COUNT(l.id) AS logs,
+			COUNT(l.id) FILTER (WHERE l.compacted) AS compacted_logs,
+			COALESCE(SUM(pg_column_size(l.*)) FILTER (WHERE l.id IS NOT NULL), 0) AS bytes
+		FROM (
+			SELECT DISTINCT name, address, event
+			FROM evm.log_poller_filters
+			WHERE evm_chain_id = $1
+		) f LEFT JOIN evm.logs l ON l.evm_chain_id = $1 AND l.address = f.address AND l.event_sig = f.event
+		GROUP BY f.name
+		ORDER BY f.name`, ubig.New(o.chainID))
+	return stats, err
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *DSORM) InsertLogs(ctx context.Context, logs []Log) error {
 	if err := o.validateLogs(logs); err != nil {
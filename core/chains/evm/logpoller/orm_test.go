@@ -703,6 +703,89 @@ func TestORM_SelectExcessLogs(t *testing.T) {
 	assert.Equal(t, int64(8), deleted)
 }
 
+func TestORM_CompactExpiredLogs(t *testing.T) {
+	t.Parallel()
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	o2 := th.ORM2
+	ctx := testutils.Context(t)
+
+	topic := EmitterABI.Events["Log1"].ID
+	addr1 := common.HexToAddress("0x1234")
+	addr2 := common.HexToAddress("0x1235")
+	old := time.Now().Add(-48 * time.Hour)
+
+	require.NoError(t, o1.InsertBlock(ctx, common.HexToHash("0x10"), 10, old, 10))
+	require.NoError(t, o1.InsertBlock(ctx, common.HexToHash("0x11"), 11, time.Now(), 11))
+	require.NoError(t, o1.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithTimestamp(th.ChainID, 0, 10, "0x10", topic.Bytes(), addr1, old),
+		GenLogWithTimestamp(th.ChainID, 1, 10, "0x10", topic.Bytes(), addr2, old),
+		GenLogWithTimestamp(th.ChainID, 0, 11, "0x11", topic.Bytes(), addr1, time.Now()),
+	}))
+	// The same old log on an unrelated chain is not compacted
+	require.NoError(t, o2.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithTimestamp(th.ChainID2, 0, 10, "0x10", topic.Bytes(), addr1, old),
+	}))
+	require.NoError(t, o2.InsertFilter(ctx, logpoller.Filter{Name: "chain 2", Addresses: []common.Address{addr1}, EventSigs: types.HashArray{topic}, CompactAfter: time.Hour}))
+
+	filters := []logpoller.Filter{
+		{Name: "compacted", Addresses: []common.Address{addr1}, EventSigs: types.HashArray{topic}, CompactAfter: time.Hour},
+		// addr2 logs are kept in full, since one of their filters never compacts them
+		{Name: "partially compacted", Addresses: []common.Address{addr2}, EventSigs: types.HashArray{topic}, CompactAfter: time.Hour},
+		{Name: "not compacted", Addresses: []common.Address{addr2}, EventSigs: types.HashArray{topic}},
+		{Name: "no logs", Addresses: []common.Address{common.HexToAddress("0x1236")}, EventSigs: types.HashArray{topic}, CompactAfter: time.Hour},
+	}
+	for _, filter := range filters {
+		require.NoError(t, o1.InsertFilter(ctx, filter))
+	}
+	loaded, err := o1.LoadFilters(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, loaded["compacted"].CompactAfter)
+	assert.Equal(t, time.Duration(0), loaded["not compacted"].CompactAfter)
+
+	compacted, err := o1.CompactExpiredLogs(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), compacted)
+	compacted, err = o1.CompactExpiredLogs(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), compacted, "logs are compacted only once")
+
+	logs, err := o1.SelectLogsByBlockRange(ctx, 10, 11)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	for _, log := range logs {
+		expected := GenLogWithTimestamp(th.ChainID, log.LogIndex, log.BlockNumber, log.BlockHash.Hex(), topic.Bytes(), log.Address, old)
+		assert.Equal(t, expected.Topics, log.Topics)
+		assert.Equal(t, expected.TxHash, log.TxHash)
+		if log.BlockNumber == 10 && log.Address == addr1 {
+			assert.True(t, log.Compacted)
+			assert.Empty(t, log.Data)
+		} else {
+			assert.False(t, log.Compacted)
+			assert.Equal(t, expected.Data, log.Data)
+		}
+	}
+	logs, err = o2.SelectLogsByBlockRange(ctx, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.False(t, logs[0].Compacted)
+
+	stats, err := o1.SelectLogsStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 4)
+	byName := make(map[string]logpoller.FilterLogsStats)
+	for _, s := range stats {
+		byName[s.FilterName] = s
+	}
+	assert.Equal(t, int64(2), byName["compacted"].Logs)
+	assert.Equal(t, int64(1), byName["compacted"].CompactedLogs)
+	assert.Equal(t, int64(1), byName["partially compacted"].Logs)
+	assert.Equal(t, int64(0), byName["partially compacted"].CompactedLogs)
+	assert.Equal(t, byName["partially compacted"].Bytes, byName["not compacted"].Bytes)
+	assert.Positive(t, byName["not compacted"].Bytes)
+	assert.Equal(t, logpoller.FilterLogsStats{FilterName: "no logs"}, byName["no logs"])
+}
+
 func TestLogPollerFilters(t *testing.T) {
 	lggr := logger.Test(t)
 	chainID := testutils.NewRandomEVMChainID()
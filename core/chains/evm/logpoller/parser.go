@@ -28,7 +28,7 @@ const (
 var (
 	ErrUnexpectedCursorFormat = errors.New("unexpected cursor format")
 	logsFields                = [...]string{"evm_chain_id", "log_index", "block_hash", "block_number",
-		"address", "event_sig", "topics", "tx_hash", "data", "created_at", "block_timestamp"}
+		"address", "event_sig", "topics", "tx_hash", "data", "created_at", "block_timestamp", "compacted"}
 	blocksFields = [...]string{"evm_chain_id", "block_hash", "block_number", "block_timestamp",
 		"finalized_block_number", "created_at"}
 )
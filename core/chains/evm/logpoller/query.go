@@ -194,6 +194,10 @@ func (q *queryArgs) withLogsPerBlock(logsPerBlock uint64) *queryArgs {
 	return q.withField("logs_per_block", logsPerBlock)
 }
 
+func (q *queryArgs) withCompactAfter(compactAfter time.Duration) *queryArgs {
+	return q.withField("compact_after", compactAfter)
+}
+
 func (q *queryArgs) withMaxLogsKept(maxLogsKept uint64) *queryArgs {
 	return q.withField("max_logs_kept", maxLogsKept)
 }
@@ -0,0 +1,208 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strconv"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// subscriptionBufferSize is the capacity of the channel of a subscription. When a subscriber lags behind and its
+// channel is full, the delivery of its events is paused until the next poll instead of blocking the LogPoller.
+const subscriptionBufferSize = 256
+
+// SubscriptionEvent is delivered on the channel returned by Subscribe. Exactly one of Log and Reorg is set.
+type SubscriptionEvent struct {
+	Log   *Log
+	Reorg *Reorg
+}
+
+// Reorg notifies a subscriber that the blocks starting at BlockNumber were reorged out. The logs of these blocks
+// delivered before are no longer canonical. The logs of the new canonical blocks are delivered once they reach the
+// requested confirmation depth.
+type Reorg struct {
+	BlockNumber int64
+}
+
+type subscription struct {
+	id     int64
+	filter query.Expression
+	confs  evmtypes.Confirmations
+	ch     chan SubscriptionEvent
+	// cursorBlock and cursorLogIndex point to the last delivered log. cursorLogIndex is math.MaxInt64 when all the logs
+	// of cursorBlock were delivered.
+	cursorBlock    int64
+	cursorLogIndex int64
+	// pendingReorg is the first reorged block not yet notified to the subscriber.
+	pendingReorg *int64
+}
+
+// Subscribe delivers the logs matching filter exactly once, in block order, as soon as they reach confs
+// confirmations, or as soon as their block is finalized if confs is evmtypes.Finalized. Only the logs of the blocks
+// reaching the confirmation depth after the call are delivered, use the query methods to read the older ones.
+// When a reorg removes blocks whose logs were already delivered, a Reorg event is sent on the same channel and the
+// logs of the new canonical blocks are delivered again as they reach the confirmation depth.
+// The returned func unsubscribes and closes the channel. The channel is also closed when the LogPoller is closed.
+func (lp *logPoller) Subscribe(ctx context.Context, filter query.Expression, confs evmtypes.Confirmations) (<-chan SubscriptionEvent, func(), error) {
+	if confs < evmtypes.Finalized {
+		return nil, nil, pkgerrors.Errorf("invalid confirmations %d", confs)
+	}
+	cursorBlock, err := lp.confirmedBlockNumber(ctx, confs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	if lp.subscriptionsClosed {
+		return nil, nil, ErrLogPollerShutdown
+	}
+	lp.subscriptionID++
+	sub := &subscription{
+		id:             lp.subscriptionID,
+		filter:         filter,
+		confs:          confs,
+		ch:             make(chan SubscriptionEvent, subscriptionBufferSize),
+		cursorBlock:    cursorBlock,
+		cursorLogIndex: math.MaxInt64,
+	}
+	lp.subscriptions[sub.id] = sub
+	return sub.ch, func() { lp.unsubscribe(sub.id) }, nil
+}
+
+func (lp *logPoller) unsubscribe(id int64) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	if sub, ok := lp.subscriptions[id]; ok {
+		delete(lp.subscriptions, id)
+		close(sub.ch)
+	}
+}
+
+func (lp *logPoller) closeSubscriptions() {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for id, sub := range lp.subscriptions {
+		delete(lp.subscriptions, id)
+		close(sub.ch)
+	}
+	lp.subscriptionsClosed = true
+}
+
+// confirmedBlockNumber returns the latest saved block having confs confirmations, or 0 if there is none.
+func (lp *logPoller) confirmedBlockNumber(ctx context.Context, confs evmtypes.Confirmations) (int64, error) {
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, pkgerrors.Wrap(err, "failed to select the latest block")
+	}
+	if confs == evmtypes.Finalized {
+		return latest.FinalizedBlockNumber, nil
+	}
+	return max(latest.BlockNumber-int64(confs), 0), nil
+}
+
+// notifyReorg rewinds the subscriptions which were delivered the logs of the blocks starting at blockNumber, so that
+// the logs of the new canonical blocks are delivered, and queues a Reorg event for them.
+func (lp *logPoller) notifyReorg(blockNumber int64) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptionReorgs++
+	for _, sub := range lp.subscriptions {
+		if sub.cursorBlock < blockNumber {
+			continue
+		}
+		if sub.pendingReorg == nil || blockNumber < *sub.pendingReorg {
+			sub.pendingReorg = &blockNumber
+		}
+		sub.cursorBlock = blockNumber - 1
+		sub.cursorLogIndex = math.MaxInt64
+	}
+}
+
+// dispatchSubscriptions delivers the pending reorg notifications and the logs which reached the confirmation depth
+// of their subscription since the last dispatch.
+func (lp *logPoller) dispatchSubscriptions(ctx context.Context) {
+	lp.subscriptionsMu.Lock()
+	subs := make([]subscription, 0, len(lp.subscriptions))
+	for _, sub := range lp.subscriptions {
+		subs = append(subs, *sub)
+	}
+	reorgs := lp.subscriptionReorgs
+	lp.subscriptionsMu.Unlock()
+
+	for i := range subs {
+		if ctx.Err() != nil {
+			return
+		}
+		toBlock, err := lp.confirmedBlockNumber(ctx, subs[i].confs)
+		if err != nil {
+			lp.lggr.Warnw("Unable to dispatch logs to subscriptions, retrying later", "err", err)
+			return
+		}
+		if toBlock <= subs[i].cursorBlock && subs[i].pendingReorg == nil {
+			continue
+		}
+		var logs []Log
+		if toBlock > subs[i].cursorBlock || subs[i].cursorLogIndex != math.MaxInt64 {
+			logs, err = lp.orm.FilteredLogs(ctx, []query.Expression{
+				subs[i].filter,
+				query.Block(strconv.FormatInt(subs[i].cursorBlock, 10), primitives.Gte),
+				query.Block(strconv.FormatInt(toBlock, 10), primitives.Lte),
+			}, query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)), "")
+			if err != nil {
+				lp.lggr.Warnw("Unable to select logs of subscription, retrying later", "err", err, "subscriptionID", subs[i].id)
+				continue
+			}
+		}
+		lp.deliver(subs[i], reorgs, logs, toBlock)
+	}
+}
+
+// deliver sends the events to the subscription without blocking, and advances its cursor up to the last event sent.
+// snapshot is the state of the subscription when the logs were selected, the subscription is left untouched if a
+// reorg happened or if it was unsubscribed in the meantime, since the logs may no longer be canonical.
+func (lp *logPoller) deliver(snapshot subscription, reorgs int64, logs []Log, toBlock int64) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	sub, ok := lp.subscriptions[snapshot.id]
+	if !ok || reorgs != lp.subscriptionReorgs {
+		return
+	}
+
+	if sub.pendingReorg != nil {
+		select {
+		case sub.ch <- SubscriptionEvent{Reorg: &Reorg{BlockNumber: *sub.pendingReorg}}:
+			sub.pendingReorg = nil
+		default:
+			lp.lggr.Debugw("Subscription channel is full, pausing delivery", "subscriptionID", sub.id)
+			return
+		}
+	}
+
+	for i := range logs {
+		if logs[i].BlockNumber == sub.cursorBlock && logs[i].LogIndex <= sub.cursorLogIndex {
+			continue
+		}
+		select {
+		case sub.ch <- SubscriptionEvent{Log: &logs[i]}:
+			sub.cursorBlock, sub.cursorLogIndex = logs[i].BlockNumber, logs[i].LogIndex
+		default:
+			lp.lggr.Debugw("Subscription channel is full, pausing delivery", "subscriptionID", sub.id, "block", logs[i].BlockNumber)
+			return
+		}
+	}
+	if toBlock >= sub.cursorBlock {
+		sub.cursorBlock, sub.cursorLogIndex = toBlock, math.MaxInt64
+	}
+}
@@ -43,6 +43,7 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
+		funder         Funder
 	}
 
 	NullBalanceMonitor struct{}
@@ -50,8 +51,9 @@ type (
 
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. If funder is non-nil, it is
+// invoked with the refreshed balances after every balance check.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, funder Funder, lggr logger.Logger) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
 		ethClient:   ethClient,
@@ -59,6 +61,7 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lgg
 		chainIDStr:  chainId.String(),
 		ethKeyStore: ethKeyStore,
 		ethBalances: make(map[gethCommon.Address]*assets.Eth),
+		funder:      funder,
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -167,6 +170,14 @@ func (w *worker) WorkCtx(ctx context.Context) {
 		}(address)
 	}
 	wg.Wait()
+
+	if w.bm.funder != nil {
+		balances := make(map[gethCommon.Address]*assets.Eth, len(enabledAddresses))
+		for _, address := range enabledAddresses {
+			balances[address] = w.bm.GetEthBalance(address)
+		}
+		w.bm.funder.Fund(ctx, balances)
+	}
 }
 
 // Approximately ETH block time
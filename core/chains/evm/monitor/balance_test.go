@@ -44,7 +44,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -71,7 +71,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -90,7 +90,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -119,7 +119,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -144,7 +144,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -193,7 +193,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
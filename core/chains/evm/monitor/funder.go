@@ -0,0 +1,148 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// fundingWindow is the rolling window the daily spend cap applies to
+const fundingWindow = 24 * time.Hour
+
+// Funder tops up sending keys whose balance has fallen below a configured minimum
+type Funder interface {
+	// Fund is called by the BalanceMonitor with the latest known balance of every enabled key
+	Fund(ctx context.Context, balances map[common.Address]*assets.Eth)
+}
+
+type keyFunder struct {
+	lggr        logger.SugaredLogger
+	cfg         config.FundingConfig
+	gasLimit    uint64
+	chainID     *big.Int
+	ethKeyStore keystore.Eth
+	txm         txmgr.TxManager
+	orm         FundingORM
+}
+
+var _ Funder = (*keyFunder)(nil)
+
+// NewKeyFunder returns a Funder that sends native tokens from the configured treasury key through the txm
+func NewKeyFunder(cfg config.FundingConfig, gasLimit uint64, chainID *big.Int, ethKeyStore keystore.Eth, txm txmgr.TxManager, orm FundingORM, lggr logger.Logger) Funder {
+	return &keyFunder{
+		lggr:        logger.Sugared(logger.Named(lggr, "KeyFunder")),
+		cfg:         cfg,
+		gasLimit:    gasLimit,
+		chainID:     chainID,
+		ethKeyStore: ethKeyStore,
+		txm:         txm,
+		orm:         orm,
+	}
+}
+
+func (f *keyFunder) Fund(ctx context.Context, balances map[common.Address]*assets.Eth) {
+	treasury := f.cfg.TreasuryAddress()
+	if err := f.ethKeyStore.CheckEnabled(ctx, treasury, f.chainID); err != nil {
+		f.lggr.Errorw("KeyFunder: treasury key is not usable, skipping funding", "treasury", treasury, "err", err)
+		return
+	}
+	treasuryBal, ok := balances[treasury]
+	if !ok || treasuryBal == nil {
+		f.lggr.Warnw("KeyFunder: treasury balance unknown, skipping funding", "treasury", treasury)
+		return
+	}
+	available := assets.NewWei(treasuryBal.ToInt())
+
+	minBalance := f.cfg.MinBalance().ToInt()
+	var underfunded []common.Address
+	for address, bal := range balances {
+		if address == treasury || bal == nil || bal.ToInt().Cmp(minBalance) >= 0 {
+			continue
+		}
+		underfunded = append(underfunded, address)
+	}
+	if len(underfunded) == 0 {
+		return
+	}
+	// Lowest balances first, as those keys are closest to being unable to send
+	slices.SortFunc(underfunded, func(a, b common.Address) int {
+		return balances[a].Cmp(balances[b])
+	})
+
+	pending, err := f.orm.PendingFundingAddresses(ctx, f.chainID)
+	if err != nil {
+		f.lggr.Errorw("KeyFunder: failed to load pending fundings", "err", err)
+		return
+	}
+	spent, err := f.orm.AmountFundedSince(ctx, f.chainID, time.Now().Add(-fundingWindow))
+	if err != nil {
+		f.lggr.Errorw("KeyFunder: failed to load amount funded in the last 24h", "err", err)
+		return
+	}
+	remaining := f.cfg.DailySpendCap().Sub(spent)
+
+	for _, address := range underfunded {
+		if slices.Contains(pending, address) {
+			f.lggr.Debugw("KeyFunder: key already has a pending funding transaction", "address", address)
+			continue
+		}
+		amount := f.cfg.TargetBalance().Sub(assets.NewWei(balances[address].ToInt()))
+		if amount.Cmp(remaining) > 0 {
+			f.lggr.Warnw("KeyFunder: daily spend cap reached, not funding remaining keys",
+				"address", address, "amount", amount, "remaining", remaining, "dailySpendCap", f.cfg.DailySpendCap())
+			return
+		}
+		if amount.Cmp(available) >= 0 {
+			f.lggr.Criticalw("KeyFunder: treasury balance too low to fund key",
+				"treasury", treasury, "treasuryBalance", treasuryBal, "address", address, "amount", amount)
+			return
+		}
+		if err := f.fundKey(ctx, treasury, address, amount); err != nil {
+			f.lggr.Errorw("KeyFunder: failed to fund key", "address", address, "amount", amount, "err", err)
+			continue
+		}
+		remaining = remaining.Sub(amount)
+		available = available.Sub(amount)
+	}
+}
+
+// fundKey records the top-up before handing it to the txm, and removes the record if that fails, so that every
+// funding transaction counts against the daily spend cap. An error is only returned if no transaction was created.
+func (f *keyFunder) fundKey(ctx context.Context, treasury, address common.Address, amount *assets.Wei) error {
+	funding := KeyFunding{
+		EVMChainID:      *ubig.New(f.chainID),
+		TreasuryAddress: treasury,
+		Address:         address,
+		Amount:          *amount,
+	}
+	if err := f.orm.CreateKeyFunding(ctx, &funding); err != nil {
+		return fmt.Errorf("failed to record funding: %w", err)
+	}
+	etx, err := f.txm.SendNativeToken(ctx, f.chainID, treasury, address, *amount.ToInt(), f.gasLimit)
+	if err != nil {
+		if err2 := f.orm.DeleteKeyFunding(ctx, funding.ID); err2 != nil {
+			f.lggr.Errorw("KeyFunder: failed to delete the record of a funding which was not sent, it counts against the daily spend cap",
+				"fundingID", funding.ID, "err", err2)
+		}
+		return fmt.Errorf("failed to create funding transaction: %w", err)
+	}
+	if err = f.orm.SetKeyFundingTx(ctx, funding.ID, etx.ID); err != nil {
+		// The funding still counts against the daily spend cap, but is not known to be pending.
+		f.lggr.Errorw("KeyFunder: failed to link funding to its transaction", "fundingID", funding.ID, "ethTxID", etx.ID, "err", err)
+	}
+	f.lggr.Infow(fmt.Sprintf("KeyFunder: funding %s with %s from treasury %s", address.Hex(), amount, treasury.Hex()),
+		"treasury", treasury, "address", address, "amount", amount, "ethTxID", etx.ID, "fundingID", funding.ID)
+	return nil
+}
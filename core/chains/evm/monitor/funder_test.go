@@ -0,0 +1,222 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	monitormocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+const fundingGasLimit = 21_000
+
+func newFundingConfig(t *testing.T, treasury common.Address, dailySpendCap int64) config.FundingConfig {
+	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		enabled := true
+		treasuryEIP55 := types.EIP55AddressFromAddress(treasury)
+		c.BalanceMonitor.Funding = toml.FundingConfig{
+			Enabled:         &enabled,
+			TreasuryAddress: &treasuryEIP55,
+			MinBalance:      assets.NewWeiI(100),
+			TargetBalance:   assets.NewWeiI(500),
+			DailySpendCap:   assets.NewWeiI(dailySpendCap),
+		}
+	})
+	return cfg.EVM().BalanceMonitor().Funding()
+}
+
+func TestKeyFunder_Fund(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(0)
+	treasury := testutils.NewAddress()
+
+	t.Run("tops up keys below the minimum, lowest balance first", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 10_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		low := testutils.NewAddress()
+		lower := testutils.NewAddress()
+		pending := testutils.NewAddress()
+		healthy := testutils.NewAddress()
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil).Once()
+		orm.On("PendingFundingAddresses", mock.Anything, chainID).Return([]common.Address{pending}, nil).Once()
+		orm.On("AmountFundedSince", mock.Anything, chainID, mock.Anything).Return(assets.NewWeiI(0), nil).Once()
+
+		var sent []common.Address
+		txm.On("SendNativeToken", mock.Anything, chainID, treasury, mock.Anything, mock.Anything, uint64(fundingGasLimit)).
+			Run(func(args mock.Arguments) {
+				sent = append(sent, args.Get(3).(common.Address))
+			}).
+			Return(func(_ context.Context, _ *big.Int, _, to common.Address, value big.Int, _ uint64) (txmgr.Tx, error) {
+				return txmgr.Tx{ID: int64(len(sent)), ToAddress: to, Value: value}, nil
+			}).Twice()
+		orm.On("CreateKeyFunding", mock.Anything, mock.MatchedBy(func(kf *monitor.KeyFunding) bool {
+			return kf.Address == lower && kf.Amount.Equal(assets.NewWeiI(490)) && kf.EthTxID == nil
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*monitor.KeyFunding).ID = 10
+		}).Return(nil).Once()
+		orm.On("CreateKeyFunding", mock.Anything, mock.MatchedBy(func(kf *monitor.KeyFunding) bool {
+			return kf.Address == low && kf.Amount.Equal(assets.NewWeiI(450)) && kf.EthTxID == nil
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*monitor.KeyFunding).ID = 20
+		}).Return(nil).Once()
+		orm.On("SetKeyFundingTx", mock.Anything, int64(10), int64(1)).Return(nil).Once()
+		orm.On("SetKeyFundingTx", mock.Anything, int64(20), int64(2)).Return(nil).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(1_000_000),
+			low:      assets.NewEth(50),
+			lower:    assets.NewEth(10),
+			pending:  assets.NewEth(0),
+			healthy:  assets.NewEth(100),
+		})
+
+		assert.Equal(t, []common.Address{lower, low}, sent)
+	})
+
+	t.Run("stops once the daily spend cap would be exceeded", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 1_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		k0 := testutils.NewAddress()
+		k1 := testutils.NewAddress()
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil).Once()
+		orm.On("PendingFundingAddresses", mock.Anything, chainID).Return(nil, nil).Once()
+		orm.On("AmountFundedSince", mock.Anything, chainID, mock.Anything).Return(assets.NewWeiI(400), nil).Once()
+		txm.On("SendNativeToken", mock.Anything, chainID, treasury, k0, *big.NewInt(500), uint64(fundingGasLimit)).
+			Return(txmgr.Tx{ID: 1}, nil).Once()
+		orm.On("CreateKeyFunding", mock.Anything, mock.Anything).Return(nil).Once()
+		orm.On("SetKeyFundingTx", mock.Anything, mock.Anything, int64(1)).Return(nil).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(1_000_000),
+			k0:       assets.NewEth(0),
+			k1:       assets.NewEth(1),
+		})
+	})
+
+	t.Run("removes the funding record if the transaction is not created", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 10_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil).Once()
+		orm.On("PendingFundingAddresses", mock.Anything, chainID).Return(nil, nil).Once()
+		orm.On("AmountFundedSince", mock.Anything, chainID, mock.Anything).Return(assets.NewWeiI(0), nil).Once()
+		orm.On("CreateKeyFunding", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*monitor.KeyFunding).ID = 10
+		}).Return(nil).Once()
+		txm.On("SendNativeToken", mock.Anything, chainID, treasury, mock.Anything, mock.Anything, uint64(fundingGasLimit)).
+			Return(txmgr.Tx{}, pkgerrors.New("txm stopped")).Once()
+		orm.On("DeleteKeyFunding", mock.Anything, int64(10)).Return(nil).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury:               assets.NewEth(1_000_000),
+			testutils.NewAddress(): assets.NewEth(0),
+		})
+	})
+
+	t.Run("does not send if the funding can't be recorded", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 10_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil).Once()
+		orm.On("PendingFundingAddresses", mock.Anything, chainID).Return(nil, nil).Once()
+		orm.On("AmountFundedSince", mock.Anything, chainID, mock.Anything).Return(assets.NewWeiI(0), nil).Once()
+		orm.On("CreateKeyFunding", mock.Anything, mock.Anything).Return(pkgerrors.New("db down")).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury:               assets.NewEth(1_000_000),
+			testutils.NewAddress(): assets.NewEth(0),
+		})
+	})
+
+	t.Run("does not fund beyond the treasury balance", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 10_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil).Once()
+		orm.On("PendingFundingAddresses", mock.Anything, chainID).Return(nil, nil).Once()
+		orm.On("AmountFundedSince", mock.Anything, chainID, mock.Anything).Return(assets.NewWeiI(0), nil).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury:               assets.NewEth(200),
+			testutils.NewAddress(): assets.NewEth(0),
+		})
+	})
+
+	t.Run("skips funding if the treasury key is not enabled", func(t *testing.T) {
+		ethKeyStore := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := monitormocks.NewFundingORM(t)
+		f := monitor.NewKeyFunder(newFundingConfig(t, treasury, 10_000), fundingGasLimit, chainID, ethKeyStore, txm, orm, logger.Test(t))
+
+		ethKeyStore.On("CheckEnabled", mock.Anything, treasury, chainID).Return(pkgerrors.New("key not found")).Once()
+
+		f.Fund(tests.Context(t), map[common.Address]*assets.Eth{
+			treasury:               assets.NewEth(1_000_000),
+			testutils.NewAddress(): assets.NewEth(0),
+		})
+	})
+}
+
+type fakeFunder struct {
+	balances chan map[common.Address]*assets.Eth
+}
+
+func (f *fakeFunder) Fund(_ context.Context, balances map[common.Address]*assets.Eth) {
+	f.balances <- balances
+}
+
+func TestBalanceMonitor_CallsFunder(t *testing.T) {
+	t.Parallel()
+
+	ethKeyStore := ksmocks.NewEth(t)
+	k0Addr := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{k0Addr}, nil)
+	ethClient := newEthClientMock(t)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+
+	funder := &fakeFunder{balances: make(chan map[common.Address]*assets.Eth, 1)}
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, funder, logger.Test(t))
+	require.NoError(t, bm.Start(tests.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, bm.Close()) })
+
+	select {
+	case balances := <-funder.balances:
+		assert.Equal(t, big.NewInt(42), balances[k0Addr].ToInt())
+	case <-tests.Context(t).Done():
+		t.Fatal("funder was not called")
+	}
+}
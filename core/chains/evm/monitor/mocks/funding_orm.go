@@ -0,0 +1,306 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	assets "github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	big "math/big"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	monitor "github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+
+	time "time"
+)
+
+// FundingORM is an autogenerated mock type for the FundingORM type
+type FundingORM struct {
+	mock.Mock
+}
+
+type FundingORM_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FundingORM) EXPECT() *FundingORM_Expecter {
+	return &FundingORM_Expecter{mock: &_m.Mock}
+}
+
+// AmountFundedSince provides a mock function with given fields: ctx, chainID, since
+func (_m *FundingORM) AmountFundedSince(ctx context.Context, chainID *big.Int, since time.Time) (*assets.Wei, error) {
+	ret := _m.Called(ctx, chainID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmountFundedSince")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) (*assets.Wei, error)); ok {
+		return rf(ctx, chainID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, time.Time) *assets.Wei); ok {
+		r0 = rf(ctx, chainID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, time.Time) error); ok {
+		r1 = rf(ctx, chainID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FundingORM_AmountFundedSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmountFundedSince'
+type FundingORM_AmountFundedSince_Call struct {
+	*mock.Call
+}
+
+// AmountFundedSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - since time.Time
+func (_e *FundingORM_Expecter) AmountFundedSince(ctx interface{}, chainID interface{}, since interface{}) *FundingORM_AmountFundedSince_Call {
+	return &FundingORM_AmountFundedSince_Call{Call: _e.mock.On("AmountFundedSince", ctx, chainID, since)}
+}
+
+func (_c *FundingORM_AmountFundedSince_Call) Run(run func(ctx context.Context, chainID *big.Int, since time.Time)) *FundingORM_AmountFundedSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FundingORM_AmountFundedSince_Call) Return(_a0 *assets.Wei, _a1 error) *FundingORM_AmountFundedSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FundingORM_AmountFundedSince_Call) RunAndReturn(run func(context.Context, *big.Int, time.Time) (*assets.Wei, error)) *FundingORM_AmountFundedSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateKeyFunding provides a mock function with given fields: ctx, f
+func (_m *FundingORM) CreateKeyFunding(ctx context.Context, f *monitor.KeyFunding) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKeyFunding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *monitor.KeyFunding) error); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingORM_CreateKeyFunding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKeyFunding'
+type FundingORM_CreateKeyFunding_Call struct {
+	*mock.Call
+}
+
+// CreateKeyFunding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *monitor.KeyFunding
+func (_e *FundingORM_Expecter) CreateKeyFunding(ctx interface{}, f interface{}) *FundingORM_CreateKeyFunding_Call {
+	return &FundingORM_CreateKeyFunding_Call{Call: _e.mock.On("CreateKeyFunding", ctx, f)}
+}
+
+func (_c *FundingORM_CreateKeyFunding_Call) Run(run func(ctx context.Context, f *monitor.KeyFunding)) *FundingORM_CreateKeyFunding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*monitor.KeyFunding))
+	})
+	return _c
+}
+
+func (_c *FundingORM_CreateKeyFunding_Call) Return(_a0 error) *FundingORM_CreateKeyFunding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingORM_CreateKeyFunding_Call) RunAndReturn(run func(context.Context, *monitor.KeyFunding) error) *FundingORM_CreateKeyFunding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteKeyFunding provides a mock function with given fields: ctx, id
+func (_m *FundingORM) DeleteKeyFunding(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKeyFunding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingORM_DeleteKeyFunding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteKeyFunding'
+type FundingORM_DeleteKeyFunding_Call struct {
+	*mock.Call
+}
+
+// DeleteKeyFunding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *FundingORM_Expecter) DeleteKeyFunding(ctx interface{}, id interface{}) *FundingORM_DeleteKeyFunding_Call {
+	return &FundingORM_DeleteKeyFunding_Call{Call: _e.mock.On("DeleteKeyFunding", ctx, id)}
+}
+
+func (_c *FundingORM_DeleteKeyFunding_Call) Run(run func(ctx context.Context, id int64)) *FundingORM_DeleteKeyFunding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FundingORM_DeleteKeyFunding_Call) Return(_a0 error) *FundingORM_DeleteKeyFunding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingORM_DeleteKeyFunding_Call) RunAndReturn(run func(context.Context, int64) error) *FundingORM_DeleteKeyFunding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingFundingAddresses provides a mock function with given fields: ctx, chainID
+func (_m *FundingORM) PendingFundingAddresses(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingFundingAddresses")
+	}
+
+	var r0 []common.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]common.Address, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []common.Address); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FundingORM_PendingFundingAddresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingFundingAddresses'
+type FundingORM_PendingFundingAddresses_Call struct {
+	*mock.Call
+}
+
+// PendingFundingAddresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *FundingORM_Expecter) PendingFundingAddresses(ctx interface{}, chainID interface{}) *FundingORM_PendingFundingAddresses_Call {
+	return &FundingORM_PendingFundingAddresses_Call{Call: _e.mock.On("PendingFundingAddresses", ctx, chainID)}
+}
+
+func (_c *FundingORM_PendingFundingAddresses_Call) Run(run func(ctx context.Context, chainID *big.Int)) *FundingORM_PendingFundingAddresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *FundingORM_PendingFundingAddresses_Call) Return(_a0 []common.Address, _a1 error) *FundingORM_PendingFundingAddresses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FundingORM_PendingFundingAddresses_Call) RunAndReturn(run func(context.Context, *big.Int) ([]common.Address, error)) *FundingORM_PendingFundingAddresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetKeyFundingTx provides a mock function with given fields: ctx, id, ethTxID
+func (_m *FundingORM) SetKeyFundingTx(ctx context.Context, id int64, ethTxID int64) error {
+	ret := _m.Called(ctx, id, ethTxID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetKeyFundingTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, ethTxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingORM_SetKeyFundingTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetKeyFundingTx'
+type FundingORM_SetKeyFundingTx_Call struct {
+	*mock.Call
+}
+
+// SetKeyFundingTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - ethTxID int64
+func (_e *FundingORM_Expecter) SetKeyFundingTx(ctx interface{}, id interface{}, ethTxID interface{}) *FundingORM_SetKeyFundingTx_Call {
+	return &FundingORM_SetKeyFundingTx_Call{Call: _e.mock.On("SetKeyFundingTx", ctx, id, ethTxID)}
+}
+
+func (_c *FundingORM_SetKeyFundingTx_Call) Run(run func(ctx context.Context, id int64, ethTxID int64)) *FundingORM_SetKeyFundingTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FundingORM_SetKeyFundingTx_Call) Return(_a0 error) *FundingORM_SetKeyFundingTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingORM_SetKeyFundingTx_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FundingORM_SetKeyFundingTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFundingORM creates a new instance of FundingORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFundingORM(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FundingORM {
+	mock := &FundingORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
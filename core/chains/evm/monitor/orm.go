@@ -0,0 +1,82 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// KeyFunding is the audit record of a single top-up sent from the treasury key to a sending key.
+type KeyFunding struct {
+	ID              int64
+	EVMChainID      ubig.Big `db:"evm_chain_id"`
+	TreasuryAddress common.Address
+	Address         common.Address
+	Amount          assets.Wei
+	EthTxID         *int64 `db:"eth_tx_id"`
+	CreatedAt       time.Time
+}
+
+type FundingORM interface {
+	// CreateKeyFunding records a top-up before it is handed to the txm, so that it counts against the daily spend cap
+	// even if the node stops before linking it to its transaction.
+	CreateKeyFunding(ctx context.Context, f *KeyFunding) error
+	// SetKeyFundingTx links the top-up to the txm transaction sending it.
+	SetKeyFundingTx(ctx context.Context, id int64, ethTxID int64) error
+	// DeleteKeyFunding removes the record of a top-up which could not be handed to the txm.
+	DeleteKeyFunding(ctx context.Context, id int64) error
+	// AmountFundedSince returns the total amount sent from the treasury key on the given chain since the given time.
+	AmountFundedSince(ctx context.Context, chainID *big.Int, since time.Time) (*assets.Wei, error)
+	// PendingFundingAddresses returns the addresses that have a top-up which has not yet been confirmed on chain.
+	PendingFundingAddresses(ctx context.Context, chainID *big.Int) ([]common.Address, error)
+}
+
+type fundingORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ FundingORM = (*fundingORM)(nil)
+
+func NewFundingORM(ds sqlutil.DataSource) FundingORM {
+	return &fundingORM{ds: ds}
+}
+
+func (o *fundingORM) CreateKeyFunding(ctx context.Context, f *KeyFunding) error {
+	const sql = `INSERT INTO evm.key_fundings (evm_chain_id, treasury_address, address, amount, eth_tx_id, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW()) RETURNING id, created_at`
+	return o.ds.QueryRowxContext(ctx, sql, f.EVMChainID, f.TreasuryAddress, f.Address, f.Amount, f.EthTxID).Scan(&f.ID, &f.CreatedAt)
+}
+
+func (o *fundingORM) SetKeyFundingTx(ctx context.Context, id int64, ethTxID int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.key_fundings SET eth_tx_id = $2 WHERE id = $1`, id, ethTxID)
+	return err
+}
+
+func (o *fundingORM) DeleteKeyFunding(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.key_fundings WHERE id = $1`, id)
+	return err
+}
+
+func (o *fundingORM) AmountFundedSince(ctx context.Context, chainID *big.Int, since time.Time) (*assets.Wei, error) {
+	var amount assets.Wei
+	err := o.ds.GetContext(ctx, &amount, `SELECT COALESCE(SUM(amount), 0) FROM evm.key_fundings WHERE evm_chain_id = $1 AND created_at >= $2`, ubig.New(chainID), since)
+	if err != nil {
+		return nil, err
+	}
+	return &amount, nil
+}
+
+func (o *fundingORM) PendingFundingAddresses(ctx context.Context, chainID *big.Int) (addresses []common.Address, err error) {
+	const sql = `SELECT DISTINCT kf.address FROM evm.key_fundings kf
+JOIN evm.txes t ON t.id = kf.eth_tx_id
+WHERE kf.evm_chain_id = $1 AND t.state IN ('unstarted', 'in_progress', 'unconfirmed')`
+	err = o.ds.SelectContext(ctx, &addresses, sql, ubig.New(chainID))
+	return
+}
@@ -0,0 +1,112 @@
+package monitor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestFundingORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+	txStore := txmgr.NewTxStore(db, logger.Test(t))
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore)
+	orm := monitor.NewFundingORM(db)
+	chainID := &cltest.FixtureChainID
+
+	k0 := testutils.NewAddress()
+	k1 := testutils.NewAddress()
+
+	spent, err := orm.AmountFundedSince(ctx, chainID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, spent.IsZero())
+
+	unconfirmed := cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, treasury)
+	confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 1, treasury)
+
+	f0 := monitor.KeyFunding{
+		EVMChainID:      *ubig.New(chainID),
+		TreasuryAddress: treasury,
+		Address:         k0,
+		Amount:          *assets.NewWeiI(100),
+		EthTxID:         &unconfirmed.ID,
+	}
+	require.NoError(t, orm.CreateKeyFunding(ctx, &f0))
+	assert.NotZero(t, f0.ID)
+	assert.False(t, f0.CreatedAt.IsZero())
+
+	f1 := monitor.KeyFunding{
+		EVMChainID:      *ubig.New(chainID),
+		TreasuryAddress: treasury,
+		Address:         k1,
+		Amount:          *assets.NewWeiI(250),
+		EthTxID:         &confirmed.ID,
+	}
+	require.NoError(t, orm.CreateKeyFunding(ctx, &f1))
+
+	t.Run("sums amounts inside the window", func(t *testing.T) {
+		spent, err := orm.AmountFundedSince(ctx, chainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(350), spent)
+
+		spent, err = orm.AmountFundedSince(ctx, chainID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, spent.IsZero())
+	})
+
+	t.Run("only reports addresses with unconfirmed funding transactions", func(t *testing.T) {
+		pending, err := orm.PendingFundingAddresses(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{k0}, pending)
+	})
+
+	t.Run("links and deletes fundings", func(t *testing.T) {
+		f2 := monitor.KeyFunding{
+			EVMChainID:      *ubig.New(chainID),
+			TreasuryAddress: treasury,
+			Address:         k1,
+			Amount:          *assets.NewWeiI(50),
+		}
+		require.NoError(t, orm.CreateKeyFunding(ctx, &f2))
+		spent, err := orm.AmountFundedSince(ctx, chainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(400), spent)
+
+		unconfirmed2 := cltest.MustInsertUnconfirmedEthTx(t, txStore, 2, treasury)
+		require.NoError(t, orm.SetKeyFundingTx(ctx, f2.ID, unconfirmed2.ID))
+		pending, err := orm.PendingFundingAddresses(ctx, chainID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{k0, k1}, pending)
+
+		require.NoError(t, orm.DeleteKeyFunding(ctx, f2.ID))
+		spent, err = orm.AmountFundedSince(ctx, chainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(350), spent)
+	})
+
+	t.Run("scopes by chain", func(t *testing.T) {
+		pending, err := orm.PendingFundingAddresses(ctx, testutils.SimulatedChainID)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		spent, err := orm.AmountFundedSince(ctx, testutils.SimulatedChainID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.True(t, spent.IsZero())
+	})
+}
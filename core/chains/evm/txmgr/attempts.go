@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -25,30 +26,59 @@ type TxAttemptSigner[ADDR commontypes.Hashable] interface {
 
 var _ TxAttemptBuilder = (*evmTxAttemptBuilder)(nil)
 
+// BlobSidecarFinder loads the blob sidecars of transactions, which are not loaded with the transactions themselves.
+type BlobSidecarFinder interface {
+	FindTxBlobSidecar(ctx context.Context, etxID int64) ([]byte, error)
+}
+
 type evmTxAttemptBuilder struct {
-	chainID   big.Int
-	feeConfig evmTxAttemptBuilderFeeConfig
-	keystore  TxAttemptSigner[common.Address]
+	chainID      big.Int
+	feeConfig    evmTxAttemptBuilderFeeConfig
+	keystore     TxAttemptSigner[common.Address]
+	blobSidecars BlobSidecarFinder
 	gas.EvmFeeEstimator
 }
 
 type evmTxAttemptBuilderFeeConfig interface {
 	EIP1559DynamicFees() bool
+	BumpPercent() uint16
+	BumpMin() *assets.Wei
 	PriceMaxKey(common.Address) *assets.Wei
 	LimitDefault() uint64
 }
 
 func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
-	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
+	return &evmTxAttemptBuilder{chainID: chainID, feeConfig: feeConfig, keystore: keystore, EvmFeeEstimator: estimator}
+}
+
+// SetBlobSidecarFinder sets the store the blob sidecars of transactions are loaded from when building their attempts.
+// Without it, only the sidecars already set on the transactions are used.
+func (c *evmTxAttemptBuilder) SetBlobSidecarFinder(blobSidecars BlobSidecarFinder) {
+	c.blobSidecars = blobSidecars
+}
+
+// loadBlobSidecar sets the blob sidecar of etx, if it carries blobs and it is not set yet.
+func (c *evmTxAttemptBuilder) loadBlobSidecar(ctx context.Context, etx *Tx) (err error) {
+	if c.blobSidecars == nil || len(etx.BlobSidecar) > 0 {
+		return nil
+	}
+	etx.BlobSidecar, err = c.blobSidecars.FindTxBlobSidecar(ctx, etx.ID)
+	return pkgerrors.Wrap(err, "failed to load blob sidecar")
 }
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
+	if err = c.loadBlobSidecar(ctx, &etx); err != nil {
+		return attempt, fee, feeLimit, true, err
+	}
 	txType := 0x0
 	if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
+	if len(etx.BlobSidecar) > 0 {
+		txType = 0x3
+	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
 }
 
@@ -60,6 +90,12 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == 0x3 {
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx, keySpecificMaxGasPriceWei)
+		if err != nil {
+			return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get blob fee") // estimator errors are retryable
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -109,6 +145,83 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	return attempt, nil
 }
 
+// NewManualBumpTxAttempt builds an attempt paying fee to replace previousAttempt, used when an operator bumps a transaction.
+// Nodes only accept a replacement whose fees are all bumped by their minimum price bump: EVM.GasEstimator.BumpPercent and
+// BumpMin for legacy and dynamic fee transactions, and gas.BlobTxPriceBump for blob transactions. Every fee component set
+// for the tx type of previousAttempt must be bumped at least that much, and must not exceed the maximum gas price of the key.
+func (c *evmTxAttemptBuilder) NewManualBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, fee gas.EvmFee, lggr logger.Logger) (attempt TxAttempt, err error) {
+	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
+	prev := previousAttempt.TxFee
+	minBump := func(previous *assets.Wei) *assets.Wei {
+		if previous == nil {
+			return nil
+		}
+		if previousAttempt.TxType == 0x3 {
+			return previous.AddPercentage(gas.BlobTxPriceBump)
+		}
+		bumped := previous.AddPercentage(c.feeConfig.BumpPercent())
+		if bumpMin := c.feeConfig.BumpMin(); bumpMin != nil {
+			bumped = assets.WeiMax(bumped, previous.Add(bumpMin))
+		}
+		return bumped
+	}
+	switch previousAttempt.TxType {
+	case 0x0:
+		if fee.GasPrice == nil {
+			return attempt, pkgerrors.New("gas price must be set to bump a legacy transaction")
+		}
+		if err = checkManualBumpFee("gas price", fee.GasPrice, minBump(prev.GasPrice), keySpecificMaxGasPriceWei); err != nil {
+			return attempt, err
+		}
+	default:
+		if fee.GasFeeCap == nil || fee.GasTipCap == nil {
+			return attempt, pkgerrors.New("gas fee cap and gas tip cap must be set to bump a dynamic fee transaction")
+		}
+		if err = checkManualBumpFee("gas fee cap", fee.GasFeeCap, minBump(prev.GasFeeCap), keySpecificMaxGasPriceWei); err != nil {
+			return attempt, err
+		}
+		if err = checkManualBumpFee("gas tip cap", fee.GasTipCap, minBump(prev.GasTipCap), fee.GasFeeCap); err != nil {
+			return attempt, err
+		}
+		if previousAttempt.TxType == 0x3 {
+			// The blob pool also requires the blob fee cap to be bumped, which is done for operators who don't set it
+			if fee.BlobFeeCap == nil && prev.BlobFeeCap != nil {
+				fee.BlobFeeCap = minBump(prev.BlobFeeCap)
+			}
+			if err = checkManualBumpFee("blob fee cap", fee.BlobFeeCap, minBump(prev.BlobFeeCap), keySpecificMaxGasPriceWei); err != nil {
+				return attempt, err
+			}
+		}
+	}
+
+	feeLimit := previousAttempt.ChainSpecificFeeLimit
+	// Keep purging the transaction if it was cancelled
+	if previousAttempt.IsPurgeAttempt {
+		etx.EncodedPayload = []byte{}
+		etx.Value = *big.NewInt(0)
+		feeLimit = c.feeConfig.LimitDefault()
+	}
+	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, previousAttempt.TxType, lggr)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.IsPurgeAttempt = previousAttempt.IsPurgeAttempt
+	return attempt, nil
+}
+
+func checkManualBumpFee(name string, fee, minimum, maximum *assets.Wei) error {
+	if fee == nil {
+		return fmt.Errorf("%s must be set", name)
+	}
+	if minimum != nil && fee.Cmp(minimum) < 0 {
+		return fmt.Errorf("%s %s is below the minimum replacement %s %s of the previous attempt", name, fee, name, minimum)
+	}
+	if maximum != nil && fee.Cmp(maximum) > 0 {
+		return fmt.Errorf("%s %s exceeds the maximum %s", name, fee, maximum)
+	}
+	return nil
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -132,6 +245,14 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return dynamic and blob fees. Blob transactions require EIP1559DynamicFees to be enabled", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, fee, gasLimit)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -197,6 +318,64 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee.DynamicFee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if max := c.feeConfig.PriceMaxKey(etx.FromAddress); fee.BlobFeeCap.Cmp(max) > 0 {
+		return attempt, pkgerrors.Errorf("cannot create tx attempt: specified blob fee cap of %s would exceed max configured gas price of %s for key %s", fee.BlobFeeCap.String(), max.String(), etx.FromAddress.String())
+	}
+	if err = c.loadBlobSidecar(ctx, &etx); err != nil {
+		return attempt, err
+	}
+	sidecar, err := decodeBlobSidecar(etx.BlobSidecar)
+	if err != nil {
+		return attempt, err
+	}
+
+	b := newBlobTransaction(
+		uint64(*etx.Sequence),
+		etx.ToAddress,
+		&etx.Value,
+		gasLimit,
+		&c.chainID,
+		fee.GasTipCap,
+		fee.GasFeeCap,
+		fee.BlobFeeCap,
+		etx.EncodedPayload,
+		sidecar,
+	)
+	tx := types.NewTx(&b)
+	// the signed tx is encoded with its sidecar, so the attempt can be rebroadcast as is
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+		BlobFeeCap: fee.BlobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
+func newBlobTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID *big.Int, gasTipCap, gasFeeCap, blobFeeCap *assets.Wei, data []byte, sidecar *types.BlobTxSidecar) types.BlobTx {
+	return types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         to,
+		Value:      uint256.MustFromBig(value),
+		Data:       data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
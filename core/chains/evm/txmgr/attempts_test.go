@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,7 @@ import (
 	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
@@ -37,6 +40,8 @@ type feeConfig struct {
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
 	limitDefault       uint64
+	bumpPercent        uint16
+	bumpMin            *assets.Wei
 }
 
 func newFeeConfig() *feeConfig {
@@ -44,6 +49,7 @@ func newFeeConfig() *feeConfig {
 		tipCapMin: assets.NewWeiI(0),
 		priceMin:  assets.NewWeiI(0),
 		priceMax:  assets.NewWeiI(0),
+		bumpMin:   assets.NewWeiI(0),
 	}
 }
 
@@ -52,6 +58,8 @@ func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
 func (g *feeConfig) LimitDefault() uint64                            { return g.limitDefault }
+func (g *feeConfig) BumpPercent() uint16                             { return g.bumpPercent }
+func (g *feeConfig) BumpMin() *assets.Wei                            { return g.bumpMin }
 
 func TestTxm_SignTx(t *testing.T) {
 	t.Parallel()
@@ -191,6 +199,97 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobTx(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		})
+	n := evmtypes.Nonce(3)
+	lggr := logger.Test(t)
+	sidecar, err := txmgr.NewBlobTxSidecar([][]byte{{1, 2, 3}})
+	require.NoError(t, err)
+	encodedSidecar, err := rlp.EncodeToBytes(sidecar)
+	require.NoError(t, err)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, BlobSidecar: encodedSidecar}
+	fee := gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(20)},
+		BlobFeeCap: assets.GWei(10),
+	}
+
+	t.Run("creates attempt with fields", func(t *testing.T) {
+		feeCfg := newFeeConfig()
+		feeCfg.priceMax = assets.GWei(200)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, 100, int(a.ChainSpecificFeeLimit))
+		assert.Nil(t, a.TxFee.GasPrice)
+		assert.Equal(t, fee, a.TxFee)
+
+		// the signed tx carries the sidecar so it can be rebroadcast
+		tx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+		assert.Equal(t, uint64(3), tx.Nonce())
+		assert.Equal(t, assets.GWei(10).ToInt(), tx.BlobGasFeeCap())
+		assert.Equal(t, sidecar.BlobHashes(), tx.BlobHashes())
+		require.NotNil(t, tx.BlobTxSidecar())
+		assert.Equal(t, sidecar.Commitments, tx.BlobTxSidecar().Commitments)
+		assert.Equal(t, a.Hash, tx.Hash())
+	})
+
+	t.Run("verifies blob fee cap", func(t *testing.T) {
+		feeCfg := newFeeConfig()
+		feeCfg.priceMax = assets.GWei(5)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		fee := fee
+		fee.DynamicFee = gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(2)}
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, fee, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "specified blob fee cap of 10 gwei would exceed max configured gas price of 5 gwei")
+	})
+
+	t.Run("estimates blob fee for new blob transactions", func(t *testing.T) {
+		feeCfg := newFeeConfig()
+		feeCfg.eip1559DynamicFees = true
+		feeCfg.priceMax = assets.GWei(200)
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{DynamicFee: fee.DynamicFee}, uint64(100), nil).Once()
+		est.On("GetBlobFee", mock.Anything, feeCfg.priceMax).Return(assets.GWei(10), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, est)
+
+		a, estimatedFee, _, _, err := cks.NewTxAttempt(tests.Context(t), etx, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, fee, estimatedFee)
+	})
+	t.Run("loads the sidecar of blob transactions to build their attempts", func(t *testing.T) {
+		feeCfg := newFeeConfig()
+		feeCfg.eip1559DynamicFees = true
+		feeCfg.priceMax = assets.GWei(200)
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{DynamicFee: fee.DynamicFee}, uint64(100), nil).Twice()
+		est.On("GetBlobFee", mock.Anything, feeCfg.priceMax).Return(assets.GWei(10), nil).Once()
+		txStore := txmmocks.NewEvmTxStore(t)
+		txStore.On("FindTxBlobSidecar", mock.Anything, int64(1)).Return(encodedSidecar, nil).Once()
+		txStore.On("FindTxBlobSidecar", mock.Anything, int64(2)).Return(nil, nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, est)
+		cks.SetBlobSidecarFinder(txStore)
+
+		a, _, _, _, err := cks.NewTxAttempt(tests.Context(t), txmgr.Tx{ID: 1, Sequence: &n, FromAddress: addr}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+
+		a, _, _, _, err = cks.NewTxAttempt(tests.Context(t), txmgr.Tx{ID: 2, Sequence: &n, FromAddress: addr}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 2, a.TxType)
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
@@ -301,6 +400,81 @@ func TestTxm_NewPurgeAttempt(t *testing.T) {
 	})
 }
 
+func TestTxm_NewManualBumpAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	gc := newFeeConfig()
+	gc.priceMin = assets.GWei(10)
+	gc.priceMax = assets.GWei(50)
+	gc.limitDefault = uint64(10)
+	gc.bumpPercent = 10
+	gc.bumpMin = assets.GWei(1)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	n := evmtypes.Nonce(0)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, EncodedPayload: []byte{1, 2, 3}}
+
+	t.Run("creates legacy attempt with the given gas price", func(t *testing.T) {
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(20)}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		a, err := cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.GWei(30)}, lggr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), a.ChainSpecificFeeLimit)
+		require.Equal(t, assets.GWei(30).String(), a.TxFee.GasPrice.String())
+		require.False(t, a.IsPurgeAttempt)
+		require.Equal(t, []byte{1, 2, 3}, a.Tx.EncodedPayload)
+
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.GWei(20)}, lggr)
+		require.ErrorContains(t, err, "is below the minimum replacement gas price")
+		// the price must be bumped by at least BumpPercent
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.NewWeiI(21_500_000_000)}, lggr)
+		require.ErrorContains(t, err, "is below the minimum replacement gas price 22 gwei")
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.GWei(60)}, lggr)
+		require.ErrorContains(t, err, "exceeds the maximum")
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(5), GasFeeCap: assets.GWei(30)}}, lggr)
+		require.ErrorContains(t, err, "gas price must be set")
+	})
+
+	t.Run("creates dynamic attempt with the given fee and tip caps", func(t *testing.T) {
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(2), GasFeeCap: assets.GWei(20)}}, 100, 0x2, lggr)
+		require.NoError(t, err)
+		a, err := cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(3), GasFeeCap: assets.GWei(30)}}, lggr)
+		require.NoError(t, err)
+		require.Nil(t, a.TxFee.GasPrice)
+		require.Equal(t, assets.GWei(3).String(), a.TxFee.GasTipCap.String())
+		require.Equal(t, assets.GWei(30).String(), a.TxFee.GasFeeCap.String())
+
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(2), GasFeeCap: assets.GWei(30)}}, lggr)
+		require.ErrorContains(t, err, "is below the minimum replacement gas tip cap")
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.GWei(30)}, lggr)
+		require.ErrorContains(t, err, "gas fee cap and gas tip cap must be set")
+	})
+
+	t.Run("bumps every fee of a blob attempt by the blob price bump", func(t *testing.T) {
+		blobFee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(2), GasFeeCap: assets.GWei(10)}, BlobFeeCap: assets.GWei(5)}
+		prevAttempt := txmgr.TxAttempt{TxType: 0x3, TxFee: blobFee, ChainSpecificFeeLimit: 100}
+		_, err := cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(3), GasFeeCap: assets.GWei(20)}}, lggr)
+		require.ErrorContains(t, err, "is below the minimum replacement gas tip cap 4 gwei")
+		_, err = cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(4), GasFeeCap: assets.GWei(20)}, BlobFeeCap: assets.GWei(6)}, lggr)
+		require.ErrorContains(t, err, "is below the minimum replacement blob fee cap 10 gwei")
+	})
+
+	t.Run("keeps purging a cancelled transaction", func(t *testing.T) {
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(20)}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		prevAttempt.IsPurgeAttempt = true
+		a, err := cks.NewManualBumpTxAttempt(ctx, etx, prevAttempt, gas.EvmFee{GasPrice: assets.GWei(30)}, lggr)
+		require.NoError(t, err)
+		require.Equal(t, gc.limitDefault, a.ChainSpecificFeeLimit)
+		require.True(t, a.IsPurgeAttempt)
+		require.Equal(t, []byte{}, a.Tx.EncodedPayload)
+		require.Equal(t, *big.NewInt(0), a.Tx.Value)
+	})
+}
+
 func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 	t.Parallel()
 
@@ -324,6 +498,14 @@ func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 		assert.False(t, retryable)
 	})
 
+	t.Run("dynamic fee without blob fee with blob tx type", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{
+			DynamicFee: dynamicFee,
+		}, 100, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+
 	t.Run("invalid type", func(t *testing.T) {
 		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{}, 100, 0xA, lggr)
 		require.Error(t, err)
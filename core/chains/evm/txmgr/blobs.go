@@ -0,0 +1,63 @@
+package txmgr
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// MaxBlobsPerTx is the maximum number of blobs of a transaction, limited by the blob gas of a block.
+const MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
+// NewBlobTxSidecar computes the KZG commitments and proofs of the blobs of a transaction.
+func NewBlobTxSidecar(blobs [][]byte) (*types.BlobTxSidecar, error) {
+	if len(blobs) > MaxBlobsPerTx {
+		return nil, fmt.Errorf("too many blobs: %d, max is %d", len(blobs), MaxBlobsPerTx)
+	}
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       make([]kzg4844.Blob, len(blobs)),
+		Commitments: make([]kzg4844.Commitment, len(blobs)),
+		Proofs:      make([]kzg4844.Proof, len(blobs)),
+	}
+	for i, data := range blobs {
+		if len(data) > len(kzg4844.Blob{}) {
+			return nil, fmt.Errorf("blob %d is %d bytes, max is %d", i, len(data), len(kzg4844.Blob{}))
+		}
+		copy(sidecar.Blobs[i][:], data)
+		commitment, err := kzg4844.BlobToCommitment(sidecar.Blobs[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute commitment of blob %d: %w", i, err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(sidecar.Blobs[i], commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute proof of blob %d: %w", i, err)
+		}
+		sidecar.Commitments[i] = commitment
+		sidecar.Proofs[i] = proof
+	}
+	return sidecar, nil
+}
+
+// encodeBlobSidecar builds the sidecar of the blobs and encodes it to be persisted with the transaction,
+// so that attempts can be built and rebroadcast without the caller. It returns nil if there are no blobs.
+func encodeBlobSidecar(blobs [][]byte) ([]byte, error) {
+	if len(blobs) == 0 {
+		return nil, nil
+	}
+	sidecar, err := NewBlobTxSidecar(blobs)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(sidecar)
+}
+
+func decodeBlobSidecar(b []byte) (*types.BlobTxSidecar, error) {
+	sidecar := new(types.BlobTxSidecar)
+	if err := rlp.DecodeBytes(b, sidecar); err != nil {
+		return nil, fmt.Errorf("failed to decode blob sidecar: %w", err)
+	}
+	return sidecar, nil
+}
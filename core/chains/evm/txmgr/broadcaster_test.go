@@ -4,16 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"math/big"
 	"math/rand"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -183,7 +183,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 
 	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
-	checkerFactory := &txmgr.CheckerFactory{Client: ethClient}
+	checkerFactory := &txmgr.CheckerFactory{Client: ethClient, Simulator: txmgr.NewEvmTxmClient(ethClient, nil)}
 
 	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
 	ethClient.On("PendingNonceAt", mock.Anything, otherAddress).Return(uint64(0), nil).Once()
@@ -446,20 +446,19 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
 				return tx.Nonce() == uint64(344) && tx.Value().Cmp(big.NewInt(442)) == 0
 			}), fromAddress).Return(commonclient.Successful, nil).Once()
-			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
-				if fmt.Sprintf("%s", callarg["value"]) == "0x1ba" { // 442
-					assert.Equal(t, txRequest.FromAddress, callarg["from"])
-					assert.Equal(t, &txRequest.ToAddress, callarg["to"])
-					assert.Equal(t, hexutil.Uint64(txRequest.FeeLimit), callarg["gas"])
-					assert.Nil(t, callarg["gasPrice"])
-					assert.Nil(t, callarg["maxFeePerGas"])
-					assert.Nil(t, callarg["maxPriorityFeePerGas"])
-					assert.Equal(t, (*hexutil.Big)(&txRequest.Value), callarg["value"])
-					assert.Equal(t, hexutil.Bytes(txRequest.EncodedPayload), callarg["data"])
+			ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				if msg.Value.Cmp(big.NewInt(442)) == 0 {
+					assert.Equal(t, txRequest.FromAddress, msg.From)
+					assert.Equal(t, &txRequest.ToAddress, msg.To)
+					assert.Equal(t, txRequest.FeeLimit, msg.Gas)
+					assert.Nil(t, msg.GasPrice)
+					assert.Nil(t, msg.GasFeeCap)
+					assert.Nil(t, msg.GasTipCap)
+					assert.Equal(t, txRequest.EncodedPayload, msg.Data)
 					return true
 				}
 				return false
-			}), "latest").Return(nil).Once()
+			}), big.NewInt(int64(rpc.PendingBlockNumber))).Return(nil, nil).Once()
 
 			ethTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txRequest, testutils.FixtureChainID)
 
@@ -479,9 +478,9 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
 				return tx.Nonce() == uint64(345) && tx.Value().Cmp(big.NewInt(542)) == 0
 			}), fromAddress).Return(commonclient.Successful, nil).Once()
-			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
-				return fmt.Sprintf("%s", callarg["value"]) == "0x21e" // 542
-			}), "latest").Return(errors.New("this is not a revert, something unexpected went wrong")).Once()
+			ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return msg.Value.Cmp(big.NewInt(542)) == 0
+			}), big.NewInt(int64(rpc.PendingBlockNumber))).Return(nil, errors.New("this is not a revert, something unexpected went wrong")).Once()
 
 			ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
 				txRequestWithChecker(checker),
@@ -504,9 +503,9 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 				Message: "oh no, it reverted",
 				Data:    []byte{42, 166, 34},
 			}
-			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
-				return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
-			}), "latest").Return(&jerr).Once()
+			ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return msg.Value.Cmp(big.NewInt(642)) == 0
+			}), big.NewInt(int64(rpc.PendingBlockNumber))).Return(nil, &jerr).Once()
 
 			ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
 				txRequestWithChecker(checker),
@@ -524,6 +523,31 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			assert.Equal(t, "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }", ethTx.Error.String)
 		})
 
+		t.Run("on revert with reason, saves the decoded reason", func(t *testing.T) {
+			jerr := client.JsonError{
+				Code:    3,
+				Message: "execution reverted: oh no",
+				Data:    "0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000056f68206e6f000000000000000000000000000000000000000000000000000000",
+			}
+			ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return msg.Value.Cmp(big.NewInt(742)) == 0
+			}), big.NewInt(int64(rpc.PendingBlockNumber))).Return(nil, &jerr).Once()
+
+			ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+				txRequestWithChecker(checker),
+				txRequestWithValue(big.Int(assets.NewEthValue(742))))
+			{
+				retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+				assert.NoError(t, err)
+				assert.False(t, retryable)
+			}
+
+			ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
+			assert.Equal(t, "transaction reverted during simulation: oh no", ethTx.Error.String)
+		})
+
 		t.Run("terminally stuck transaction is marked as fatal", func(t *testing.T) {
 			terminallyStuckError := "failed to add tx to the pool: not enough step counters to continue the execution"
 			etx := mustCreateUnstartedTx(t, txStore, fromAddress, toAddress, []byte{42, 42, 0}, gasLimit, big.Int(assets.NewEthValue(243)), testutils.FixtureChainID)
@@ -1852,7 +1876,7 @@ func TestEthBroadcaster_NonceTracker_InProgressTx(t *testing.T) {
 
 	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
 	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
-	checkerFactory := &txmgr.CheckerFactory{Client: ethClient}
+	checkerFactory := &txmgr.CheckerFactory{Client: ethClient, Simulator: txmgr.NewEvmTxmClient(ethClient, nil)}
 	lggr := logger.Test(t)
 	ctx := tests.Context(t)
 
@@ -1895,7 +1919,7 @@ func TestEthBroadcaster_HederaBroadcastValidation(t *testing.T) {
 		return gas.NewFixedPriceEstimator(evmcfg.EVM().GasEstimator(), nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge, ethClient)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, estimator)
-	checkerFactory := &txmgr.CheckerFactory{Client: ethClient}
+	checkerFactory := &txmgr.CheckerFactory{Client: ethClient, Simulator: txmgr.NewEvmTxmClient(ethClient, nil)}
 	ctx := tests.Context(t)
 
 	t.Run("transaction successfully broadcasted and increments on-chain nonce", func(t *testing.T) {
@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"time"
 
@@ -38,6 +39,7 @@ func NewTxm(
 	keyStore keystore.Eth,
 	estimator gas.EvmFeeEstimator,
 	headTracker latestAndFinalizedBlockHeadTracker,
+	safeMode txmgrtypes.SafeModeChecker,
 ) (txm TxManager,
 	err error,
 ) {
@@ -48,13 +50,14 @@ func NewTxm(
 	} else {
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
-	checker := &CheckerFactory{Client: client}
+	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
+	checker := &CheckerFactory{Client: client, Simulator: txmClient}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(ds, lggr)
-	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
-	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
-	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
+	txAttemptBuilder.SetBlobSidecarFinder(txStore)
+	txmCfg := NewEvmTxmConfig(chainConfig) // wrap Evm specific config
+	feeCfg := NewEvmTxmFeeConfig(fCfg)     // wrap Evm specific config
 	chainID := txmClient.ConfiguredChainID()
 	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
@@ -65,8 +68,19 @@ func NewTxm(
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
-	return txm, nil
+	evmTxm := NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
+	if txConfig.UserOperations().Enabled() {
+		// the keys owning smart accounts are not exposed by keystore.Eth, which only signs transactions
+		userOpKeyStore, ok := keyStore.(forwarders.UserOperationKeyStore)
+		if !ok {
+			return nil, errors.New("user operations are enabled, but the keystore cannot sign them")
+		}
+		evmTxm.SetUserOperationManager(forwarders.NewUserOpMgr(ds, client, estimator, userOpKeyStore, lggr, txConfig.UserOperations(), fCfg))
+	}
+	if safeMode != nil {
+		evmTxm.SetSafeModeChecker(safeMode)
+	}
+	return evmTxm, nil
 }
 
 // NewEvmTxm creates a new concrete EvmTxm
@@ -105,7 +105,7 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 }
 
 func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Address) (n evmtypes.Nonce, err error) {
-	nextNonce, err := c.client.PendingNonceAt(ctx, fromAddress)
+	nextNonce, err := c.client.PendingNonceAt(client.CtxAddRequestBudgetExemptFlag(ctx), fromAddress)
 	if err != nil {
 		return n, err
 	}
@@ -117,7 +117,7 @@ func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Ad
 }
 
 func (c *evmTxmClient) SequenceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (evmtypes.Nonce, error) {
-	nonce, err := c.client.NonceAt(ctx, addr, blockNum)
+	nonce, err := c.client.NonceAt(client.CtxAddRequestBudgetExemptFlag(ctx), addr, blockNum)
 	if nonce > math.MaxInt64 {
 		return 0, fmt.Errorf("overflow for nonce: %d", nonce)
 	}
@@ -138,7 +138,7 @@ func (c *evmTxmClient) BatchGetReceipts(ctx context.Context, attempts []TxAttemp
 		reqs = append(reqs, req)
 	}
 
-	if err := c.client.BatchCallContext(ctx, reqs); err != nil {
+	if err := c.client.BatchCallContext(client.CtxAddRequestBudgetExemptFlag(ctx), reqs); err != nil {
 		return nil, nil, fmt.Errorf("EthConfirmer#batchFetchReceipts error fetching receipts with BatchCallContext: %w", err)
 	}
 
@@ -189,6 +189,26 @@ func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumbe
 	return client.ExtractRPCError(errCall)
 }
 
+// SimulateTransaction runs the attempt with eth_call at blockNumber, and returns the JSON-RPC error if it failed.
+// Both errors are nil if the call succeeded.
+func (c *evmTxmClient) SimulateTransaction(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error) {
+	_, errCall := c.client.CallContract(ctx, ethereum.CallMsg{
+		From: a.Tx.FromAddress,
+		To:   &a.Tx.ToAddress,
+		Gas:  a.ChainSpecificFeeLimit,
+		// NOTE: Deliberately do not include gas prices. We never want a call to fail
+		// just because the wallet has insufficient eth.
+		// Relevant info regarding EIP1559 transactions: https://github.com/ethereum/go-ethereum/pull/23027
+		Value:      &a.Tx.Value,
+		Data:       a.Tx.EncodedPayload,
+		AccessList: nil,
+	}, blockNumber)
+	if errCall == nil {
+		return nil, nil
+	}
+	return client.ExtractRPCError(errCall)
+}
+
 func (c *evmTxmClient) HeadByHash(ctx context.Context, hash common.Hash) (*evmtypes.Head, error) {
-	return c.client.HeadByHash(ctx, hash)
+	return c.client.HeadByHash(client.CtxAddRequestBudgetExemptFlag(ctx), hash)
 }
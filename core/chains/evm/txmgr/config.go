@@ -23,6 +23,7 @@ type ChainConfig interface {
 type FeeConfig interface {
 	EIP1559DynamicFees() bool
 	BumpPercent() uint16
+	BumpMin() *assets.Wei
 	BumpThreshold() uint64
 	BumpTxDepth() uint32
 	LimitDefault() uint64
@@ -3313,6 +3313,117 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	var resumeErr error
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, func(_ context.Context, _ uuid.UUID, _ interface{}, err error) error {
+		resumeErr = err
+		return nil
+	})
+	ctx := tests.Context(t)
+
+	t.Run("marks unstarted transaction as fatally errored", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+
+		require.NoError(t, ec.CancelTransaction(ctx, etx))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, dbTx.State)
+		assert.Equal(t, txmgrcommon.ErrTxCancelled.Error(), dbTx.Error.String)
+		assert.True(t, dbTx.Cancelled)
+
+		err = ec.CancelTransaction(ctx, etx)
+		require.ErrorContains(t, err, "no longer unstarted")
+	})
+
+	t.Run("purges unconfirmed transaction", func(t *testing.T) {
+		etx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, 100, assets.GWei(20))
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, signal_callback = TRUE WHERE id = $2`, uuid.New(), etx.ID)
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, ec.CancelTransaction(ctx, etx))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, dbTx.State)
+		require.Len(t, dbTx.TxAttempts, 2)
+		purgeAttempt := dbTx.TxAttempts[0]
+		assert.True(t, purgeAttempt.IsPurgeAttempt)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, purgeAttempt.State)
+		assert.True(t, purgeAttempt.TxFee.GasPrice.Cmp(assets.GWei(20)) > 0)
+		assert.True(t, dbTx.Cancelled)
+		// The task run is only resumed once the purge attempt is included
+		assert.False(t, dbTx.CallbackCompleted)
+		require.NoError(t, resumeErr)
+
+		err = ec.CancelTransaction(ctx, dbTx)
+		require.ErrorContains(t, err, "already being purged")
+	})
+
+	t.Run("does not cancel confirmed transaction", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 100, fromAddress)
+
+		err := ec.CancelTransaction(ctx, etx)
+		require.ErrorContains(t, err, "cannot cancel transaction")
+	})
+}
+
+func TestEthConfirmer_BumpTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, nil)
+	ctx := tests.Context(t)
+
+	etx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, 100, assets.GWei(20))
+
+	t.Run("does not bump with a fee of the wrong type", func(t *testing.T) {
+		err := ec.BumpTransaction(ctx, etx, gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.GWei(50), GasTipCap: assets.GWei(2)}})
+		require.ErrorContains(t, err, "failed to create attempt")
+	})
+
+	t.Run("does not bump with a fee lower than the one of the latest attempt", func(t *testing.T) {
+		err := ec.BumpTransaction(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(10)})
+		require.ErrorContains(t, err, "must be higher than the gas price")
+	})
+
+	t.Run("creates attempt with the given fee", func(t *testing.T) {
+		require.NoError(t, ec.BumpTransaction(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(50)}))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, dbTx.TxAttempts, 2)
+		attempt := dbTx.TxAttempts[0]
+		assert.False(t, attempt.IsPurgeAttempt)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, attempt.State)
+		assert.Equal(t, assets.GWei(50), attempt.TxFee.GasPrice)
+		assert.Equal(t, etx.TxAttempts[0].ChainSpecificFeeLimit, attempt.ChainSpecificFeeLimit)
+	})
+
+	t.Run("does not bump unstarted transaction", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+
+		err := ec.BumpTransaction(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(50)})
+		require.ErrorContains(t, err, "cannot bump transaction")
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
 
 func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Client, gconfig chainlink.GeneralConfig, config evmconfig.ChainScopedConfig, ks keystore.Eth, fn txmgrcommon.ResumeCallback) *txmgr.Confirmer {
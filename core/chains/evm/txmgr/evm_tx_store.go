@@ -48,6 +48,7 @@ type EvmTxStore interface {
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
 	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	FindTxBlobSidecar(ctx context.Context, etxID int64) ([]byte, error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -133,6 +134,7 @@ type dbReceiptPlus struct {
 	ID           uuid.UUID        `db:"pipeline_task_run_id"`
 	Receipt      evmtypes.Receipt `db:"receipt"`
 	FailOnRevert bool             `db:"FailOnRevert"`
+	Cancelled    bool             `db:"cancelled"`
 }
 
 func fromDBReceipts(rs []DbReceipt) []*evmtypes.Receipt {
@@ -150,11 +152,20 @@ func fromDBReceiptsPlus(rs []dbReceiptPlus) []ReceiptPlus {
 			ID:           rs[i].ID,
 			Receipt:      &rs[i].Receipt,
 			FailOnRevert: rs[i].FailOnRevert,
+			Cancelled:    rs[i].Cancelled,
 		}
 	}
 	return receipts
 }
 
+// weiOrNil converts an optional receipt amount to a query arg, keeping it NULL if missing.
+func weiOrNil(i *big.Int) interface{} {
+	if i == nil {
+		return nil
+	}
+	return assets.NewWei(i)
+}
+
 func toOnchainReceipt(rs []*evmtypes.Receipt) []rawOnchainReceipt {
 	receipts := make([]rawOnchainReceipt, len(rs))
 	for i := 0; i < len(rs); i++ {
@@ -199,6 +210,8 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	Priority          txmgrtypes.TxPriority
+	Cancelled         bool
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +234,8 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
+	db.Cancelled = tx.Cancelled
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +271,8 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
+	tx.Cancelled = db.Cancelled
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -288,6 +305,7 @@ type DbEthTxAttempt struct {
 	TxType                  int
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
+	BlobFeeCap              *assets.Wei
 	IsPurgeAttempt          bool
 }
 
@@ -303,6 +321,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -333,6 +352,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 	attempt.TxFee = gas.EvmFee{
 		GasPrice:   db.GasPrice,
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		BlobFeeCap: db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -358,8 +378,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, blob_fee_cap, is_purge_attempt)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :blob_fee_cap, :is_purge_attempt)
 RETURNING *;
 `
 
@@ -530,8 +550,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -540,11 +560,37 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if err != nil {
 		return pkgerrors.Wrap(err, "InsertTx failed to bind named")
 	}
-	err = o.q.GetContext(ctx, &dbTx, query, args...)
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if err = orm.q.GetContext(ctx, &dbTx, query, args...); err != nil {
+			return err
+		}
+		return orm.insertTxBlobSidecar(ctx, dbTx.ID, etx.BlobSidecar)
+	})
 	dbTx.ToTx(etx)
 	return pkgerrors.Wrap(err, "InsertTx failed")
 }
 
+func (o *evmTxStore) insertTxBlobSidecar(ctx context.Context, etxID int64, blobSidecar []byte) error {
+	if len(blobSidecar) == 0 {
+		return nil
+	}
+	_, err := o.q.ExecContext(ctx, `INSERT INTO evm.tx_blob_sidecars (eth_tx_id, sidecar) VALUES ($1, $2)`, etxID, blobSidecar)
+	return pkgerrors.Wrap(err, "failed to insert blob sidecar")
+}
+
+// FindTxBlobSidecar returns the encoded blob sidecar of a transaction, or nil if it does not carry blobs.
+// Sidecars are not loaded with the transactions since they are up to 768KB, and are only needed to build attempts.
+func (o *evmTxStore) FindTxBlobSidecar(ctx context.Context, etxID int64) (blobSidecar []byte, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.GetContext(ctx, &blobSidecar, `SELECT sidecar FROM evm.tx_blob_sidecars WHERE eth_tx_id = $1`, etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return blobSidecar, pkgerrors.Wrap(err, "FindTxBlobSidecar failed")
+}
+
 // InsertTxAttempt inserts a new txAttempt into the database
 func (o *evmTxStore) InsertTxAttempt(ctx context.Context, attempt *TxAttempt) error {
 	var dbTxAttempt DbEthTxAttempt
@@ -934,8 +980,14 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	// # EthTxes update
 	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed.
 	//
-	var valueStrs []string
-	var valueArgs []interface{}
+	// # TxCosts upsert
+	// Records the gas spent by the eth_tx in the cost ledger, attributed to
+	// the job from the meta, or else from the pipeline run which created it.
+	// RPCs which don't return effectiveGasPrice fall back to the price of the
+	// attempt, which is an upper bound for EIP-1559 transactions.
+	//
+	var valueStrs, costValueStrs []string
+	var valueArgs, costValueArgs []interface{}
 	for _, r := range receipts {
 		var receiptJSON []byte
 		receiptJSON, err = json.Marshal(r)
@@ -944,8 +996,11 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 		}
 		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
 		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		costValueStrs = append(costValueStrs, "(?::bytea,?::bigint,?::bigint,?::numeric,?::numeric)")
+		costValueArgs = append(costValueArgs, r.TxHash, r.BlockNumber.Int64(), r.GasUsed, weiOrNil(r.EffectiveGasPrice), weiOrNil(r.L1Fee))
 	}
-	valueArgs = append(valueArgs, state, errorMsg, chainID.String())
+	valueArgs = append(valueArgs, costValueArgs...)
+	valueArgs = append(valueArgs, chainID.String(), state, errorMsg, chainID.String())
 
 	/* #nosec G201 */
 	sql := `
@@ -966,6 +1021,28 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 		FROM inserted_receipts
 		WHERE inserted_receipts.tx_hash = evm.tx_attempts.hash
 		RETURNING evm.tx_attempts.eth_tx_id
+	),
+	upserted_tx_costs AS (
+		INSERT INTO evm.tx_costs (eth_tx_id, evm_chain_id, from_address, job_id, tx_hash, block_number, gas_used, effective_gas_price, l1_fee, created_at)
+		SELECT t.id, t.evm_chain_id, t.from_address,
+			COALESCE((t.meta->>'JobID')::integer, (
+				SELECT jps.job_id FROM pipeline_task_runs ptr
+				JOIN pipeline_runs pr ON pr.id = ptr.pipeline_run_id
+				JOIN job_pipeline_specs jps ON jps.pipeline_spec_id = pr.pipeline_spec_id
+				WHERE ptr.id = t.pipeline_task_run_id
+				LIMIT 1
+			)),
+			c.tx_hash, c.block_number, c.gas_used, COALESCE(c.effective_gas_price, a.gas_price, a.gas_fee_cap, 0), COALESCE(c.l1_fee, 0), NOW()
+		FROM (VALUES %s) AS c (tx_hash, block_number, gas_used, effective_gas_price, l1_fee)
+		JOIN evm.tx_attempts a ON a.hash = c.tx_hash
+		JOIN evm.txes t ON t.id = a.eth_tx_id
+		WHERE t.evm_chain_id = ?
+		ON CONFLICT (evm_chain_id, eth_tx_id) DO UPDATE SET
+			tx_hash = EXCLUDED.tx_hash,
+			block_number = EXCLUDED.block_number,
+			gas_used = EXCLUDED.gas_used,
+			effective_gas_price = EXCLUDED.effective_gas_price,
+			l1_fee = EXCLUDED.l1_fee
 	)
 	UPDATE evm.txes
 	SET state = ?, error = ?
@@ -974,7 +1051,7 @@ func (o *evmTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Rece
 	AND evm_chain_id = ?
 	`
 
-	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
+	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","), strings.Join(costValueStrs, ","))
 
 	stmt = sqlx.Rebind(sqlx.DOLLAR, stmt)
 
@@ -1062,7 +1139,7 @@ func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, latest, finali
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	err = o.q.SelectContext(ctx, &rs, `
-	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert" FROM evm.txes
+	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert", (evm.txes.cancelled AND evm.tx_attempts.is_purge_attempt) "cancelled" FROM evm.txes
 	INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id
 	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
 	WHERE evm.txes.pipeline_task_run_id IS NOT NULL AND evm.txes.signal_callback = TRUE AND evm.txes.callback_completed = FALSE
@@ -1170,6 +1247,9 @@ func updateEthTxUnconfirm(ctx context.Context, orm *evmTxStore, etx Tx) error {
 
 func deleteEthReceipts(ctx context.Context, orm *evmTxStore, etxID int64) (err error) {
 	_, err = orm.q.ExecContext(ctx, `
+WITH deleted_tx_costs AS (
+	DELETE FROM evm.tx_costs WHERE eth_tx_id = $1
+)
 DELETE FROM evm.receipts
 USING evm.tx_attempts
 WHERE evm.receipts.tx_hash = evm.tx_attempts.hash
@@ -1328,6 +1408,30 @@ func (o *evmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt TxAtte
 	return pkgerrors.Wrap(err, "DeleteInProgressAttempt failed")
 }
 
+// SaveCancelAttempt marks the unconfirmed tx of the purge attempt as cancelled, and saves the attempt as in_progress.
+func (o *evmTxStore) SaveCancelAttempt(ctx context.Context, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if !attempt.IsPurgeAttempt {
+		return errors.New("SaveCancelAttempt failed: attempt must be a purge attempt")
+	}
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		res, err := orm.q.ExecContext(ctx, `UPDATE evm.txes SET cancelled=TRUE WHERE id=$1 AND state=$2`, attempt.TxID, txmgr.TxUnconfirmed)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SaveCancelAttempt failed to update eth_tx")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return pkgerrors.Wrap(err, "SaveCancelAttempt failed to get RowsAffected")
+		}
+		if rowsAffected == 0 {
+			return pkgerrors.Errorf("SaveCancelAttempt failed: transaction %d is no longer unconfirmed", attempt.TxID)
+		}
+		return orm.SaveInProgressAttempt(ctx, attempt)
+	})
+}
+
 // SaveInProgressAttempt inserts or updates an attempt
 func (o *evmTxStore) SaveInProgressAttempt(ctx context.Context, attempt *TxAttempt) error {
 	var cancel context.CancelFunc
@@ -1599,7 +1703,7 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1673,6 +1777,26 @@ func (o *evmTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, e
 	})
 }
 
+// UpdateTxUnstartedToCancelled marks an unstarted tx as fatally errored with the error set on etx, and as cancelled.
+// The update only applies while the tx is unstarted, so that it can't race with the Broadcaster moving it to in_progress.
+func (o *evmTxStore) UpdateTxUnstartedToCancelled(ctx context.Context, etx *Tx) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if !etx.Error.Valid {
+		return errors.New("expected error field to be set")
+	}
+	var dbEtx DbEthTx
+	err := o.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, cancelled=TRUE WHERE id=$3 AND state=$4 RETURNING *`, txmgr.TxFatalError, etx.Error, etx.ID, txmgr.TxUnstarted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pkgerrors.Errorf("UpdateTxUnstartedToCancelled failed: transaction %d is no longer unstarted", etx.ID)
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "UpdateTxUnstartedToCancelled failed to update eth_tx")
+	}
+	dbEtx.ToTx(etx)
+	return nil
+}
+
 // Updates eth tx from unstarted to in_progress and inserts in_progress eth attempt
 func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
 	var cancel context.CancelFunc
@@ -1734,7 +1858,11 @@ func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx,
 		dbAttempt.ToTxAttempt(attempt)
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4 WHERE id=$5 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID)
+		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4 WHERE id=$5 AND state=$6 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID, txmgr.TxUnstarted)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The tx was cancelled by an operator since it was loaded
+			return txmgr.ErrTxRemoved
+		}
 		dbEtx.ToTx(etx)
 		return pkgerrors.Wrap(err, "UpdateTxUnstartedToInProgress failed to update eth_tx")
 	})
@@ -1839,6 +1967,10 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
+	blobSidecar, err := encodeBlobSidecar(txRequest.Blobs)
+	if err != nil {
+		return tx, pkgerrors.Wrap(err, "CreateEthTransaction failed to build blob sidecar")
+	}
 	var dbEtx DbEthTx
 	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
 		if txRequest.PipelineTaskRunID != nil {
@@ -1853,16 +1985,16 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
-		return nil
+		return pkgerrors.Wrap(orm.insertTxBlobSidecar(ctx, dbEtx.ID, blobSidecar), "CreateEthTransaction")
 	})
 	var etx Tx
 	dbEtx.ToTx(&etx)
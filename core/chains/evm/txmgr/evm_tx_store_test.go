@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -689,8 +691,27 @@ func TestORM_FindTxesPendingCallback(t *testing.T) {
 	require.NoError(t, err)
 	if assert.Len(t, receiptsPlus, 1) {
 		assert.Equal(t, tr1.ID, receiptsPlus[0].ID)
+		assert.False(t, receiptsPlus[0].Cancelled)
 	}
 
+	// Cancelled tx, but the original attempt was included
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET cancelled = TRUE WHERE id = $1`, etx1.ID)
+	receiptsPlus, err = txStore.FindTxesPendingCallback(tests.Context(t), head.Number, 0, ethClient.ConfiguredChainID())
+	require.NoError(t, err)
+	if assert.Len(t, receiptsPlus, 1) {
+		assert.False(t, receiptsPlus[0].Cancelled)
+	}
+
+	// Cancelled tx, and the purge attempt was included
+	pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET is_purge_attempt = TRUE WHERE id = $1`, attempt1.ID)
+	receiptsPlus, err = txStore.FindTxesPendingCallback(tests.Context(t), head.Number, 0, ethClient.ConfiguredChainID())
+	require.NoError(t, err)
+	if assert.Len(t, receiptsPlus, 1) {
+		assert.True(t, receiptsPlus[0].Cancelled)
+	}
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET cancelled = FALSE WHERE id = $1`, etx1.ID)
+	pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET is_purge_attempt = FALSE WHERE id = $1`, attempt1.ID)
+
 	// Clear min_confirmations
 	pgtest.MustExec(t, db, `UPDATE evm.txes SET min_confirmations = NULL WHERE id = $1`, etx1.ID)
 
@@ -1269,6 +1290,33 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds higher priority tx first", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		low := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+			FromAddress: fromAddress,
+			ToAddress:   testutils.NewAddress(),
+			Strategy:    txmgrcommon.NewSendEveryStrategy(),
+			Priority:    txmgr.TxPriorityLow,
+		}, testutils.FixtureChainID)
+		high := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+			FromAddress: fromAddress,
+			ToAddress:   testutils.NewAddress(),
+			Strategy:    txmgrcommon.NewSendEveryStrategy(),
+			Priority:    txmgr.TxPriorityHigh,
+		}, testutils.FixtureChainID)
+
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, high.ID, resultEtx.ID)
+		assert.Equal(t, txmgr.TxPriorityHigh, resultEtx.Priority)
+
+		high.Error = null.StringFrom("fatal")
+		require.NoError(t, txStore.UpdateTxFatalError(tests.Context(t), &high))
+		resultEtx, err = txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, low.ID, resultEtx.ID)
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1814,6 +1862,44 @@ func TestORM_CreateTransaction(t *testing.T) {
 		assert.Equal(t, fromAddress, dbEthTx.FromAddress)
 		assert.Equal(t, true, dbEthTx.SignalCallback)
 	})
+
+	t.Run("persists the sidecar of blob transactions", func(t *testing.T) {
+		blob := []byte{1, 2, 3}
+		etx, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Blobs:          [][]byte{blob},
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+
+		// the sidecar is not loaded with the transaction
+		etx, err = txStore.FindTxWithAttempts(tests.Context(t), etx.ID)
+		require.NoError(t, err)
+		assert.Empty(t, etx.BlobSidecar)
+
+		encodedSidecar, err := txStore.FindTxBlobSidecar(tests.Context(t), etx.ID)
+		require.NoError(t, err)
+		var sidecar gethtypes.BlobTxSidecar
+		require.NoError(t, rlp.DecodeBytes(encodedSidecar, &sidecar))
+		require.Len(t, sidecar.Blobs, 1)
+		assert.Equal(t, blob, sidecar.Blobs[0][:len(blob)])
+		assert.Len(t, sidecar.Commitments, 1)
+		assert.Len(t, sidecar.Proofs, 1)
+	})
+
+	t.Run("rejects blobs which are too large", func(t *testing.T) {
+		_, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress: fromAddress,
+			ToAddress:   toAddress,
+			FeeLimit:    gasLimit,
+			Strategy:    txmgrcommon.NewSendEveryStrategy(),
+			Blobs:       [][]byte{make([]byte, 131073)},
+		}, ethClient.ConfiguredChainID())
+		assert.ErrorContains(t, err, "blob 0 is 131073 bytes")
+	})
 }
 
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
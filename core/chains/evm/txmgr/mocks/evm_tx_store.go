@@ -1097,6 +1097,65 @@ func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// FindTxBlobSidecar provides a mock function with given fields: ctx, etxID
+func (_m *EvmTxStore) FindTxBlobSidecar(ctx context.Context, etxID int64) ([]byte, error) {
+	ret := _m.Called(ctx, etxID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxBlobSidecar")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]byte, error)); ok {
+		return rf(ctx, etxID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []byte); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, etxID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxBlobSidecar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxBlobSidecar'
+type EvmTxStore_FindTxBlobSidecar_Call struct {
+	*mock.Call
+}
+
+// FindTxBlobSidecar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+func (_e *EvmTxStore_Expecter) FindTxBlobSidecar(ctx interface{}, etxID interface{}) *EvmTxStore_FindTxBlobSidecar_Call {
+	return &EvmTxStore_FindTxBlobSidecar_Call{Call: _e.mock.On("FindTxBlobSidecar", ctx, etxID)}
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) Run(run func(ctx context.Context, etxID int64)) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) Return(_a0 []byte, _a1 error) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) RunAndReturn(run func(context.Context, int64) ([]byte, error)) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxByHash provides a mock function with given fields: ctx, hash
 func (_m *EvmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*txmgr.Tx, error) {
 	ret := _m.Called(ctx, hash)
@@ -2419,6 +2478,53 @@ func (_c *EvmTxStore_ReapTxHistory_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// SaveCancelAttempt provides a mock function with given fields: ctx, attempt
+func (_m *EvmTxStore) SaveCancelAttempt(ctx context.Context, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCancelAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SaveCancelAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCancelAttempt'
+type EvmTxStore_SaveCancelAttempt_Call struct {
+	*mock.Call
+}
+
+// SaveCancelAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt *types.TxAttempt[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) SaveCancelAttempt(ctx interface{}, attempt interface{}) *EvmTxStore_SaveCancelAttempt_Call {
+	return &EvmTxStore_SaveCancelAttempt_Call{Call: _e.mock.On("SaveCancelAttempt", ctx, attempt)}
+}
+
+func (_c *EvmTxStore_SaveCancelAttempt_Call) Run(run func(ctx context.Context, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_SaveCancelAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SaveCancelAttempt_Call) Return(_a0 error) *EvmTxStore_SaveCancelAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SaveCancelAttempt_Call) RunAndReturn(run func(context.Context, *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_SaveCancelAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -3248,6 +3354,53 @@ func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingReceiptIds_Call) RunAndReturn
 	return _c
 }
 
+// UpdateTxUnstartedToCancelled provides a mock function with given fields: ctx, etx
+func (_m *EvmTxStore) UpdateTxUnstartedToCancelled(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxUnstartedToCancelled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxUnstartedToCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxUnstartedToCancelled'
+type EvmTxStore_UpdateTxUnstartedToCancelled_Call struct {
+	*mock.Call
+}
+
+// UpdateTxUnstartedToCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) UpdateTxUnstartedToCancelled(ctx interface{}, etx interface{}) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	return &EvmTxStore_UpdateTxUnstartedToCancelled_Call{Call: _e.mock.On("UpdateTxUnstartedToCancelled", ctx, etx)}
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) Return(_a0 error) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxUnstartedToInProgress provides a mock function with given fields: ctx, etx, attempt
 func (_m *EvmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx, attempt)
@@ -0,0 +1,159 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+// TxCostORM is an autogenerated mock type for the TxCostORM type
+type TxCostORM struct {
+	mock.Mock
+}
+
+type TxCostORM_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TxCostORM) EXPECT() *TxCostORM_Expecter {
+	return &TxCostORM_Expecter{mock: &_m.Mock}
+}
+
+// JobTxCostsSince provides a mock function with given fields: ctx, jobID, since
+func (_m *TxCostORM) JobTxCostsSince(ctx context.Context, jobID int32, since time.Time) ([]txmgr.TxCost, error) {
+	ret := _m.Called(ctx, jobID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobTxCostsSince")
+	}
+
+	var r0 []txmgr.TxCost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) ([]txmgr.TxCost, error)); ok {
+		return rf(ctx, jobID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) []txmgr.TxCost); ok {
+		r0 = rf(ctx, jobID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.TxCost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, time.Time) error); ok {
+		r1 = rf(ctx, jobID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxCostORM_JobTxCostsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JobTxCostsSince'
+type TxCostORM_JobTxCostsSince_Call struct {
+	*mock.Call
+}
+
+// JobTxCostsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - since time.Time
+func (_e *TxCostORM_Expecter) JobTxCostsSince(ctx interface{}, jobID interface{}, since interface{}) *TxCostORM_JobTxCostsSince_Call {
+	return &TxCostORM_JobTxCostsSince_Call{Call: _e.mock.On("JobTxCostsSince", ctx, jobID, since)}
+}
+
+func (_c *TxCostORM_JobTxCostsSince_Call) Run(run func(ctx context.Context, jobID int32, since time.Time)) *TxCostORM_JobTxCostsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TxCostORM_JobTxCostsSince_Call) Return(_a0 []txmgr.TxCost, _a1 error) *TxCostORM_JobTxCostsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxCostORM_JobTxCostsSince_Call) RunAndReturn(run func(context.Context, int32, time.Time) ([]txmgr.TxCost, error)) *TxCostORM_JobTxCostsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TxCostsSince provides a mock function with given fields: ctx, since
+func (_m *TxCostORM) TxCostsSince(ctx context.Context, since time.Time) ([]txmgr.TxCost, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxCostsSince")
+	}
+
+	var r0 []txmgr.TxCost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]txmgr.TxCost, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []txmgr.TxCost); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.TxCost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxCostORM_TxCostsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxCostsSince'
+type TxCostORM_TxCostsSince_Call struct {
+	*mock.Call
+}
+
+// TxCostsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *TxCostORM_Expecter) TxCostsSince(ctx interface{}, since interface{}) *TxCostORM_TxCostsSince_Call {
+	return &TxCostORM_TxCostsSince_Call{Call: _e.mock.On("TxCostsSince", ctx, since)}
+}
+
+func (_c *TxCostORM_TxCostsSince_Call) Run(run func(ctx context.Context, since time.Time)) *TxCostORM_TxCostsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TxCostORM_TxCostsSince_Call) Return(_a0 []txmgr.TxCost, _a1 error) *TxCostORM_TxCostsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxCostORM_TxCostsSince_Call) RunAndReturn(run func(context.Context, time.Time) ([]txmgr.TxCost, error)) *TxCostORM_TxCostsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTxCostORM creates a new instance of TxCostORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTxCostORM(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TxCostORM {
+	mock := &TxCostORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -35,6 +35,7 @@ type (
 	TxRequest              = txmgrtypes.TxRequest[common.Address, common.Hash]
 	Tx                     = txmgrtypes.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	TxMeta                 = txmgrtypes.TxMeta[common.Address, common.Hash]
+	TxPriority             = txmgrtypes.TxPriority
 	TxAttempt              = txmgrtypes.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	Receipt                = DbReceipt // DbReceipt is the exported DB table model for receipts
 	ReceiptPlus            = txmgrtypes.ReceiptPlus[*evmtypes.Receipt]
@@ -65,6 +66,12 @@ const (
 	TransmitCheckerTypeVRFV2Plus = txmgrtypes.TransmitCheckerType("vrf_v2plus")
 )
 
+const (
+	TxPriorityLow    = txmgrtypes.TxPriorityLow
+	TxPriorityNormal = txmgrtypes.TxPriorityNormal
+	TxPriorityHigh   = txmgrtypes.TxPriorityHigh
+)
+
 // GetGethSignedTx decodes the SignedRawTx into a types.Transaction struct
 func GetGethSignedTx(signedRawTx []byte) (*types.Transaction, error) {
 	s := rlp.NewStream(bytes.NewReader(signedRawTx), 0)
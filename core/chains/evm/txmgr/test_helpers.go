@@ -103,6 +103,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Mempool() evmconfig.Mempool {
+	return &TestMempoolConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -157,6 +161,14 @@ type TestFeeHistoryConfig struct {
 
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
+type TestMempoolConfig struct {
+	evmconfig.Mempool
+}
+
+func (m *TestMempoolConfig) TargetBlocks() uint16          { return 42 }
+func (m *TestMempoolConfig) Percentile() uint16            { return 42 }
+func (m *TestMempoolConfig) TxPoolInterval() time.Duration { return 42 * time.Second }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -170,6 +182,9 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) UserOperations() evmconfig.UserOperationsConfig {
+	return &userOperationsConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -177,6 +192,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type userOperationsConfig struct {
+	evmconfig.UserOperationsConfig
+}
+
+func (u *userOperationsConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
@@ -2,8 +2,10 @@ package txmgr
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -41,16 +43,22 @@ var (
 	_ TransmitChecker        = &VRFV2Checker{}
 )
 
+// TxSimulator simulates transaction attempts, see evmTxmClient.SimulateTransaction.
+type TxSimulator interface {
+	SimulateTransaction(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error)
+}
+
 // CheckerFactory is a real implementation of TransmitCheckerFactory.
 type CheckerFactory struct {
-	Client evmclient.Client
+	Client    evmclient.Client
+	Simulator TxSimulator
 }
 
 // BuildChecker satisfies the TransmitCheckerFactory interface.
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{c.Simulator}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -117,48 +125,55 @@ func (noChecker) Check(
 	return nil
 }
 
-// SimulateChecker simulates transactions, producing an error if they revert on chain.
+// SimulateChecker simulates transactions at the pending block, producing an error with the
+// decoded revert reason if they would revert on chain.
 type SimulateChecker struct {
-	Client evmclient.Client
+	Client TxSimulator
 }
 
 // Check satisfies the TransmitChecker interface.
 func (s *SimulateChecker) Check(
 	ctx context.Context,
 	l logger.SugaredLogger,
-	tx Tx,
+	_ Tx,
 	a TxAttempt,
 ) error {
-	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
-	callArg := map[string]interface{}{
-		"from": tx.FromAddress,
-		"to":   &tx.ToAddress,
-		"gas":  hexutil.Uint64(a.ChainSpecificFeeLimit),
-		// NOTE: Deliberately do not include gas prices. We never want to fatally error a
-		// transaction just because the wallet has insufficient eth.
-		// Relevant info regarding EIP1559 transactions: https://github.com/ethereum/go-ethereum/pull/23027
-		"gasPrice":             nil,
-		"maxFeePerGas":         nil,
-		"maxPriorityFeePerGas": nil,
-		"value":                (*hexutil.Big)(&tx.Value),
-		"data":                 hexutil.Bytes(tx.EncodedPayload),
-	}
-	var b hexutil.Bytes
-	// always run simulation on "latest" block
-	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	rpcErr, err := s.Client.SimulateTransaction(ctx, a, big.NewInt(int64(rpc.PendingBlockNumber)))
 	if err != nil {
-		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
-			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
-		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
-			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
-	} else {
-		l.Debugw("Transaction simulation succeeded",
-			"ethTxAttemptID", a.ID, "txHash", a.Hash, "returnValue", b.String())
+			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+		return nil
 	}
-	return nil
+	if rpcErr == nil {
+		l.Debugw("Transaction simulation succeeded", "ethTxAttemptID", a.ID, "txHash", a.Hash)
+		return nil
+	}
+	l.Criticalw("Transaction reverted during simulation",
+		"ethTxAttemptID", a.ID, "txHash", a.Hash, "rpcErr", rpcErr.String())
+	if jErr, ok := rpcErr.(*evmclient.JsonError); ok {
+		if reason, decoded := revertReason(jErr); decoded {
+			return pkgerrors.Errorf("transaction reverted during simulation: %s", reason)
+		}
+	}
+	return pkgerrors.Errorf("transaction reverted during simulation: %s", rpcErr.String())
+}
+
+// revertReason decodes the reason of a revert from the data of a JSON-RPC error, which is
+// the hex encoded return data of the call.
+func revertReason(jErr *evmclient.JsonError) (string, bool) {
+	data, ok := jErr.Data.(string)
+	if !ok {
+		return "", false
+	}
+	b, err := hexutil.Decode(data)
+	if err != nil {
+		return "", false
+	}
+	reason, err := abi.UnpackRevert(b)
+	if err != nil {
+		return "", false
+	}
+	return reason, true
 }
 
 // VRFV1Checker is an implementation of TransmitChecker that checks whether a VRF V1 fulfillment
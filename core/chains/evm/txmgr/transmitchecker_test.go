@@ -3,11 +3,11 @@ package txmgr_test
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -32,7 +32,8 @@ import (
 
 func TestFactory(t *testing.T) {
 	client := testutils.NewEthClientMockWithDefaultChain(t)
-	factory := &txmgr.CheckerFactory{Client: client}
+	txmClient := txmgr.NewEvmTxmClient(client, nil)
+	factory := &txmgr.CheckerFactory{Client: client, Simulator: txmClient}
 
 	t.Run("no checker", func(t *testing.T) {
 		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{})
@@ -90,7 +91,7 @@ func TestFactory(t *testing.T) {
 			CheckerType: txmgr.TransmitCheckerTypeSimulate,
 		})
 		require.NoError(t, err)
-		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
+		require.Equal(t, &txmgr.SimulateChecker{Client: txmClient}, c)
 	})
 
 	t.Run("invalid checker type", func(t *testing.T) {
@@ -112,7 +113,7 @@ func TestTransmitCheckers(t *testing.T) {
 	})
 
 	t.Run("simulate", func(t *testing.T) {
-		checker := txmgr.SimulateChecker{Client: client}
+		checker := txmgr.SimulateChecker{Client: txmgr.NewEvmTxmClient(client, nil)}
 
 		tx := txmgr.Tx{
 			FromAddress:    common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9"),
@@ -124,18 +125,19 @@ func TestTransmitCheckers(t *testing.T) {
 			State:          txmgrcommon.TxUnstarted,
 		}
 		attempt := txmgr.TxAttempt{
-			Tx:        tx,
-			Hash:      common.Hash{},
-			CreatedAt: tx.CreatedAt,
-			State:     txmgrtypes.TxAttemptInProgress,
+			Tx:                    tx,
+			Hash:                  common.Hash{},
+			CreatedAt:             tx.CreatedAt,
+			State:                 txmgrtypes.TxAttemptInProgress,
+			ChainSpecificFeeLimit: 1e9,
 		}
+		pending := big.NewInt(int64(rpc.PendingBlockNumber))
+		callMsg := mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return msg.Value.Cmp(big.NewInt(642)) == 0 && msg.Gas == 1e9 && msg.GasPrice == nil && msg.GasFeeCap == nil
+		})
 
 		t.Run("success", func(t *testing.T) {
-			client.On("CallContext", mock.Anything,
-				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
-				mock.MatchedBy(func(callarg map[string]interface{}) bool {
-					return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
-				}), "latest").Return(nil).Once()
+			client.On("CallContract", mock.Anything, callMsg, pending).Return(nil, nil).Once()
 
 			require.NoError(t, checker.Check(ctx, log, tx, attempt))
 		})
@@ -146,23 +148,27 @@ func TestTransmitCheckers(t *testing.T) {
 				Message: "oh no, it reverted",
 				Data:    []byte{42, 166, 34},
 			}
-			client.On("CallContext", mock.Anything,
-				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
-				mock.MatchedBy(func(callarg map[string]interface{}) bool {
-					return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
-				}), "latest").Return(&jerr).Once()
+			client.On("CallContract", mock.Anything, callMsg, pending).Return(nil, &jerr).Once()
 
 			err := checker.Check(ctx, log, tx, attempt)
 			expErrMsg := "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }"
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("revert with reason", func(t *testing.T) {
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted: oh no",
+				Data:    "0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000056f68206e6f000000000000000000000000000000000000000000000000000000",
+			}
+			client.On("CallContract", mock.Anything, callMsg, pending).Return(nil, &jerr).Once()
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: oh no")
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
-			client.On("CallContext", mock.Anything,
-				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
-				mock.MatchedBy(func(callarg map[string]interface{}) bool {
-					return fmt.Sprintf("%s", callarg["value"]) == "0x282" // 642
-				}), "latest").Return(pkgerrors.New("error")).Once()
+			client.On("CallContract", mock.Anything, callMsg, pending).Return(nil, pkgerrors.New("error")).Once()
 
 			// Non-revert errors are logged but should not prevent transmission, and do not need
 			// to be passed to the caller
@@ -0,0 +1,70 @@
+package txmgr
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// DefaultTxCostsWindow is the period reported when no start time is given.
+const DefaultTxCostsWindow = 7 * 24 * time.Hour
+
+// TxCost is the gas spent by the confirmed transactions of a job, sent from a single key on a single chain.
+// The ledger is filled in as receipts are saved, see SaveFetchedReceipts.
+type TxCost struct {
+	// JobID is nil for transactions which are not attributed to a job.
+	JobID       *int32   `db:"job_id"`
+	EVMChainID  ubig.Big `db:"evm_chain_id"`
+	FromAddress common.Address
+	TxCount     int64
+	GasUsed     int64
+	// GasFee is the sum of gasUsed * effectiveGasPrice.
+	GasFee assets.Wei
+	// L1Fee is the data availability fee paid on rollups, which is not included in GasFee.
+	L1Fee assets.Wei `db:"l1_fee"`
+}
+
+// TotalFee returns the total amount spent, including L1 fees.
+func (c TxCost) TotalFee() *assets.Wei {
+	return c.GasFee.Add(&c.L1Fee)
+}
+
+type TxCostORM interface {
+	// TxCostsSince returns the gas spent since the given time, grouped by job, chain and key, most expensive first.
+	TxCostsSince(ctx context.Context, since time.Time) ([]TxCost, error)
+	// JobTxCostsSince returns the gas spent by a job since the given time, grouped by chain and key, most expensive first.
+	JobTxCostsSince(ctx context.Context, jobID int32, since time.Time) ([]TxCost, error)
+}
+
+type txCostORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ TxCostORM = (*txCostORM)(nil)
+
+func NewTxCostORM(ds sqlutil.DataSource) TxCostORM {
+	return &txCostORM{ds: ds}
+}
+
+const selectTxCostsSQL = `SELECT job_id, evm_chain_id, from_address, COUNT(*) AS tx_count, SUM(gas_used)::bigint AS gas_used,
+SUM(gas_used * effective_gas_price) AS gas_fee, SUM(l1_fee) AS l1_fee
+FROM evm.tx_costs
+WHERE created_at >= $1 AND ($2::integer IS NULL OR job_id = $2)
+GROUP BY job_id, evm_chain_id, from_address
+ORDER BY SUM(gas_used * effective_gas_price + l1_fee) DESC, job_id, evm_chain_id, from_address`
+
+func (o *txCostORM) TxCostsSince(ctx context.Context, since time.Time) (costs []TxCost, err error) {
+	err = o.ds.SelectContext(ctx, &costs, selectTxCostsSQL, since, nil)
+	return
+}
+
+func (o *txCostORM) JobTxCostsSince(ctx context.Context, jobID int32, since time.Time) (costs []TxCost, err error) {
+	err = o.ds.SelectContext(ctx, &costs, selectTxCostsSQL, since, jobID)
+	return
+}
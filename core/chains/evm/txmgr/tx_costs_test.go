@@ -0,0 +1,97 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestTxCostORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	costORM := txmgr.NewTxCostORM(db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ctx := tests.Context(t)
+	broadcastAt := time.Unix(1616509100, 0)
+
+	etx0 := mustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, txStore, 0, 1, broadcastAt, fromAddress)
+	db.MustExec(`UPDATE evm.txes SET meta = '{"JobID": 7}' WHERE id = $1`, etx0.ID)
+	etx1 := mustInsertConfirmedMissingReceiptEthTxWithLegacyAttempt(t, txStore, 1, 1, broadcastAt, fromAddress)
+
+	receipts := []*evmtypes.Receipt{
+		{
+			TxHash:            etx0.TxAttempts[0].Hash,
+			BlockHash:         utils.NewHash(),
+			BlockNumber:       big.NewInt(42),
+			GasUsed:           21_000,
+			EffectiveGasPrice: big.NewInt(10),
+			L1Fee:             big.NewInt(5),
+		},
+		{
+			// no effectiveGasPrice, the gas price of the attempt is used
+			TxHash:      etx1.TxAttempts[0].Hash,
+			BlockHash:   utils.NewHash(),
+			BlockNumber: big.NewInt(42),
+			GasUsed:     50_000,
+		},
+	}
+	require.NoError(t, txStore.SaveFetchedReceipts(ctx, receipts, txmgrcommon.TxConfirmed, nil, ethClient.ConfiguredChainID()))
+	// saving the same receipts again does not count them twice
+	require.NoError(t, txStore.SaveFetchedReceipts(ctx, receipts, txmgrcommon.TxConfirmed, nil, ethClient.ConfiguredChainID()))
+
+	t.Run("groups costs by job, most expensive first", func(t *testing.T) {
+		costs, err := costORM.TxCostsSince(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, costs, 2)
+
+		require.NotNil(t, costs[0].JobID)
+		assert.Equal(t, int32(7), *costs[0].JobID)
+		assert.Equal(t, ethClient.ConfiguredChainID().String(), costs[0].EVMChainID.String())
+		assert.Equal(t, fromAddress, costs[0].FromAddress)
+		assert.Equal(t, int64(1), costs[0].TxCount)
+		assert.Equal(t, int64(21_000), costs[0].GasUsed)
+		assert.Equal(t, assets.NewWeiI(210_000), &costs[0].GasFee)
+		assert.Equal(t, assets.NewWeiI(5), &costs[0].L1Fee)
+		assert.Equal(t, assets.NewWeiI(210_005), costs[0].TotalFee())
+
+		assert.Nil(t, costs[1].JobID)
+		assert.Equal(t, int64(50_000), costs[1].GasUsed)
+		assert.Equal(t, assets.NewWeiI(50_000), &costs[1].GasFee)
+		assert.Equal(t, assets.NewWeiI(0), &costs[1].L1Fee)
+	})
+
+	t.Run("filters by job", func(t *testing.T) {
+		costs, err := costORM.JobTxCostsSince(ctx, 7, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, costs, 1)
+		assert.Equal(t, int64(21_000), costs[0].GasUsed)
+
+		costs, err = costORM.JobTxCostsSince(ctx, 8, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, costs)
+	})
+
+	t.Run("filters by time", func(t *testing.T) {
+		costs, err := costORM.TxCostsSince(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, costs)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -86,7 +87,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 }
 
 func TestTxm_SendNativeToken_DoesNotSendToZero(t *testing.T) {
@@ -482,6 +484,46 @@ func TestTxm_CreateTransaction_OutOfEth(t *testing.T) {
 	})
 }
 
+type safeModeChecker struct {
+	err error
+}
+
+func (c *safeModeChecker) CheckSafeMode() error { return c.err }
+
+func TestTxm_CreateTransaction_SafeMode(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	kst := cltest.NewKeyStore(t, db)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth())
+
+	config, dbConfig, evmConfig := txmgr.MakeTestConfigs(t)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	estimator, err := gas.NewEstimator(logger.Test(t), ethClient, config.ChainType(), evmConfig.GasEstimator())
+	require.NoError(t, err)
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, evmConfig, evmConfig.GasEstimator(), evmConfig.Transactions(), dbConfig, dbConfig.Listener(), kst.Eth())
+	require.NoError(t, err)
+
+	checker := &safeModeChecker{err: errors.New("chain is in safe mode")}
+	txm.(*txmgr.Txm).SetSafeModeChecker(checker)
+	request := txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		FeeLimit:       21000,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}
+
+	_, err = txm.CreateTransaction(tests.Context(t), request)
+	require.ErrorIs(t, err, checker.err)
+	cltest.AssertCount(t, db, "evm.txes", 0)
+
+	checker.err = nil
+	_, err = txm.CreateTransaction(tests.Context(t), request)
+	require.NoError(t, err)
+	cltest.AssertCount(t, db, "evm.txes", 1)
+}
+
 func TestTxm_Lifecycle(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
@@ -44,6 +44,8 @@ type Chain interface {
 	HeadBroadcaster() httypes.HeadBroadcaster
 	TxManager() txmgr.TxManager
 	HeadTracker() httypes.HeadTracker
+	ReorgBus() httypes.ReorgBus
+	SafeMode() httypes.SafeMode
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
@@ -110,6 +112,8 @@ type chain struct {
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
+	reorgBus        httypes.ReorgBus
+	safeMode        httypes.SafeMode
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
@@ -205,6 +209,10 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	}
 
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	reorgORM := headtracker.NewReorgORM(*chainID, opts.DS)
+	reorgBus := headtracker.NewReorgBus(l, reorgORM)
+	safeMode := headtracker.NewSafeMode(l, reorgORM)
+	reorgBus.Subscribe(safeMode)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !opts.AppConfig.EVMRPCEnabled() {
@@ -217,7 +225,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 			orm = headtracker.NewNullORM()
 		}
 		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
-		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, reorgBus, opts.MailMon)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
@@ -235,6 +243,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 				RpcBatchSize:             int64(cfg.EVM().RPCDefaultBatchSize()),
 				KeepFinalizedBlocksDepth: int64(cfg.EVM().LogKeepBlocksDepth()),
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
+				LogCompactAfter:          cfg.EVM().LogCompactAfter(),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
 				ClientErrors:             cfg.EVM().NodePool().Errors(),
 			}
@@ -243,7 +252,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	}
 
 	// note: gas estimator is started as a part of the txm
-	txm, gasEstimator, err := newEvmTxm(opts.DS, cfg.EVM(), opts.AppConfig.EVMRPCEnabled(), opts.AppConfig.Database(), opts.AppConfig.Database().Listener(), client, l, logPoller, opts, headTracker)
+	txm, gasEstimator, err := newEvmTxm(opts.DS, cfg.EVM(), opts.AppConfig.EVMRPCEnabled(), opts.AppConfig.Database(), opts.AppConfig.Database().Listener(), client, l, logPoller, opts, headTracker, safeMode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate EvmTxm for chain with ID %s: %w", chainID.String(), err)
 	}
@@ -258,7 +267,11 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		var funder monitor.Funder
+		if fundingCfg := cfg.EVM().BalanceMonitor().Funding(); fundingCfg.Enabled() {
+			funder = monitor.NewKeyFunder(fundingCfg, cfg.EVM().GasEstimator().LimitTransfer(), chainID, opts.KeyStore, txm, monitor.NewFundingORM(opts.DS), l)
+		}
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, funder, l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -289,6 +302,8 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		logger:          l,
 		headBroadcaster: headBroadcaster,
 		headTracker:     headTracker,
+		reorgBus:        reorgBus,
+		safeMode:        safeMode,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
@@ -312,7 +327,7 @@ func (c *chain) Start(ctx context.Context) error {
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
-		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
+		if err := ms.Start(ctx, c.safeMode, c.txm, c.headBroadcaster, c.reorgBus, c.headTracker, c.logBroadcaster); err != nil {
 			return err
 		}
 		if c.balanceMonitor != nil {
@@ -337,10 +352,14 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
 		merr = multierr.Combine(merr, c.headTracker.Close())
+		c.logger.Debug("Chain: stopping reorgBus")
+		merr = multierr.Combine(merr, c.reorgBus.Close())
 		c.logger.Debug("Chain: stopping headBroadcaster")
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
 		c.logger.Debug("Chain: stopping evmTxm")
 		merr = multierr.Combine(merr, c.txm.Close())
+		c.logger.Debug("Chain: stopping safeMode")
+		merr = multierr.Combine(merr, c.safeMode.Close())
 		c.logger.Debug("Chain: stopping client")
 		c.client.Close()
 		c.logger.Debug("Chain: stopped")
@@ -354,6 +373,8 @@ func (c *chain) Ready() (merr error) {
 		c.txm.Ready(),
 		c.headBroadcaster.Ready(),
 		c.headTracker.Ready(),
+		c.reorgBus.Ready(),
+		c.safeMode.Ready(),
 		c.logBroadcaster.Ready(),
 	)
 	if c.balanceMonitor != nil {
@@ -371,6 +392,8 @@ func (c *chain) HealthReport() map[string]error {
 	services.CopyHealth(report, c.txm.HealthReport())
 	services.CopyHealth(report, c.headBroadcaster.HealthReport())
 	services.CopyHealth(report, c.headTracker.HealthReport())
+	services.CopyHealth(report, c.reorgBus.HealthReport())
+	services.CopyHealth(report, c.safeMode.HealthReport())
 	services.CopyHealth(report, c.logBroadcaster.HealthReport())
 
 	if c.balanceMonitor != nil {
@@ -466,6 +489,8 @@ func (c *chain) LogPoller() logpoller.LogPoller           { return c.logPoller }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster { return c.headBroadcaster }
 func (c *chain) TxManager() txmgr.TxManager               { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
+func (c *chain) ReorgBus() httypes.ReorgBus               { return c.reorgBus }
+func (c *chain) SafeMode() httypes.SafeMode               { return c.safeMode }
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 func (c *chain) GasEstimator() gas.EvmFeeEstimator        { return c.gasEstimator }
@@ -24,6 +24,7 @@ func newEvmTxm(
 	logPoller logpoller.LogPoller,
 	opts ChainRelayOpts,
 	headTracker httypes.HeadTracker,
+	safeMode httypes.SafeMode,
 ) (txm txmgr.TxManager,
 	estimator gas.EvmFeeEstimator,
 	err error,
@@ -66,7 +67,8 @@ func newEvmTxm(
 			logPoller,
 			opts.KeyStore,
 			estimator,
-			headTracker)
+			headTracker,
+			safeMode)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
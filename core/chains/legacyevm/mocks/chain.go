@@ -880,6 +880,100 @@ func (_c *Chain_Ready_Call) RunAndReturn(run func() error) *Chain_Ready_Call {
 	return _c
 }
 
+// ReorgBus provides a mock function with given fields:
+func (_m *Chain) ReorgBus() headtrackertypes.ReorgBus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgBus")
+	}
+
+	var r0 headtrackertypes.ReorgBus
+	if rf, ok := ret.Get(0).(func() headtrackertypes.ReorgBus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(headtrackertypes.ReorgBus)
+		}
+	}
+
+	return r0
+}
+
+// Chain_ReorgBus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgBus'
+type Chain_ReorgBus_Call struct {
+	*mock.Call
+}
+
+// ReorgBus is a helper method to define mock.On call
+func (_e *Chain_Expecter) ReorgBus() *Chain_ReorgBus_Call {
+	return &Chain_ReorgBus_Call{Call: _e.mock.On("ReorgBus")}
+}
+
+func (_c *Chain_ReorgBus_Call) Run(run func()) *Chain_ReorgBus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_ReorgBus_Call) Return(_a0 headtrackertypes.ReorgBus) *Chain_ReorgBus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_ReorgBus_Call) RunAndReturn(run func() headtrackertypes.ReorgBus) *Chain_ReorgBus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SafeMode provides a mock function with given fields:
+func (_m *Chain) SafeMode() headtrackertypes.SafeMode {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SafeMode")
+	}
+
+	var r0 headtrackertypes.SafeMode
+	if rf, ok := ret.Get(0).(func() headtrackertypes.SafeMode); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(headtrackertypes.SafeMode)
+		}
+	}
+
+	return r0
+}
+
+// Chain_SafeMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SafeMode'
+type Chain_SafeMode_Call struct {
+	*mock.Call
+}
+
+// SafeMode is a helper method to define mock.On call
+func (_e *Chain_Expecter) SafeMode() *Chain_SafeMode_Call {
+	return &Chain_SafeMode_Call{Call: _e.mock.On("SafeMode")}
+}
+
+func (_c *Chain_SafeMode_Call) Run(run func()) *Chain_SafeMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_SafeMode_Call) Return(_a0 headtrackertypes.SafeMode) *Chain_SafeMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_SafeMode_Call) RunAndReturn(run func() headtrackertypes.SafeMode) *Chain_SafeMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
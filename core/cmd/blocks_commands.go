@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -48,6 +53,85 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "backfill",
+			Usage: "Commands for managing LogPoller backfill jobs",
+			Subcommands: cli.Commands{
+				{
+					Name:   "create",
+					Usage:  "Creates a job backfilling the logs of LogPoller filters in a range of finalized blocks",
+					Action: s.CreateBackfillJob,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "from-block",
+							Usage:    "First block to backfill",
+							Required: true,
+						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "Last block to backfill, the latest finalized block if not set",
+						},
+						cli.StringSliceFlag{
+							Name:  "filter",
+							Usage: "Name of a LogPoller filter to backfill, can be repeated. All filters are backfilled if not set",
+						},
+						cli.Int64Flag{
+							Name:  "evm-chain-id",
+							Usage: "Chain ID of the EVM-based blockchain",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "Lists the backfill jobs and their progress",
+					Action: s.ListBackfillJobs,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:  "evm-chain-id",
+							Usage: "Chain ID of the EVM-based blockchain",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Shows the progress of a backfill job",
+					Action: s.ShowBackfillJob,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "id",
+							Usage:    "ID of the backfill job",
+							Required: true,
+						},
+						cli.Int64Flag{
+							Name:  "evm-chain-id",
+							Usage: "Chain ID of the EVM-based blockchain",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:   "logs-stats",
+			Usage:  "Shows the number of logs stored for each LogPoller filter and their size in the database",
+			Action: s.LogsStats,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "evm-chain-id",
+					Usage: "Chain ID of the EVM-based blockchain",
+				},
+			},
+		},
+		{
+			Name:   "ack-safe-mode",
+			Usage:  "Acknowledges the finality violations of a chain in safe mode, and resumes sending transactions",
+			Action: s.AcknowledgeSafeMode,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "evm-chain-id",
+					Usage: "Chain ID of the EVM-based blockchain",
+				},
+			},
+		},
 	}
 }
 
@@ -133,3 +217,220 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// BackfillJobPresenter implements TableRenderer for a LogPollerBackfillJobResource.
+type BackfillJobPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.LogPollerBackfillJobResource
+}
+
+var backfillJobHeaders = []string{"ID", "Chain ID", "Filters", "From Block", "To Block", "Progress", "Batch Size", "State", "Error", "Updated At"}
+
+// ToRow presents the LogPollerBackfillJobResource as a slice of strings.
+func (p *BackfillJobPresenter) ToRow() []string {
+	filters := "all"
+	if len(p.FilterNames) > 0 {
+		filters = strings.Join(p.FilterNames, ", ")
+	}
+	var progress float64
+	if p.BlocksTotal > 0 {
+		progress = 100 * float64(p.BlocksDone) / float64(p.BlocksTotal)
+	}
+	var errMsg string
+	if p.Error != nil {
+		errMsg = *p.Error
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		filters,
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		fmt.Sprintf("%d/%d (%.1f%%)", p.BlocksDone, p.BlocksTotal, progress),
+		strconv.FormatInt(p.BatchSize, 10),
+		string(p.State),
+		errMsg,
+		p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *BackfillJobPresenter) RenderTable(rt RendererTable) error {
+	renderList(backfillJobHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// BackfillJobPresenters implements TableRenderer for a slice of BackfillJobPresenter.
+type BackfillJobPresenters []BackfillJobPresenter
+
+// RenderTable implements TableRenderer
+func (ps BackfillJobPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(backfillJobHeaders, rows, rt.Writer)
+	return nil
+}
+
+// CreateBackfillJob creates a LogPoller job backfilling the logs of the given filters in a block range
+func (s *Shell) CreateBackfillJob(c *cli.Context) (err error) {
+	fromBlock := c.Int64("from-block")
+	if fromBlock <= 0 {
+		return s.errorOut(errors.New("Must pass a positive value in '--from-block' parameter"))
+	}
+	toBlock := c.Int64("to-block")
+	if toBlock < 0 || (toBlock > 0 && toBlock < fromBlock) {
+		return s.errorOut(errors.New("'--to-block' must not be lower than '--from-block'"))
+	}
+
+	request, err := json.Marshal(web.CreateBackfillJobRequest{
+		FilterNames: c.StringSlice("filter"),
+		FromBlock:   fromBlock,
+		ToBlock:     toBlock,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/backfill_jobs?"+evmChainIDQuery(c).Encode(), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BackfillJobPresenter{}, "Backfill job created")
+}
+
+// ListBackfillJobs lists the LogPoller backfill jobs and their progress
+func (s *Shell) ListBackfillJobs(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/backfill_jobs?"+evmChainIDQuery(c).Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BackfillJobPresenters{}, "Backfill jobs")
+}
+
+// ShowBackfillJob shows the progress of a LogPoller backfill job
+func (s *Shell) ShowBackfillJob(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/backfill_jobs/%d?%s", c.Int64("id"), evmChainIDQuery(c).Encode()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BackfillJobPresenter{}, "Backfill job")
+}
+
+// LogsStatsPresenter implements TableRenderer for a LogPollerLogsStatsResource.
+type LogsStatsPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.LogPollerLogsStatsResource
+}
+
+// ToRow presents the LogPollerLogsStatsResource as a slice of strings.
+func (p *LogsStatsPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		strconv.FormatInt(p.Logs, 10),
+		strconv.FormatInt(p.CompactedLogs, 10),
+		utils.FileSize(p.Bytes).String(),
+	}
+}
+
+// LogsStatsPresenters implements TableRenderer for a slice of LogsStatsPresenter.
+type LogsStatsPresenters []LogsStatsPresenter
+
+// RenderTable implements TableRenderer
+func (ps LogsStatsPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList([]string{"Filter", "Logs", "Compacted Logs", "Size"}, rows, rt.Writer)
+	return nil
+}
+
+// LogsStats shows the number of logs stored for each LogPoller filter and their size in the database. Logs matching
+// several filters are counted for each of them.
+func (s *Shell) LogsStats(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/logs_stats?"+evmChainIDQuery(c).Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogsStatsPresenters{}, "Logs stats")
+}
+
+// ReorgEventPresenter implements TableRenderer for a ReorgEventResource.
+type ReorgEventPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ReorgEventResource
+}
+
+// ToRow presents the ReorgEventResource as a slice of strings.
+func (p *ReorgEventPresenter) ToRow() []string {
+	return []string{
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.LatestFinalizedBlock, 10),
+		p.NewHeadHash,
+		p.DetectedAt.String(),
+	}
+}
+
+// ReorgEventPresenters implements TableRenderer for a slice of ReorgEventPresenter.
+type ReorgEventPresenters []ReorgEventPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReorgEventPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList([]string{"From Block", "To Block", "Latest Finalized Block", "New Head Hash", "Detected At"}, rows, rt.Writer)
+	return nil
+}
+
+// AcknowledgeSafeMode acknowledges the finality violations which put the chain in safe mode, and resumes sending
+// transactions on it.
+func (s *Shell) AcknowledgeSafeMode(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/safe_mode/acknowledge?"+evmChainIDQuery(c).Encode(), bytes.NewBufferString("{}"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ReorgEventPresenters{}, "Acknowledged finality violations")
+}
+
+func evmChainIDQuery(c *cli.Context) url.Values {
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+	return v
+}
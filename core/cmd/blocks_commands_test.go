@@ -5,10 +5,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
 
@@ -66,3 +68,88 @@ func Test_FindLCA(t *testing.T) {
 	c = cli.NewContext(nil, set, nil)
 	require.ErrorContains(t, client.FindLCA(c), "FindLCA is only available if LogPoller is enabled")
 }
+
+func Test_CreateBackfillJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateBackfillJob, set, "")
+
+	// Incorrect block range
+	require.NoError(t, set.Set("from-block", "0"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.CreateBackfillJob(c), "Must pass a positive value in")
+
+	require.NoError(t, set.Set("from-block", "10"))
+	require.NoError(t, set.Set("to-block", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.CreateBackfillJob(c), "must not be lower than '--from-block'")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("to-block", "20"))
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.CreateBackfillJob(c), "does not match any local chains")
+
+	// Correct chain ID
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.CreateBackfillJob(c), "CreateLogPollerBackfillJob is only available if LogPoller is enabled")
+}
+
+func Test_LogsStats(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.LogsStats, set, "")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.LogsStats(c), "does not match any local chains")
+
+	// Correct chain ID
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.LogsStats(c), "FindLogPollerLogsStats is only available if LogPoller is enabled")
+}
+
+func Test_AcknowledgeSafeMode(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.AcknowledgeSafeMode, set, "")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.AcknowledgeSafeMode(c), "does not match any local chains")
+
+	// Correct chain ID, no finality violations to acknowledge
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.AcknowledgeSafeMode(c))
+	require.Len(t, r.Renders, 1)
+	assert.Empty(t, *r.Renders[0].(*cmd.ReorgEventPresenters))
+}
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -50,14 +52,37 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyCircuitBreaker describes the state of the circuit breaker, with
+// the time of the next probe if it is open
+func (p *BridgePresenter) FriendlyCircuitBreaker() string {
+	cb := p.CircuitBreaker
+	if cb == nil {
+		return ""
+	}
+	if cb.NextProbeAt == nil {
+		return string(cb.State)
+	}
+	return fmt.Sprintf("%s (%d consecutive failures, next probe at %s)", cb.State, cb.ConsecutiveFailures, cb.NextProbeAt.Format(time.RFC3339))
+}
+
+// FriendlyHealthScore converts the health score to a string
+func (p *BridgePresenter) FriendlyHealthScore() string {
+	if p.CircuitBreaker == nil {
+		return ""
+	}
+	return strconv.FormatFloat(p.CircuitBreaker.HealthScore, 'f', 2, 64)
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Circuit Breaker", "Health Score"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyCircuitBreaker(),
+		p.FriendlyHealthScore(),
 	})
 	render("Bridge", table)
 	return nil
@@ -37,6 +37,13 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
 			CreatedAt:     createdAt,
+			CircuitBreaker: presenters.NewBridgeCircuitBreakerResource(bridges.CircuitBreakerStatus{
+				State:               bridges.CircuitOpen,
+				ConsecutiveFailures: 5,
+				HealthScore:         0.25,
+				OpenedAt:            createdAt,
+				NextProbeAt:         createdAt.Add(time.Minute),
+			}),
 		},
 	}
 
@@ -48,6 +55,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "open (5 consecutive failures")
+	assert.Contains(t, output, "0.25")
 
 	// Render many resources
 	buffer.Reset()
@@ -62,6 +62,30 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel an unstarted or unconfirmed Ethereum Transaction, given its <id> or the <hash> of one of its attempts",
+				Action: s.CancelTransaction,
+			},
+			{
+				Name:   "bump",
+				Usage:  "Rebroadcast the unconfirmed Ethereum Transaction <id> at a higher fee",
+				Action: s.BumpTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "gas-price",
+						Usage: "gas price of a legacy transaction, e.g. '20 gwei'",
+					},
+					cli.StringFlag{
+						Name:  "gas-fee-cap",
+						Usage: "gas fee cap of an EIP-1559 transaction, e.g. '40 gwei'",
+					},
+					cli.StringFlag{
+						Name:  "gas-tip-cap",
+						Usage: "gas tip cap of an EIP-1559 transaction, e.g. '2 gwei'",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +155,68 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the transaction with the given ID or attempt hash
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID or the hash of the transaction"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// BumpTransaction rebroadcasts the transaction with the given ID at a higher fee
+func (s *Shell) BumpTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+
+	var request models.BumpEthTxRequest
+	for flag, fee := range map[string]**assets.Wei{
+		"gas-price":   &request.GasPrice,
+		"gas-fee-cap": &request.GasFeeCap,
+		"gas-tip-cap": &request.GasTipCap,
+	} {
+		if !c.IsSet(flag) {
+			continue
+		}
+		*fee = new(assets.Wei)
+		if err = (*fee).UnmarshalText([]byte(c.String(flag))); err != nil {
+			return s.errorOut(fmt.Errorf("while parsing %s: %w", flag, err))
+		}
+	}
+	if request.GasPrice == nil && (request.GasFeeCap == nil || request.GasTipCap == nil) {
+		return s.errorOut(errors.New("must set either --gas-price, or both --gas-fee-cap and --gas-tip-cap"))
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+c.Args().First()+"/bump", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
@@ -88,6 +89,69 @@ func TestShell_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestShell_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	db := app.GetDB()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, db)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, from)
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	flagSetApplyFromAction(client.CancelTransaction, set, "")
+
+	require.NoError(t, set.Parse([]string{strconv.FormatInt(tx.ID, 10)}))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, &tx.FromAddress, renderedTx.From)
+	assert.Equal(t, "unconfirmed", renderedTx.State)
+
+	dbTx, err := txStore.FindTxWithAttempts(testutils.Context(t), tx.ID)
+	require.NoError(t, err)
+	assert.True(t, dbTx.TxAttempts[0].IsPurgeAttempt)
+}
+
+func TestShell_BumpTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	db := app.GetDB()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, db)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, from)
+
+	set := flag.NewFlagSet("test bump tx", 0)
+	flagSetApplyFromAction(client.BumpTransaction, set, "")
+
+	require.NoError(t, set.Set("gas-price", "50 gwei"))
+	require.NoError(t, set.Parse([]string{strconv.FormatInt(tx.ID, 10)}))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.BumpTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, assets.GWei(50).ToInt().String(), renderedTx.GasPrice)
+
+	t.Run("requires a fee", func(t *testing.T) {
+		set := flag.NewFlagSet("test bump tx", 0)
+		flagSetApplyFromAction(client.BumpTransaction, set, "")
+		require.NoError(t, set.Parse([]string{strconv.FormatInt(tx.ID, 10)}))
+
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.BumpTransaction(c), "must set either --gas-price")
+	})
+}
+
 func TestShell_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
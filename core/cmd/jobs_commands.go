@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +52,33 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job spec's pipeline, without side effects",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures",
+					Usage: "path to a JSON file with vars and the results to use for side-effecting tasks (http, bridge, ethcall, ethtx)",
+				},
+			},
+		},
+		{
+			Name:   "costs",
+			Usage:  "Show the gas spent by the transactions of jobs, per chain and key",
+			Action: s.ShowJobCosts,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "period to report, e.g. 36h or 7d",
+					Value: "7d",
+				},
+				cli.IntFlag{
+					Name:  "job-id",
+					Usage: "only report the job with this ID",
+				},
+			},
+		},
 	}
 }
 
@@ -313,3 +342,137 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter wraps the JSONAPI Pipeline Run Resource of a
+// simulated run and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		var output, errStr string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errStr = *tr.Error
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), output, errStr})
+	}
+
+	render("Simulated Task Runs", table)
+	return nil
+}
+
+// SimulateJob simulates a run of the pipeline of a job spec, using the
+// fixtures in place of any side-effecting tasks. Nothing is persisted.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var fixtures pipeline.Fixtures
+	if path := c.String("fixtures"); path != "" {
+		buf, ferr := fromFile(path)
+		if ferr != nil {
+			return s.errorOut(errors.Wrapf(ferr, "error reading fixtures from file '%s'", path))
+		}
+		fixtures, err = pipeline.ParseFixtures(buf.Bytes())
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	request, err := json.Marshal(web.SimulatePipelineRunRequest{
+		TOML:     tomlString,
+		Fixtures: fixtures,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/simulations", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{}, "Pipeline run simulated")
+}
+
+// JobCostPresenter wraps the JSONAPI JobCost Resource and adds rendering functionality
+type JobCostPresenter struct {
+	JAID
+	presenters.JobCostResource
+}
+
+// ToRow presents the job cost as a row
+func (p JobCostPresenter) ToRow() []string {
+	jobID := "-"
+	if p.JobID != nil {
+		jobID = fmt.Sprint(*p.JobID)
+	}
+	return []string{
+		jobID,
+		p.EVMChainID.String(),
+		p.FromAddress.Hex(),
+		fmt.Sprint(p.TxCount),
+		fmt.Sprint(p.GasUsed),
+		p.GasFee,
+		p.L1Fee,
+		p.TotalFee,
+	}
+}
+
+var jobCostHeaders = []string{"Job ID", "Chain ID", "From", "Txs", "Gas Used", "Gas Fee (wei)", "L1 Fee (wei)", "Total Fee (wei)"}
+
+type JobCostPresenters []JobCostPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobCostPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobCostHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Job Costs", table)
+	return nil
+}
+
+// ShowJobCosts displays the gas spent by jobs in the given period
+func (s *Shell) ShowJobCosts(c *cli.Context) (err error) {
+	if _, err = web.ParseCostsWindow(c.String("since")); err != nil {
+		return s.errorOut(err)
+	}
+	query := url.Values{}
+	query.Set("since", c.String("since"))
+	if c.IsSet("job-id") {
+		query.Set("jobID", strconv.Itoa(c.Int("job-id")))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/job_costs?"+query.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobCostPresenters{})
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type Alerting interface {
+	Enabled() bool
+	CheckInterval() time.Duration
+	Cooldown() time.Duration
+	JobErrorRateThreshold() float64
+	JobErrorRateWindow() time.Duration
+	JobErrorRateMinRuns() uint32
+	Webhooks() []AlertingWebhook
+}
+
+type AlertingWebhook interface {
+	Name() string
+	URL() *url.URL
+	Format() string
+	RoutingKey() string
+}
@@ -97,6 +97,15 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
 
+		// BalanceMonitor.AlertThreshold has no default, alerts are opt-in per chain
+		docDefaults.BalanceMonitor.AlertThreshold = nil
+
+		// BalanceMonitor.Funding configs are only set if the feature is enabled
+		docDefaults.BalanceMonitor.Funding.TreasuryAddress = nil
+		docDefaults.BalanceMonitor.Funding.MinBalance = nil
+		docDefaults.BalanceMonitor.Funding.TargetBalance = nil
+		docDefaults.BalanceMonitor.Funding.DailySpendCap = nil
+
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = evmcfg.DAOracle{}
 
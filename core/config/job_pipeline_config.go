@@ -16,4 +16,22 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	Retention() JobPipelineRetention
+}
+
+type JobPipelineRetention interface {
+	Enabled() bool
+	Interval() time.Duration
+	ArchiveDir() string
+	MaxAge() time.Duration
+	MaxRuns() uint32
+	ErroredMaxAge() time.Duration
+	JobTypes() []JobPipelineRetentionJobType
+}
+
+type JobPipelineRetentionJobType interface {
+	Type() string
+	MaxAge() time.Duration
+	MaxRuns() uint32
+	ErroredMaxAge() time.Duration
 }
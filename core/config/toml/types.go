@@ -44,6 +44,7 @@ type Core struct {
 	Database         Database         `toml:",omitempty"`
 	TelemetryIngress TelemetryIngress `toml:",omitempty"`
 	AuditLogger      AuditLogger      `toml:",omitempty"`
+	Alerting         Alerting         `toml:",omitempty"`
 	Log              Log              `toml:",omitempty"`
 	WebServer        WebServer        `toml:",omitempty"`
 	JobPipeline      JobPipeline      `toml:",omitempty"`
@@ -78,6 +79,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Database.setFrom(&f.Database)
 	c.TelemetryIngress.setFrom(&f.TelemetryIngress)
 	c.AuditLogger.SetFrom(&f.AuditLogger)
+	c.Alerting.setFrom(&f.Alerting)
 	c.Log.setFrom(&f.Log)
 
 	c.WebServer.setFrom(&f.WebServer)
@@ -126,6 +128,7 @@ type Secrets struct {
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+	Alerting   AlertingSecrets          `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -521,6 +524,93 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	}
 }
 
+type Alerting struct {
+	Enabled               *bool
+	CheckInterval         *commonconfig.Duration
+	Cooldown              *commonconfig.Duration
+	JobErrorRateThreshold *float64
+	JobErrorRateWindow    *commonconfig.Duration
+	JobErrorRateMinRuns   *uint32
+
+	Webhooks []AlertingWebhook `toml:",omitempty"`
+}
+
+// AlertingWebhook is an HTTP endpoint alerts are posted to, in one of the
+// supported payload formats. Its URL and routing key are credentials, and are
+// read from the AlertingSecrets entry of the same Name.
+type AlertingWebhook struct {
+	Name   *string
+	Format *string
+}
+
+// AlertingWebhook formats
+const (
+	AlertingWebhookFormatJSON      = "json"
+	AlertingWebhookFormatSlack     = "slack"
+	AlertingWebhookFormatPagerDuty = "pagerduty"
+)
+
+func (a *Alerting) setFrom(f *Alerting) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.CheckInterval; v != nil {
+		a.CheckInterval = v
+	}
+	if v := f.Cooldown; v != nil {
+		a.Cooldown = v
+	}
+	if v := f.JobErrorRateThreshold; v != nil {
+		a.JobErrorRateThreshold = v
+	}
+	if v := f.JobErrorRateWindow; v != nil {
+		a.JobErrorRateWindow = v
+	}
+	if v := f.JobErrorRateMinRuns; v != nil {
+		a.JobErrorRateMinRuns = v
+	}
+	if v := f.Webhooks; v != nil {
+		a.Webhooks = v
+	}
+}
+
+func (a *Alerting) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+
+	if a.CheckInterval != nil && a.CheckInterval.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "CheckInterval", Value: a.CheckInterval.String(), Msg: "must be greater than 0"})
+	}
+	if a.JobErrorRateThreshold != nil && (*a.JobErrorRateThreshold <= 0 || *a.JobErrorRateThreshold > 1) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "JobErrorRateThreshold", Value: *a.JobErrorRateThreshold, Msg: "must be greater than 0 and at most 1"})
+	}
+	if len(a.Webhooks) == 0 {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Webhooks", Msg: "at least one webhook must be configured when alerting is enabled"})
+	}
+	names := make(map[string]struct{}, len(a.Webhooks))
+	for i, w := range a.Webhooks {
+		if w.Name == nil || *w.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Webhooks.%d.Name", i), Msg: "must be set"})
+		} else if _, exists := names[*w.Name]; exists {
+			err = multierr.Append(err, configutils.NewErrDuplicate(fmt.Sprintf("Webhooks.%d.Name", i), *w.Name))
+		} else {
+			names[*w.Name] = struct{}{}
+		}
+		format := AlertingWebhookFormatJSON
+		if w.Format != nil {
+			format = *w.Format
+		}
+		switch format {
+		case AlertingWebhookFormatJSON, AlertingWebhookFormatSlack, AlertingWebhookFormatPagerDuty:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Webhooks.%d.Format", i), Value: format,
+				Msg: fmt.Sprintf("must be one of %s, %s or %s", AlertingWebhookFormatJSON, AlertingWebhookFormatSlack, AlertingWebhookFormatPagerDuty)})
+		}
+	}
+	return
+}
+
 // LogLevel replaces dpanic with crit/CRIT
 type LogLevel zapcore.Level
 
@@ -597,18 +687,21 @@ func (l *LogFile) setFrom(f *LogFile) {
 }
 
 type WebServer struct {
-	AuthenticationMethod    *string
-	AllowOrigins            *string
-	BridgeResponseURL       *commonconfig.URL
-	BridgeCacheTTL          *commonconfig.Duration
-	HTTPWriteTimeout        *commonconfig.Duration
-	HTTPPort                *uint16
-	SecureCookies           *bool
-	SessionTimeout          *commonconfig.Duration
-	SessionReaperExpiration *commonconfig.Duration
-	HTTPMaxSize             *utils.FileSize
-	StartTimeout            *commonconfig.Duration
-	ListenIP                *net.IP
+	AuthenticationMethod *string
+	AllowOrigins         *string
+	BridgeResponseURL    *commonconfig.URL
+	BridgeCacheTTL       *commonconfig.Duration
+	// BridgeCircuitBreakerThreshold and BridgeCircuitBreakerProbeInterval configure the circuit breaker of each bridge
+	BridgeCircuitBreakerThreshold     *uint32
+	BridgeCircuitBreakerProbeInterval *commonconfig.Duration
+	HTTPWriteTimeout                  *commonconfig.Duration
+	HTTPPort                          *uint16
+	SecureCookies                     *bool
+	SessionTimeout                    *commonconfig.Duration
+	SessionReaperExpiration           *commonconfig.Duration
+	HTTPMaxSize                       *utils.FileSize
+	StartTimeout                      *commonconfig.Duration
+	ListenIP                          *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
@@ -629,6 +722,12 @@ func (w *WebServer) setFrom(f *WebServer) {
 	if v := f.BridgeCacheTTL; v != nil {
 		w.BridgeCacheTTL = v
 	}
+	if v := f.BridgeCircuitBreakerThreshold; v != nil {
+		w.BridgeCircuitBreakerThreshold = v
+	}
+	if v := f.BridgeCircuitBreakerProbeInterval; v != nil {
+		w.BridgeCircuitBreakerProbeInterval = v
+	}
 	if v := f.HTTPWriteTimeout; v != nil {
 		w.HTTPWriteTimeout = v
 	}
@@ -661,6 +760,10 @@ func (w *WebServer) setFrom(f *WebServer) {
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	if w.BridgeCircuitBreakerThreshold != nil && *w.BridgeCircuitBreakerThreshold == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "BridgeCircuitBreakerThreshold", Value: 0, Msg: "must be greater than 0"})
+	}
+
 	// Validate LDAP fields when authentication method is LDAPAuth
 	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
 		return
@@ -874,6 +977,7 @@ type JobPipeline struct {
 	VerboseLogging            *bool
 
 	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	Retention   JobPipelineRetention   `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -899,6 +1003,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.Retention.setFrom(&f.Retention)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -915,6 +1020,96 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineRetention struct {
+	Enabled       *bool
+	Interval      *commonconfig.Duration
+	MaxAge        *commonconfig.Duration
+	MaxRuns       *uint32
+	ErroredMaxAge *commonconfig.Duration
+	ArchiveDir    *string
+
+	JobTypes []JobPipelineRetentionJobType `toml:",omitempty"`
+}
+
+// JobPipelineRetentionJobType overrides the retention policy for the jobs of
+// one type. Unset fields are inherited from JobPipelineRetention.
+type JobPipelineRetentionJobType struct {
+	Type          *string
+	MaxAge        *commonconfig.Duration
+	MaxRuns       *uint32
+	ErroredMaxAge *commonconfig.Duration
+}
+
+func (r *JobPipelineRetention) setFrom(f *JobPipelineRetention) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.Interval; v != nil {
+		r.Interval = v
+	}
+	if v := f.MaxAge; v != nil {
+		r.MaxAge = v
+	}
+	if v := f.MaxRuns; v != nil {
+		r.MaxRuns = v
+	}
+	if v := f.ErroredMaxAge; v != nil {
+		r.ErroredMaxAge = v
+	}
+	if v := f.ArchiveDir; v != nil {
+		r.ArchiveDir = v
+	}
+	if v := f.JobTypes; v != nil {
+		r.JobTypes = v
+	}
+}
+
+func (r *JobPipelineRetention) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+
+	if r.Interval != nil && r.Interval.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Interval", Value: r.Interval.String(), Msg: "must be greater than 0"})
+	}
+	if r.MaxAge != nil && r.ErroredMaxAge != nil {
+		err = multierr.Append(err, validateErroredMaxAge("ErroredMaxAge", *r.MaxAge, *r.ErroredMaxAge))
+	}
+
+	types := make(map[string]struct{})
+	for i, jt := range r.JobTypes {
+		if jt.Type == nil || *jt.Type == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("JobTypes.%d.Type", i), Msg: "must be set"})
+			continue
+		}
+		if _, exists := types[*jt.Type]; exists {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("JobTypes.%d.Type", i), Value: *jt.Type, Msg: "duplicate job type"})
+		}
+		types[*jt.Type] = struct{}{}
+
+		maxAge, erroredMaxAge := r.MaxAge, r.ErroredMaxAge
+		if jt.MaxAge != nil {
+			maxAge = jt.MaxAge
+		}
+		if jt.ErroredMaxAge != nil {
+			erroredMaxAge = jt.ErroredMaxAge
+		}
+		if maxAge != nil && erroredMaxAge != nil {
+			err = multierr.Append(err, validateErroredMaxAge(fmt.Sprintf("JobTypes.%d.ErroredMaxAge", i), *maxAge, *erroredMaxAge))
+		}
+	}
+	return
+}
+
+// validateErroredMaxAge checks that errored runs are kept at least as long as the others.
+func validateErroredMaxAge(name string, maxAge, erroredMaxAge commonconfig.Duration) error {
+	// 0 disables the age limit
+	if erroredMaxAge.Duration() == 0 || (maxAge.Duration() != 0 && erroredMaxAge.Duration() >= maxAge.Duration()) {
+		return nil
+	}
+	return configutils.ErrInvalid{Name: name, Value: erroredMaxAge.String(), Msg: fmt.Sprintf("must be 0 or at least MaxAge (%s), errored runs are kept longer than the others", maxAge)}
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -1428,6 +1623,54 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 	return err
 }
 
+// AlertingWebhookSecrets holds the credentials of the alerting webhook of the same name.
+type AlertingWebhookSecrets struct {
+	// URL is where alerts are posted to. Slack webhook URLs embed their token.
+	URL *models.SecretURL
+	// RoutingKey is the PagerDuty integration key.
+	RoutingKey *models.Secret
+}
+
+type AlertingSecrets struct {
+	Webhooks map[string]AlertingWebhookSecrets
+}
+
+func (a *AlertingSecrets) SetFrom(f *AlertingSecrets) (err error) {
+	if a.Webhooks != nil && f.Webhooks != nil {
+		for k := range f.Webhooks {
+			if _, exists := a.Webhooks[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("Webhooks[\"%s\"]", k)})
+			}
+		}
+		if err != nil {
+			return err
+		}
+		for k, v := range f.Webhooks {
+			a.Webhooks[k] = v
+		}
+	} else if v := f.Webhooks; v != nil {
+		a.Webhooks = v
+	}
+	return nil
+}
+
+func (a *AlertingSecrets) ValidateConfig() (err error) {
+	for name, w := range a.Webhooks {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "Webhooks", Msg: "name must be provided and non-empty"})
+		}
+		if w.URL == nil || w.URL.URL() == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Webhooks.%s.URL", name), Msg: "must be set"})
+		} else if s := w.URL.URL().Scheme; s != "http" && s != "https" {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Webhooks.%s.URL", name), Value: w.URL.String(), Msg: "must be http or https"})
+		}
+		if w.RoutingKey != nil && *w.RoutingKey == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: fmt.Sprintf("Webhooks.%s.RoutingKey", name), Msg: "must be non-empty when set"})
+		}
+	}
+	return err
+}
+
 type ExternalRegistry struct {
 	Address   *string
 	NetworkID *string
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -577,5 +578,118 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestJobPipelineRetention_ValidateConfig(t *testing.T) {
+	d := commonconfig.MustNewDuration
+	tests := []struct {
+		name      string
+		retention JobPipelineRetention
+		errMsg    string
+	}{
+		{
+			name:      "disabled",
+			retention: JobPipelineRetention{Enabled: ptr(false), Interval: d(0)},
+		},
+		{
+			name: "valid",
+			retention: JobPipelineRetention{Enabled: ptr(true), Interval: d(time.Hour), MaxAge: d(time.Hour), ErroredMaxAge: d(2 * time.Hour),
+				JobTypes: []JobPipelineRetentionJobType{{Type: ptr("webhook"), MaxAge: d(2 * time.Hour)}, {Type: ptr("cron"), ErroredMaxAge: d(0)}}},
+		},
+		{
+			name:      "zero interval",
+			retention: JobPipelineRetention{Enabled: ptr(true), Interval: d(0)},
+			errMsg:    "Interval: invalid value (0s): must be greater than 0",
+		},
+		{
+			name:      "errored runs kept less",
+			retention: JobPipelineRetention{Enabled: ptr(true), MaxAge: d(time.Hour), ErroredMaxAge: d(time.Minute)},
+			errMsg:    "ErroredMaxAge: invalid value (1m0s): must be 0 or at least MaxAge (1h0m0s), errored runs are kept longer than the others",
+		},
+		{
+			name: "job types",
+			retention: JobPipelineRetention{Enabled: ptr(true), MaxAge: d(time.Hour), ErroredMaxAge: d(2 * time.Hour),
+				JobTypes: []JobPipelineRetentionJobType{{Type: ptr("webhook")}, {}, {Type: ptr("webhook"), MaxAge: d(3 * time.Hour)}}},
+			errMsg: "JobTypes.1.Type: missing: must be set; JobTypes.2.Type: invalid value (webhook): duplicate job type; JobTypes.2.ErroredMaxAge: invalid value (2h0m0s): must be 0 or at least MaxAge (3h0m0s), errored runs are kept longer than the others",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.retention.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestWebServer_ValidateConfig_BridgeCircuitBreakerThreshold(t *testing.T) {
+	w := WebServer{AuthenticationMethod: ptr("local"), BridgeCircuitBreakerThreshold: ptr[uint32](0)}
+	assert.EqualError(t, w.ValidateConfig(), "BridgeCircuitBreakerThreshold: invalid value (0): must be greater than 0")
+
+	w.BridgeCircuitBreakerThreshold = ptr[uint32](1)
+	assert.NoError(t, w.ValidateConfig())
+}
+
+func TestAlerting_ValidateConfig(t *testing.T) {
+	d := commonconfig.MustNewDuration
+	tests := []struct {
+		name     string
+		alerting Alerting
+		errMsg   string
+	}{
+		{
+			name:     "disabled",
+			alerting: Alerting{Enabled: ptr(false)},
+		},
+		{
+			name: "valid",
+			alerting: Alerting{Enabled: ptr(true), CheckInterval: d(time.Minute), JobErrorRateThreshold: ptr(0.5),
+				Webhooks: []AlertingWebhook{{Name: ptr("hook")}, {Name: ptr("slack"), Format: ptr("slack")},
+					{Name: ptr("pagerduty"), Format: ptr("pagerduty")}}},
+		},
+		{
+			name:     "no webhooks",
+			alerting: Alerting{Enabled: ptr(true), CheckInterval: d(0), JobErrorRateThreshold: ptr(1.5)},
+			errMsg:   "CheckInterval: invalid value (0s): must be greater than 0; JobErrorRateThreshold: invalid value (1.5): must be greater than 0 and at most 1; Webhooks: missing: at least one webhook must be configured when alerting is enabled",
+		},
+		{
+			name: "invalid webhooks",
+			alerting: Alerting{Enabled: ptr(true),
+				Webhooks: []AlertingWebhook{{}, {Name: ptr("ops"), Format: ptr("xml")}, {Name: ptr("ops"), Format: ptr("pagerduty")}}},
+			errMsg: "Webhooks.0.Name: missing: must be set; Webhooks.1.Format: invalid value (xml): must be one of json, slack or pagerduty; Webhooks.2.Name: invalid value (ops): duplicate - must be unique",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.alerting.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestAlertingSecrets_ValidateConfig(t *testing.T) {
+	s := AlertingSecrets{Webhooks: map[string]AlertingWebhookSecrets{
+		"slack":     {URL: models.MustSecretURL("https://hooks.slack.com/services/token")},
+		"pagerduty": {URL: models.MustSecretURL("https://events.pagerduty.com/v2/enqueue"), RoutingKey: models.NewSecret("key")},
+	}}
+	assert.NoError(t, s.ValidateConfig())
+
+	s = AlertingSecrets{Webhooks: map[string]AlertingWebhookSecrets{
+		"missing": {},
+		"ftp":     {URL: models.MustSecretURL("ftp://example.com/token")},
+	}}
+	err := s.ValidateConfig()
+	assert.ErrorContains(t, err, "Webhooks.missing.URL: missing: must be set")
+	assert.ErrorContains(t, err, "Webhooks.ftp.URL: invalid value (xxxxx): must be http or https")
+	assert.NotContains(t, err.Error(), "token")
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
@@ -59,6 +59,8 @@ type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
 	BridgeCacheTTL() time.Duration
+	BridgeCircuitBreakerThreshold() uint32
+	BridgeCircuitBreakerProbeInterval() time.Duration
 	BridgeResponseURL() *url.URL
 	HTTPMaxSize() int64
 	StartTimeout() time.Duration
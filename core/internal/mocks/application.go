@@ -15,6 +15,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	headtrackertypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -61,6 +63,65 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// AcknowledgeSafeMode provides a mock function with given fields: ctx, chainID
+func (_m *Application) AcknowledgeSafeMode(ctx context.Context, chainID *big.Int) ([]headtrackertypes.ReorgEvent, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeSafeMode")
+	}
+
+	var r0 []headtrackertypes.ReorgEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]headtrackertypes.ReorgEvent, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []headtrackertypes.ReorgEvent); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]headtrackertypes.ReorgEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_AcknowledgeSafeMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeSafeMode'
+type Application_AcknowledgeSafeMode_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeSafeMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) AcknowledgeSafeMode(ctx interface{}, chainID interface{}) *Application_AcknowledgeSafeMode_Call {
+	return &Application_AcknowledgeSafeMode_Call{Call: _e.mock.On("AcknowledgeSafeMode", ctx, chainID)}
+}
+
+func (_c *Application_AcknowledgeSafeMode_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_AcknowledgeSafeMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_AcknowledgeSafeMode_Call) Return(_a0 []headtrackertypes.ReorgEvent, _a1 error) *Application_AcknowledgeSafeMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_AcknowledgeSafeMode_Call) RunAndReturn(run func(context.Context, *big.Int) ([]headtrackertypes.ReorgEvent, error)) *Application_AcknowledgeSafeMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -202,6 +263,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeCircuitBreakers provides a mock function with given fields:
+func (_m *Application) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeCircuitBreakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakers'
+type Application_BridgeCircuitBreakers_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakers is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeCircuitBreakers() *Application_BridgeCircuitBreakers_Call {
+	return &Application_BridgeCircuitBreakers_Call{Call: _e.mock.On("BridgeCircuitBreakers")}
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) Run(run func()) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) Return(_a0 *bridges.CircuitBreakers) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeCircuitBreakers_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Application_BridgeCircuitBreakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -249,6 +357,68 @@ func (_c *Application_BridgeORM_Call) RunAndReturn(run func() bridges.ORM) *Appl
 	return _c
 }
 
+// CreateLogPollerBackfillJob provides a mock function with given fields: ctx, chainID, filterNames, fromBlock, toBlock
+func (_m *Application) CreateLogPollerBackfillJob(ctx context.Context, chainID *big.Int, filterNames []string, fromBlock int64, toBlock int64) (*logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx, chainID, filterNames, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLogPollerBackfillJob")
+	}
+
+	var r0 *logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, []string, int64, int64) (*logpoller.BackfillJob, error)); ok {
+		return rf(ctx, chainID, filterNames, fromBlock, toBlock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, []string, int64, int64) *logpoller.BackfillJob); ok {
+		r0 = rf(ctx, chainID, filterNames, fromBlock, toBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, []string, int64, int64) error); ok {
+		r1 = rf(ctx, chainID, filterNames, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_CreateLogPollerBackfillJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLogPollerBackfillJob'
+type Application_CreateLogPollerBackfillJob_Call struct {
+	*mock.Call
+}
+
+// CreateLogPollerBackfillJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - filterNames []string
+//   - fromBlock int64
+//   - toBlock int64
+func (_e *Application_Expecter) CreateLogPollerBackfillJob(ctx interface{}, chainID interface{}, filterNames interface{}, fromBlock interface{}, toBlock interface{}) *Application_CreateLogPollerBackfillJob_Call {
+	return &Application_CreateLogPollerBackfillJob_Call{Call: _e.mock.On("CreateLogPollerBackfillJob", ctx, chainID, filterNames, fromBlock, toBlock)}
+}
+
+func (_c *Application_CreateLogPollerBackfillJob_Call) Run(run func(ctx context.Context, chainID *big.Int, filterNames []string, fromBlock int64, toBlock int64)) *Application_CreateLogPollerBackfillJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].([]string), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_CreateLogPollerBackfillJob_Call) Return(_a0 *logpoller.BackfillJob, _a1 error) *Application_CreateLogPollerBackfillJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_CreateLogPollerBackfillJob_Call) RunAndReturn(run func(context.Context, *big.Int, []string, int64, int64) (*logpoller.BackfillJob, error)) *Application_CreateLogPollerBackfillJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -450,6 +620,184 @@ func (_c *Application_FindLCA_Call) RunAndReturn(run func(context.Context, *big.
 	return _c
 }
 
+// FindLogPollerBackfillJob provides a mock function with given fields: ctx, chainID, id
+func (_m *Application) FindLogPollerBackfillJob(ctx context.Context, chainID *big.Int, id int64) (*logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx, chainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLogPollerBackfillJob")
+	}
+
+	var r0 *logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64) (*logpoller.BackfillJob, error)); ok {
+		return rf(ctx, chainID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, int64) *logpoller.BackfillJob); ok {
+		r0 = rf(ctx, chainID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, int64) error); ok {
+		r1 = rf(ctx, chainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_FindLogPollerBackfillJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLogPollerBackfillJob'
+type Application_FindLogPollerBackfillJob_Call struct {
+	*mock.Call
+}
+
+// FindLogPollerBackfillJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - id int64
+func (_e *Application_Expecter) FindLogPollerBackfillJob(ctx interface{}, chainID interface{}, id interface{}) *Application_FindLogPollerBackfillJob_Call {
+	return &Application_FindLogPollerBackfillJob_Call{Call: _e.mock.On("FindLogPollerBackfillJob", ctx, chainID, id)}
+}
+
+func (_c *Application_FindLogPollerBackfillJob_Call) Run(run func(ctx context.Context, chainID *big.Int, id int64)) *Application_FindLogPollerBackfillJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *Application_FindLogPollerBackfillJob_Call) Return(_a0 *logpoller.BackfillJob, _a1 error) *Application_FindLogPollerBackfillJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_FindLogPollerBackfillJob_Call) RunAndReturn(run func(context.Context, *big.Int, int64) (*logpoller.BackfillJob, error)) *Application_FindLogPollerBackfillJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLogPollerBackfillJobs provides a mock function with given fields: ctx, chainID
+func (_m *Application) FindLogPollerBackfillJobs(ctx context.Context, chainID *big.Int) ([]logpoller.BackfillJob, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLogPollerBackfillJobs")
+	}
+
+	var r0 []logpoller.BackfillJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]logpoller.BackfillJob, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []logpoller.BackfillJob); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.BackfillJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_FindLogPollerBackfillJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLogPollerBackfillJobs'
+type Application_FindLogPollerBackfillJobs_Call struct {
+	*mock.Call
+}
+
+// FindLogPollerBackfillJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) FindLogPollerBackfillJobs(ctx interface{}, chainID interface{}) *Application_FindLogPollerBackfillJobs_Call {
+	return &Application_FindLogPollerBackfillJobs_Call{Call: _e.mock.On("FindLogPollerBackfillJobs", ctx, chainID)}
+}
+
+func (_c *Application_FindLogPollerBackfillJobs_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_FindLogPollerBackfillJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_FindLogPollerBackfillJobs_Call) Return(_a0 []logpoller.BackfillJob, _a1 error) *Application_FindLogPollerBackfillJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_FindLogPollerBackfillJobs_Call) RunAndReturn(run func(context.Context, *big.Int) ([]logpoller.BackfillJob, error)) *Application_FindLogPollerBackfillJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLogPollerLogsStats provides a mock function with given fields: ctx, chainID
+func (_m *Application) FindLogPollerLogsStats(ctx context.Context, chainID *big.Int) ([]logpoller.FilterLogsStats, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLogPollerLogsStats")
+	}
+
+	var r0 []logpoller.FilterLogsStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]logpoller.FilterLogsStats, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []logpoller.FilterLogsStats); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.FilterLogsStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_FindLogPollerLogsStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLogPollerLogsStats'
+type Application_FindLogPollerLogsStats_Call struct {
+	*mock.Call
+}
+
+// FindLogPollerLogsStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) FindLogPollerLogsStats(ctx interface{}, chainID interface{}) *Application_FindLogPollerLogsStats_Call {
+	return &Application_FindLogPollerLogsStats_Call{Call: _e.mock.On("FindLogPollerLogsStats", ctx, chainID)}
+}
+
+func (_c *Application_FindLogPollerLogsStats_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_FindLogPollerLogsStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_FindLogPollerLogsStats_Call) Return(_a0 []logpoller.FilterLogsStats, _a1 error) *Application_FindLogPollerLogsStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_FindLogPollerLogsStats_Call) RunAndReturn(run func(context.Context, *big.Int) ([]logpoller.FilterLogsStats, error)) *Application_FindLogPollerLogsStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAuditLogger provides a mock function with given fields:
 func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	ret := _m.Called()
@@ -1506,6 +1854,75 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobRun provides a mock function with given fields: ctx, spec, fixtures
+func (_m *Application) SimulateJobRun(ctx context.Context, spec pipeline.Spec, fixtures pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Fixtures) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Fixtures) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, fixtures)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Fixtures) error); ok {
+		r2 = rf(ctx, spec, fixtures)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobRun'
+type Application_SimulateJobRun_Call struct {
+	*mock.Call
+}
+
+// SimulateJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - fixtures pipeline.Fixtures
+func (_e *Application_Expecter) SimulateJobRun(ctx interface{}, spec interface{}, fixtures interface{}) *Application_SimulateJobRun_Call {
+	return &Application_SimulateJobRun_Call{Call: _e.mock.On("SimulateJobRun", ctx, spec, fixtures)}
+}
+
+func (_c *Application_SimulateJobRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, fixtures pipeline.Fixtures)) *Application_SimulateJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Fixtures))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulateJobRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulateJobRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulateJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1597,6 +2014,53 @@ func (_c *Application_Stop_Call) RunAndReturn(run func() error) *Application_Sto
 	return _c
 }
 
+// TxCostORM provides a mock function with given fields:
+func (_m *Application) TxCostORM() txmgr.TxCostORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxCostORM")
+	}
+
+	var r0 txmgr.TxCostORM
+	if rf, ok := ret.Get(0).(func() txmgr.TxCostORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(txmgr.TxCostORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_TxCostORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxCostORM'
+type Application_TxCostORM_Call struct {
+	*mock.Call
+}
+
+// TxCostORM is a helper method to define mock.On call
+func (_e *Application_Expecter) TxCostORM() *Application_TxCostORM_Call {
+	return &Application_TxCostORM_Call{Call: _e.mock.On("TxCostORM")}
+}
+
+func (_c *Application_TxCostORM_Call) Run(run func()) *Application_TxCostORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_TxCostORM_Call) Return(_a0 txmgr.TxCostORM) *Application_TxCostORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_TxCostORM_Call) RunAndReturn(run func() txmgr.TxCostORM) *Application_TxCostORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TxmStorageService provides a mock function with given fields:
 func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	ret := _m.Called()
@@ -52,6 +52,8 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionBumped     EventID = "ETH_TRANSACTION_BUMPED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -0,0 +1,55 @@
+package alerting
+
+import (
+	"context"
+	"time"
+)
+
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+type Status string
+
+const (
+	// StatusFiring is set on alerts whose condition currently holds.
+	StatusFiring Status = "firing"
+	// StatusResolved is set on a previously notified alert whose condition no longer holds.
+	StatusResolved Status = "resolved"
+)
+
+// Alert is a single occurrence of an alert condition. Alerts with the same Key
+// refer to the same underlying problem and are deduplicated.
+type Alert struct {
+	Key       string         `json:"key"`
+	Type      string         `json:"type"`
+	Severity  Severity       `json:"severity"`
+	Status    Status         `json:"status"`
+	Summary   string         `json:"summary"`
+	Details   map[string]any `json:"details,omitempty"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+// Alert types
+const (
+	TypeLowBalance   = "low_balance"
+	TypeStuckTx      = "stuck_transaction"
+	TypeRPCNode      = "rpc_node_unhealthy"
+	TypeJobErrorRate = "job_error_rate"
+)
+
+// Check evaluates one kind of alert condition.
+type Check interface {
+	Name() string
+	// Check returns the alerts which are currently firing.
+	Check(ctx context.Context) ([]Alert, error)
+}
+
+// Sink delivers alerts to an external system.
+type Sink interface {
+	Send(ctx context.Context, alert Alert) error
+}
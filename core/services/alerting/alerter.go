@@ -0,0 +1,153 @@
+package alerting
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// webhookTimeout bounds each delivery to a webhook
+const webhookTimeout = 10 * time.Second
+
+var promAlertsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "alerts_sent_total",
+	Help: "Number of alert notifications sent to the configured webhooks",
+},
+	[]string{"type", "status"},
+)
+
+// activeAlert is an alert which has been notified and has not resolved yet.
+type activeAlert struct {
+	alert    Alert
+	check    string
+	notified time.Time
+}
+
+// Alerter periodically evaluates its checks and notifies every sink of the
+// alerts which start firing, or keep firing past the cooldown, and of the
+// alerts which resolve. Alerts are deduplicated by key.
+type Alerter struct {
+	services.Service
+	eng *services.Engine
+
+	checks   []Check
+	sinks    []Sink
+	interval time.Duration
+	cooldown time.Duration
+
+	// active is only accessed from the check loop
+	active map[string]activeAlert
+}
+
+// NewAlerter returns an Alerter posting to the webhooks configured in cfg.
+func NewAlerter(cfg config.Alerting, checks []Check, lggr logger.Logger) (*Alerter, error) {
+	client := &http.Client{Timeout: webhookTimeout}
+	var sinks []Sink
+	for _, w := range cfg.Webhooks() {
+		sink, err := NewWebhookSink(client, w)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return newAlerter(checks, sinks, cfg.CheckInterval(), cfg.Cooldown(), lggr), nil
+}
+
+func newAlerter(checks []Check, sinks []Sink, interval, cooldown time.Duration, lggr logger.Logger) *Alerter {
+	a := &Alerter{
+		checks:   checks,
+		sinks:    sinks,
+		interval: interval,
+		cooldown: cooldown,
+		active:   make(map[string]activeAlert),
+	}
+	a.Service, a.eng = services.Config{
+		Name:  "Alerter",
+		Start: a.start,
+	}.NewServiceEngine(lggr)
+	return a
+}
+
+func (a *Alerter) start(_ context.Context) error {
+	ticker := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(a.interval)
+	a.eng.GoTick(ticker, a.evaluate)
+	return nil
+}
+
+func (a *Alerter) evaluate(ctx context.Context) {
+	now := time.Now()
+	firing := make(map[string]activeAlert)
+	failed := make(map[string]bool)
+	for _, c := range a.checks {
+		alerts, err := c.Check(ctx)
+		if err != nil {
+			// keep the alerts of this check as they were, rather than resolving them
+			a.eng.Errorw("Failed to evaluate alert check", "check", c.Name(), "err", err)
+			failed[c.Name()] = true
+			continue
+		}
+		for _, alert := range alerts {
+			if _, ok := firing[alert.Key]; ok {
+				continue
+			}
+			alert.Status = StatusFiring
+			if alert.Timestamp.IsZero() {
+				alert.Timestamp = now
+			}
+			firing[alert.Key] = activeAlert{alert: alert, check: c.Name()}
+		}
+	}
+
+	for _, key := range sortedKeys(firing) {
+		f := firing[key]
+		if prev, ok := a.active[key]; ok && now.Sub(prev.notified) < a.cooldown {
+			continue
+		}
+		a.notify(ctx, f.alert)
+		f.notified = now
+		a.active[key] = f
+	}
+
+	for _, key := range sortedKeys(a.active) {
+		prev := a.active[key]
+		if _, ok := firing[key]; ok || failed[prev.check] {
+			continue
+		}
+		resolved := prev.alert
+		resolved.Status = StatusResolved
+		resolved.Timestamp = now
+		a.notify(ctx, resolved)
+		delete(a.active, key)
+	}
+}
+
+func (a *Alerter) notify(ctx context.Context, alert Alert) {
+	lggr := a.eng.With("key", alert.Key, "type", alert.Type, "severity", alert.Severity, "status", alert.Status)
+	lggr.Infow("Sending alert: "+alert.Summary, "details", alert.Details)
+	for _, sink := range a.sinks {
+		if err := sink.Send(ctx, alert); err != nil {
+			lggr.Errorw("Failed to send alert", "err", err)
+		}
+	}
+	promAlertsSent.WithLabelValues(alert.Type, string(alert.Status)).Inc()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,99 @@
+package alerting
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type fakeCheck struct {
+	alerts []Alert
+	err    error
+}
+
+func (c *fakeCheck) Name() string { return "FakeCheck" }
+
+func (c *fakeCheck) Check(context.Context) ([]Alert, error) { return c.alerts, c.err }
+
+type fakeSink struct {
+	sent []Alert
+}
+
+func (s *fakeSink) Send(_ context.Context, alert Alert) error {
+	s.sent = append(s.sent, alert)
+	return nil
+}
+
+func TestAlerter_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	lowBalance := Alert{Key: "low_balance/1/0xabc", Type: TypeLowBalance, Severity: SeverityWarning, Summary: "low balance"}
+	stuckTx := Alert{Key: "stuck_transaction/1/7", Type: TypeStuckTx, Severity: SeverityCritical, Summary: "stuck tx"}
+
+	t.Run("deduplicates and resolves alerts", func(t *testing.T) {
+		check := &fakeCheck{alerts: []Alert{lowBalance, lowBalance, stuckTx}}
+		sink := &fakeSink{}
+		a := newAlerter([]Check{check}, []Sink{sink}, time.Minute, time.Hour, logger.TestLogger(t))
+
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 2)
+		assert.Equal(t, lowBalance.Key, sink.sent[0].Key)
+		assert.Equal(t, StatusFiring, sink.sent[0].Status)
+		assert.False(t, sink.sent[0].Timestamp.IsZero())
+		assert.Equal(t, stuckTx.Key, sink.sent[1].Key)
+
+		// still firing, within the cooldown
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 2)
+
+		check.alerts = []Alert{stuckTx}
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 3)
+		assert.Equal(t, lowBalance.Key, sink.sent[2].Key)
+		assert.Equal(t, StatusResolved, sink.sent[2].Status)
+
+		// fires again right away once resolved
+		check.alerts = []Alert{lowBalance, stuckTx}
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 4)
+		assert.Equal(t, lowBalance.Key, sink.sent[3].Key)
+		assert.Equal(t, StatusFiring, sink.sent[3].Status)
+	})
+
+	t.Run("notifies again after the cooldown", func(t *testing.T) {
+		check := &fakeCheck{alerts: []Alert{lowBalance}}
+		sink := &fakeSink{}
+		a := newAlerter([]Check{check}, []Sink{sink}, time.Minute, 0, logger.TestLogger(t))
+
+		a.evaluate(ctx)
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 2)
+		assert.Equal(t, StatusFiring, sink.sent[1].Status)
+	})
+
+	t.Run("keeps alerts of failing checks", func(t *testing.T) {
+		check := &fakeCheck{alerts: []Alert{lowBalance}}
+		sink := &fakeSink{}
+		a := newAlerter([]Check{check}, []Sink{sink}, time.Minute, time.Hour, logger.TestLogger(t))
+
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 1)
+
+		check.alerts, check.err = nil, errors.New("db down")
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 1)
+
+		check.err = nil
+		a.evaluate(ctx)
+		require.Len(t, sink.sent, 2)
+		assert.Equal(t, StatusResolved, sink.sent[1].Status)
+	})
+}
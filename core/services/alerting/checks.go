@@ -0,0 +1,232 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// Node states reported by the MultiNode which make an RPC node unusable.
+const (
+	nodeStateAlive       = "Alive"
+	nodeStateUnreachable = "Unreachable"
+	nodeStateOutOfSync   = "OutOfSync"
+)
+
+// NewChecks returns all the checks supported by the node.
+func NewChecks(cfg config.Alerting, chains legacyevm.LegacyChainContainer, ethKeyStore keystore.Eth, orm ORM) []Check {
+	return []Check{
+		NewBalanceCheck(chains, ethKeyStore),
+		NewStuckTxCheck(orm),
+		NewRPCNodeCheck(chains),
+		NewJobErrorRateCheck(orm, cfg.JobErrorRateThreshold(), cfg.JobErrorRateWindow(), cfg.JobErrorRateMinRuns()),
+	}
+}
+
+type balanceCheck struct {
+	chains      legacyevm.LegacyChainContainer
+	ethKeyStore keystore.Eth
+}
+
+// NewBalanceCheck alerts on the enabled keys whose balance, as last reported
+// by the balance monitor, is below the chain's BalanceMonitor.AlertThreshold.
+func NewBalanceCheck(chains legacyevm.LegacyChainContainer, ethKeyStore keystore.Eth) Check {
+	return &balanceCheck{chains: chains, ethKeyStore: ethKeyStore}
+}
+
+func (c *balanceCheck) Name() string { return "BalanceCheck" }
+
+func (c *balanceCheck) Check(ctx context.Context) ([]Alert, error) {
+	var alerts []Alert
+	for _, chain := range c.chains.Slice() {
+		threshold := chain.Config().EVM().BalanceMonitor().AlertThreshold()
+		bm := chain.BalanceMonitor()
+		if threshold == nil || bm == nil {
+			continue
+		}
+		addresses, err := c.ethKeyStore.EnabledAddressesForChain(ctx, chain.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get enabled keys for chain %s: %w", chain.ID(), err)
+		}
+		for _, address := range addresses {
+			balance := bm.GetEthBalance(address)
+			if balance == nil || balance.ToInt().Cmp(threshold.ToInt()) >= 0 {
+				continue
+			}
+			severity := SeverityWarning
+			if balance.IsZero() {
+				severity = SeverityCritical
+			}
+			alerts = append(alerts, Alert{
+				Key:      fmt.Sprintf("%s/%s/%s", TypeLowBalance, chain.ID(), address.Hex()),
+				Type:     TypeLowBalance,
+				Severity: severity,
+				Summary:  fmt.Sprintf("Balance of key %s on chain %s is %s, below %s", address.Hex(), chain.ID(), balance, threshold),
+				Details: map[string]any{
+					"evmChainID": chain.ID().String(),
+					"address":    address.Hex(),
+					"balance":    balance.String(),
+					"threshold":  threshold.String(),
+				},
+			})
+		}
+	}
+	return alerts, nil
+}
+
+type stuckTxCheck struct {
+	orm ORM
+}
+
+// NewStuckTxCheck alerts on the transactions the stuck tx detector is purging.
+func NewStuckTxCheck(orm ORM) Check {
+	return &stuckTxCheck{orm: orm}
+}
+
+func (c *stuckTxCheck) Name() string { return "StuckTxCheck" }
+
+func (c *stuckTxCheck) Check(ctx context.Context) ([]Alert, error) {
+	txs, err := c.orm.FindStuckTxs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stuck transactions: %w", err)
+	}
+	alerts := make([]Alert, 0, len(txs))
+	for _, tx := range txs {
+		alerts = append(alerts, Alert{
+			Key:      fmt.Sprintf("%s/%s/%d", TypeStuckTx, tx.EVMChainID.String(), tx.ID),
+			Type:     TypeStuckTx,
+			Severity: SeverityCritical,
+			Summary: fmt.Sprintf("Transaction %d with nonce %d from %s on chain %s is terminally stuck and is being purged",
+				tx.ID, tx.Nonce.ValueOrZero(), tx.FromAddress.Hex(), tx.EVMChainID.String()),
+			Details: map[string]any{
+				"evmChainID":  tx.EVMChainID.String(),
+				"txID":        tx.ID,
+				"fromAddress": tx.FromAddress.Hex(),
+				"nonce":       tx.Nonce.ValueOrZero(),
+				"createdAt":   tx.CreatedAt,
+			},
+		})
+	}
+	return alerts, nil
+}
+
+type rpcNodeCheck struct {
+	chains legacyevm.LegacyChainContainer
+}
+
+// NewRPCNodeCheck alerts on the RPC nodes which are Unreachable or OutOfSync.
+// The alerts are critical when none of the chain's nodes are Alive.
+func NewRPCNodeCheck(chains legacyevm.LegacyChainContainer) Check {
+	return &rpcNodeCheck{chains: chains}
+}
+
+func (c *rpcNodeCheck) Name() string { return "RPCNodeCheck" }
+
+func (c *rpcNodeCheck) Check(_ context.Context) ([]Alert, error) {
+	var alerts []Alert
+	for _, chain := range c.chains.Slice() {
+		states := chain.Client().NodeStates()
+		names := make([]string, 0, len(states))
+		var alive int
+		for name, state := range states {
+			names = append(names, name)
+			if state == nodeStateAlive {
+				alive++
+			}
+		}
+		sort.Strings(names)
+
+		severity := SeverityWarning
+		if alive == 0 {
+			severity = SeverityCritical
+		}
+		for _, name := range names {
+			state := states[name]
+			if state != nodeStateUnreachable && state != nodeStateOutOfSync {
+				continue
+			}
+			alerts = append(alerts, Alert{
+				Key:      fmt.Sprintf("%s/%s/%s", TypeRPCNode, chain.ID(), name),
+				Type:     TypeRPCNode,
+				Severity: severity,
+				Summary:  fmt.Sprintf("RPC node %s on chain %s is %s, %d of %d nodes are alive", name, chain.ID(), state, alive, len(states)),
+				Details: map[string]any{
+					"evmChainID": chain.ID().String(),
+					"node":       name,
+					"state":      state,
+					"aliveNodes": alive,
+					"totalNodes": len(states),
+				},
+			})
+		}
+	}
+	return alerts, nil
+}
+
+type jobErrorRateCheck struct {
+	orm       ORM
+	threshold float64
+	window    time.Duration
+	minRuns   uint32
+}
+
+// NewJobErrorRateCheck alerts on the jobs with at least minRuns runs over the
+// last window, of which at least the threshold fraction errored, and whose
+// error rate rose compared to the window before. A job which keeps failing at
+// the same rate is not alerted on again.
+func NewJobErrorRateCheck(orm ORM, threshold float64, window time.Duration, minRuns uint32) Check {
+	return &jobErrorRateCheck{orm: orm, threshold: threshold, window: window, minRuns: minRuns}
+}
+
+func (c *jobErrorRateCheck) Name() string { return "JobErrorRateCheck" }
+
+func (c *jobErrorRateCheck) Check(ctx context.Context) ([]Alert, error) {
+	rates, err := c.orm.FindJobErrorRates(ctx, c.window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find job error rates: %w", err)
+	}
+	var alerts []Alert
+	for _, r := range rates {
+		if r.Runs == 0 || r.Runs < int64(c.minRuns) {
+			continue
+		}
+		rate := float64(r.Errored) / float64(r.Runs)
+		var prevRate float64
+		if r.PrevRuns > 0 {
+			prevRate = float64(r.PrevErrored) / float64(r.PrevRuns)
+		}
+		if rate < c.threshold || rate <= prevRate {
+			continue
+		}
+		// Crossing the threshold is critical, rising further above it is a warning.
+		severity := SeverityWarning
+		if prevRate < c.threshold {
+			severity = SeverityCritical
+		}
+		name := r.JobName.ValueOrZero()
+		if name == "" {
+			name = fmt.Sprintf("%d", r.JobID)
+		}
+		alerts = append(alerts, Alert{
+			Key:      fmt.Sprintf("%s/%d", TypeJobErrorRate, r.JobID),
+			Type:     TypeJobErrorRate,
+			Severity: severity,
+			Summary:  fmt.Sprintf("Job %s errored %d of its %d runs over the last %s (%.0f%%, was %.0f%%)", name, r.Errored, r.Runs, c.window, rate*100, prevRate*100),
+			Details: map[string]any{
+				"jobID":         r.JobID,
+				"jobName":       name,
+				"runs":          r.Runs,
+				"erroredRuns":   r.Errored,
+				"errorRate":     rate,
+				"prevErrorRate": prevRate,
+				"window":        c.window.String(),
+			},
+		})
+	}
+	return alerts, nil
+}
@@ -0,0 +1,153 @@
+package alerting
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+	evmtestutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	legacyevmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type fakeORM struct {
+	stuckTxs []StuckTx
+	rates    []JobErrorRate
+}
+
+func (o *fakeORM) FindStuckTxs(context.Context) ([]StuckTx, error) { return o.stuckTxs, nil }
+
+func (o *fakeORM) FindJobErrorRates(context.Context, time.Duration) ([]JobErrorRate, error) {
+	return o.rates, nil
+}
+
+func newChainContainer(t *testing.T, chains ...legacyevm.Chain) legacyevm.LegacyChainContainer {
+	cc := legacyevmmocks.NewLegacyChainContainer(t)
+	cc.On("Slice").Return(chains).Maybe()
+	return cc
+}
+
+func TestBalanceCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := big.NewInt(1)
+	low, empty, funded := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+
+	chain := legacyevmmocks.NewChain(t)
+	chain.On("ID").Return(chainID)
+	chain.On("Config").Return(evmtestutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.BalanceMonitor.AlertThreshold = assets.NewWeiI(100)
+	}))
+	bm := evmmocks.NewBalanceMonitor(t)
+	bm.On("GetEthBalance", low).Return(assets.NewEth(99))
+	bm.On("GetEthBalance", empty).Return(assets.NewEth(0))
+	bm.On("GetEthBalance", funded).Return(assets.NewEth(100))
+	chain.On("BalanceMonitor").Return(bm)
+
+	ks := ksmocks.NewEth(t)
+	ks.On("EnabledAddressesForChain", ctx, chainID).Return([]common.Address{low, empty, funded}, nil)
+
+	alerts, err := NewBalanceCheck(newChainContainer(t, chain), ks).Check(ctx)
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, "low_balance/1/"+low.Hex(), alerts[0].Key)
+	assert.Equal(t, SeverityWarning, alerts[0].Severity)
+	assert.Equal(t, "low_balance/1/"+empty.Hex(), alerts[1].Key)
+	assert.Equal(t, SeverityCritical, alerts[1].Severity)
+
+	t.Run("without threshold", func(t *testing.T) {
+		chain := legacyevmmocks.NewChain(t)
+		chain.On("Config").Return(evmtestutils.NewTestChainScopedConfig(t, nil))
+		chain.On("BalanceMonitor").Return(bm).Maybe()
+
+		alerts, err := NewBalanceCheck(newChainContainer(t, chain), ksmocks.NewEth(t)).Check(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+	})
+}
+
+func TestStuckTxCheck(t *testing.T) {
+	t.Parallel()
+
+	from := testutils.NewAddress()
+	orm := &fakeORM{stuckTxs: []StuckTx{
+		{ID: 7, EVMChainID: *ubig.NewI(1), FromAddress: from, Nonce: null.IntFrom(3), CreatedAt: time.Now()},
+	}}
+
+	alerts, err := NewStuckTxCheck(orm).Check(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "stuck_transaction/1/7", alerts[0].Key)
+	assert.Equal(t, SeverityCritical, alerts[0].Severity)
+	assert.Contains(t, alerts[0].Summary, "nonce 3 from "+from.Hex())
+}
+
+func TestRPCNodeCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	newChain := func(id int64, states map[string]string) legacyevm.Chain {
+		client := evmclimocks.NewClient(t)
+		client.On("NodeStates").Return(states)
+		chain := legacyevmmocks.NewChain(t)
+		chain.On("ID").Return(big.NewInt(id)).Maybe()
+		chain.On("Client").Return(client)
+		return chain
+	}
+
+	alerts, err := NewRPCNodeCheck(newChainContainer(t,
+		newChain(1, map[string]string{"primary": "Alive", "backup": "Unreachable", "other": "OutOfSync"}),
+		newChain(2, map[string]string{"primary": "Unreachable"}),
+		newChain(3, map[string]string{"primary": "Alive"}),
+	)).Check(ctx)
+	require.NoError(t, err)
+	require.Len(t, alerts, 3)
+	assert.Equal(t, "rpc_node_unhealthy/1/backup", alerts[0].Key)
+	assert.Equal(t, SeverityWarning, alerts[0].Severity)
+	assert.Equal(t, "rpc_node_unhealthy/1/other", alerts[1].Key)
+	assert.Equal(t, SeverityWarning, alerts[1].Severity)
+	assert.Equal(t, "rpc_node_unhealthy/2/primary", alerts[2].Key)
+	assert.Equal(t, SeverityCritical, alerts[2].Severity)
+}
+
+func TestJobErrorRateCheck(t *testing.T) {
+	t.Parallel()
+
+	orm := &fakeORM{rates: []JobErrorRate{
+		// rising
+		{JobID: 1, JobName: null.StringFrom("feed"), Runs: 10, Errored: 8, PrevRuns: 10, PrevErrored: 2},
+		// rising, but already above the threshold
+		{JobID: 2, Runs: 10, Errored: 9, PrevRuns: 10, PrevErrored: 6},
+		// steady
+		{JobID: 5, Runs: 10, Errored: 5, PrevRuns: 10, PrevErrored: 5},
+		// falling
+		{JobID: 6, Runs: 10, Errored: 6, PrevRuns: 10, PrevErrored: 9},
+		// below the threshold
+		{JobID: 3, Runs: 10, Errored: 4},
+		// too few runs
+		{JobID: 4, Runs: 4, Errored: 4},
+	}}
+
+	alerts, err := NewJobErrorRateCheck(orm, 0.5, time.Hour, 5).Check(testutils.Context(t))
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, "job_error_rate/1", alerts[0].Key)
+	assert.Equal(t, SeverityCritical, alerts[0].Severity)
+	assert.Equal(t, "Job feed errored 8 of its 10 runs over the last 1h0m0s (80%, was 20%)", alerts[0].Summary)
+	assert.Equal(t, "job_error_rate/2", alerts[1].Key)
+	assert.Equal(t, SeverityWarning, alerts[1].Severity)
+}
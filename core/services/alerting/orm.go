@@ -0,0 +1,77 @@
+package alerting
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// StuckTx is an unconfirmed transaction which has been detected as terminally
+// stuck, and is being purged.
+type StuckTx struct {
+	ID          int64
+	EVMChainID  ubig.Big `db:"evm_chain_id"`
+	FromAddress common.Address
+	Nonce       null.Int
+	CreatedAt   time.Time
+}
+
+// JobErrorRate counts the runs of a job over the last window, and the one before it.
+type JobErrorRate struct {
+	JobID       int32
+	JobName     null.String
+	Runs        int64
+	Errored     int64
+	PrevRuns    int64
+	PrevErrored int64
+}
+
+type ORM interface {
+	FindStuckTxs(ctx context.Context) ([]StuckTx, error)
+	FindJobErrorRates(ctx context.Context, window time.Duration) ([]JobErrorRate, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// FindStuckTxs returns the unconfirmed transactions with a purge attempt, as
+// created by the stuck tx detector.
+func (o *orm) FindStuckTxs(ctx context.Context) (txs []StuckTx, err error) {
+	const sql = `SELECT t.id, t.evm_chain_id, t.from_address, t.nonce, t.created_at FROM evm.txes t
+WHERE t.state = 'unconfirmed' AND EXISTS (
+	SELECT 1 FROM evm.tx_attempts a WHERE a.eth_tx_id = t.id AND a.is_purge_attempt
+)
+ORDER BY t.id`
+	err = o.ds.SelectContext(ctx, &txs, sql)
+	return
+}
+
+// FindJobErrorRates returns the run counts of every job which had runs over the last two windows.
+func (o *orm) FindJobErrorRates(ctx context.Context, window time.Duration) (rates []JobErrorRate, err error) {
+	const sql = `SELECT j.id AS job_id, j.name AS job_name,
+	COUNT(*) FILTER (WHERE pr.created_at >= $1) AS runs,
+	COUNT(*) FILTER (WHERE pr.created_at >= $1 AND pr.state = 'errored') AS errored,
+	COUNT(*) FILTER (WHERE pr.created_at < $1) AS prev_runs,
+	COUNT(*) FILTER (WHERE pr.created_at < $1 AND pr.state = 'errored') AS prev_errored
+FROM pipeline_runs pr
+JOIN jobs j ON j.pipeline_spec_id = pr.pipeline_spec_id
+WHERE pr.created_at >= $2 AND pr.state IN ('completed', 'errored')
+GROUP BY j.id, j.name
+ORDER BY j.id`
+	now := time.Now()
+	err = o.ds.SelectContext(ctx, &rates, sql, now.Add(-window), now.Add(-2*window))
+	return
+}
@@ -0,0 +1,146 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+// webhookSink posts alerts to an HTTP endpoint, encoded with the configured format.
+type webhookSink struct {
+	client *http.Client
+	name   string
+	url    *url.URL
+	encode func(Alert) any
+}
+
+var _ Sink = (*webhookSink)(nil)
+
+// NewWebhookSink returns a Sink posting to the webhook described by cfg.
+func NewWebhookSink(client *http.Client, cfg config.AlertingWebhook) (Sink, error) {
+	s := &webhookSink{client: client, name: cfg.Name(), url: cfg.URL()}
+	switch cfg.Format() {
+	case toml.AlertingWebhookFormatJSON:
+		s.encode = func(a Alert) any { return a }
+	case toml.AlertingWebhookFormatSlack:
+		s.encode = func(a Alert) any { return newSlackMessage(a) }
+	case toml.AlertingWebhookFormatPagerDuty:
+		routingKey := cfg.RoutingKey()
+		s.encode = func(a Alert) any { return newPagerDutyEvent(routingKey, a) }
+	default:
+		return nil, fmt.Errorf("unsupported webhook format: %s", cfg.Format())
+	}
+	return s, nil
+}
+
+func (s *webhookSink) Send(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(s.encode(alert))
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		// The URL is a secret, so only keep the cause of a *url.Error.
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return fmt.Errorf("failed to post alert to webhook %s: %w", s.name, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("webhook %s responded with status %d: %s", s.name, res.StatusCode, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func newSlackMessage(a Alert) slackMessage {
+	var sb strings.Builder
+	if a.Status == StatusResolved {
+		sb.WriteString(":white_check_mark: *[RESOLVED]* ")
+	} else {
+		fmt.Fprintf(&sb, "%s *[%s]* ", slackSeverityEmoji(a.Severity), strings.ToUpper(string(a.Severity)))
+	}
+	sb.WriteString(a.Summary)
+	for _, k := range sortedKeys(a.Details) {
+		fmt.Fprintf(&sb, "\n• %s: `%v`", k, a.Details[k])
+	}
+	return slackMessage{Text: sb.String()}
+}
+
+func slackSeverityEmoji(s Severity) string {
+	switch s {
+	case SeverityCritical:
+		return ":rotating_light:"
+	case SeverityWarning:
+		return ":warning:"
+	default:
+		return ":information_source:"
+	}
+}
+
+// pagerDutyEvent is a PagerDuty Events API v2 event.
+// See https://developer.pagerduty.com/docs/events-api-v2/trigger-events/
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      Severity       `json:"severity"`
+	Timestamp     string         `json:"timestamp"`
+	Class         string         `json:"class"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+func newPagerDutyEvent(routingKey string, a Alert) pagerDutyEvent {
+	e := pagerDutyEvent{RoutingKey: routingKey, DedupKey: a.Key}
+	if a.Status == StatusResolved {
+		// resolve events only need the dedup key
+		e.EventAction = "resolve"
+		return e
+	}
+	e.EventAction = "trigger"
+	e.Payload = &pagerDutyPayload{
+		Summary:       a.Summary,
+		Source:        hostname(),
+		Severity:      a.Severity,
+		Timestamp:     a.Timestamp.UTC().Format("2006-01-02T15:04:05.000Z"),
+		Class:         a.Type,
+		CustomDetails: a.Details,
+	}
+	return e
+}
+
+func hostname() string {
+	h, err := os.Hostname()
+	if err != nil {
+		return "chainlink"
+	}
+	return h
+}
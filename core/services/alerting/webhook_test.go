@@ -0,0 +1,142 @@
+package alerting
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type webhookConfig struct {
+	name       string
+	url        *url.URL
+	format     string
+	routingKey string
+}
+
+func (w *webhookConfig) Name() string       { return w.name }
+func (w *webhookConfig) URL() *url.URL      { return w.url }
+func (w *webhookConfig) Format() string     { return w.format }
+func (w *webhookConfig) RoutingKey() string { return w.routingKey }
+
+func newWebhookServer(t *testing.T, status int) (*url.URL, <-chan map[string]any) {
+	bodies := make(chan map[string]any, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(b, &body))
+		bodies <- body
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("boom"))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u, bodies
+}
+
+func TestWebhookSink_Send(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	alert := Alert{
+		Key:       "low_balance/1/0xabc",
+		Type:      TypeLowBalance,
+		Severity:  SeverityWarning,
+		Status:    StatusFiring,
+		Summary:   "Balance of key 0xabc on chain 1 is 0.1 ETH, below 1 ETH",
+		Details:   map[string]any{"evmChainID": "1", "address": "0xabc"},
+		Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		u, bodies := newWebhookServer(t, http.StatusOK)
+		sink, err := NewWebhookSink(http.DefaultClient, &webhookConfig{url: u, format: toml.AlertingWebhookFormatJSON})
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Send(ctx, alert))
+		body := <-bodies
+		assert.Equal(t, alert.Key, body["key"])
+		assert.Equal(t, "warning", body["severity"])
+		assert.Equal(t, "firing", body["status"])
+		assert.Equal(t, alert.Summary, body["summary"])
+		assert.Equal(t, map[string]any{"evmChainID": "1", "address": "0xabc"}, body["details"])
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		u, bodies := newWebhookServer(t, http.StatusOK)
+		sink, err := NewWebhookSink(http.DefaultClient, &webhookConfig{url: u, format: toml.AlertingWebhookFormatSlack})
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Send(ctx, alert))
+		assert.Equal(t, ":warning: *[WARNING]* "+alert.Summary+"\n• address: `0xabc`\n• evmChainID: `1`", (<-bodies)["text"])
+
+		resolved := alert
+		resolved.Status = StatusResolved
+		require.NoError(t, sink.Send(ctx, resolved))
+		assert.Equal(t, ":white_check_mark: *[RESOLVED]* "+alert.Summary+"\n• address: `0xabc`\n• evmChainID: `1`", (<-bodies)["text"])
+	})
+
+	t.Run("pagerduty", func(t *testing.T) {
+		u, bodies := newWebhookServer(t, http.StatusAccepted)
+		sink, err := NewWebhookSink(http.DefaultClient, &webhookConfig{url: u, format: toml.AlertingWebhookFormatPagerDuty, routingKey: "routing-key"})
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Send(ctx, alert))
+		body := <-bodies
+		assert.Equal(t, "routing-key", body["routing_key"])
+		assert.Equal(t, "trigger", body["event_action"])
+		assert.Equal(t, alert.Key, body["dedup_key"])
+		payload := body["payload"].(map[string]any)
+		assert.Equal(t, alert.Summary, payload["summary"])
+		assert.Equal(t, "warning", payload["severity"])
+		assert.Equal(t, "2024-05-01T12:00:00.000Z", payload["timestamp"])
+		assert.Equal(t, TypeLowBalance, payload["class"])
+		assert.NotEmpty(t, payload["source"])
+
+		resolved := alert
+		resolved.Status = StatusResolved
+		require.NoError(t, sink.Send(ctx, resolved))
+		body = <-bodies
+		assert.Equal(t, "resolve", body["event_action"])
+		assert.Equal(t, alert.Key, body["dedup_key"])
+		assert.NotContains(t, body, "payload")
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		u, bodies := newWebhookServer(t, http.StatusInternalServerError)
+		sink, err := NewWebhookSink(http.DefaultClient, &webhookConfig{name: "ops", url: u, format: toml.AlertingWebhookFormatJSON})
+		require.NoError(t, err)
+
+		err = sink.Send(ctx, alert)
+		<-bodies
+		require.ErrorContains(t, err, "webhook ops responded with status 500: boom")
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		u := &url.URL{Scheme: "http", Host: "127.0.0.1:1", Path: "/services/secret-token"}
+		sink, err := NewWebhookSink(http.DefaultClient, &webhookConfig{name: "ops", url: u, format: toml.AlertingWebhookFormatSlack})
+		require.NoError(t, err)
+
+		err = sink.Send(ctx, alert)
+		require.ErrorContains(t, err, "failed to post alert to webhook ops")
+		assert.NotContains(t, err.Error(), "secret-token")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := NewWebhookSink(http.DefaultClient, &webhookConfig{url: &url.URL{}, format: "xml"})
+		require.ErrorContains(t, err, "unsupported webhook format: xml")
+	})
+}
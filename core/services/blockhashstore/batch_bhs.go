@@ -71,6 +71,7 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 		EncodedPayload: payload,
 		FeeLimit:       b.config.LimitDefault(),
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgr.TxPriorityLow,
 	})
 
 	if err != nil {
@@ -105,6 +105,7 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 		// Set a queue size of 256. At most we store the blockhash of every block, and only the
 		// latest 256 can possibly be stored.
 		Strategy: txmgrcommon.NewQueueingTxStrategy(c.jobID, 256),
+		Priority: txmgr.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -143,6 +144,7 @@ func (c *BulletproofBHS) StoreTrusted(
 		FeeLimit:       c.config.LimitDefault(),
 
 		Strategy: txmgrcommon.NewSendEveryStrategy(),
+		Priority: txmgr.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -197,6 +199,7 @@ func (c *BulletproofBHS) StoreEarliest(ctx context.Context) error {
 		EncodedPayload: payload,
 		FeeLimit:       c.config.LimitDefault(),
 		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgr.TxPriorityLow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
@@ -33,6 +33,7 @@ import (
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -41,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/alerting"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
@@ -104,13 +106,19 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	// BridgeCircuitBreakers returns the circuit breakers guarding the bridges called by bridge tasks.
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	// TxCostORM returns the ledger of gas spent by confirmed transactions.
+	TxCostORM() txmgr.TxCostORM
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobRun executes a pipeline in-memory with fixtures in place of any side effects.
+	SimulateJobRun(ctx context.Context, spec pipeline.Spec, fixtures pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -130,6 +138,16 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// CreateLogPollerBackfillJob - creates a LogPoller job backfilling the logs of the given filters in a block range
+	CreateLogPollerBackfillJob(ctx context.Context, chainID *big.Int, filterNames []string, fromBlock, toBlock int64) (*logpoller.BackfillJob, error)
+	// FindLogPollerBackfillJob - finds a LogPoller backfill job by id
+	FindLogPollerBackfillJob(ctx context.Context, chainID *big.Int, id int64) (*logpoller.BackfillJob, error)
+	// FindLogPollerBackfillJobs - finds all LogPoller backfill jobs of the chain
+	FindLogPollerBackfillJobs(ctx context.Context, chainID *big.Int) ([]logpoller.BackfillJob, error)
+	// FindLogPollerLogsStats - finds the number and size of the logs stored for each LogPoller filter of the chain
+	FindLogPollerLogsStats(ctx context.Context, chainID *big.Int) ([]logpoller.FilterLogsStats, error)
+	// AcknowledgeSafeMode - takes the chain out of safe mode, and returns the acknowledged finality violations
+	AcknowledgeSafeMode(ctx context.Context, chainID *big.Int) ([]httypes.ReorgEvent, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -145,6 +163,7 @@ type ChainlinkApplication struct {
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	txCostORM                txmgr.TxCostORM
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -409,6 +428,17 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	srvcs = append(srvcs, pipelineORM)
+	if retention := cfg.JobPipeline().Retention(); retention.Enabled() {
+		srvcs = append(srvcs, pipeline.NewRetentionReaper(pipelineORM, retention, globalLogger))
+	}
+	if alertingCfg := cfg.Alerting(); alertingCfg.Enabled() {
+		checks := alerting.NewChecks(alertingCfg, legacyEVMChains, keyStore.Eth(), alerting.NewORM(opts.DS))
+		alerter, err := alerting.NewAlerter(alertingCfg, checks, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize alerting")
+		}
+		srvcs = append(srvcs, alerter)
+	}
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
@@ -647,6 +677,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		txCostORM:                txmgr.NewTxCostORM(opts.DS),
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -824,6 +855,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return app.pipelineRunner.BridgeCircuitBreakers()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -845,6 +880,10 @@ func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
 
+func (app *ChainlinkApplication) TxCostORM() txmgr.TxCostORM {
+	return app.txCostORM
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -953,6 +992,14 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+func (app *ChainlinkApplication) SimulateJobRun(
+	ctx context.Context,
+	spec pipeline.Spec,
+	fixtures pipeline.Fixtures,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	return pipeline.Simulate(ctx, app.pipelineRunner, spec, fixtures)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -1036,3 +1083,65 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 
 	return nil
 }
+
+// CreateLogPollerBackfillJob - creates a LogPoller job backfilling the logs of the given filters in a block range
+func (app *ChainlinkApplication) CreateLogPollerBackfillJob(ctx context.Context, chainID *big.Int, filterNames []string, fromBlock, toBlock int64) (*logpoller.BackfillJob, error) {
+	lp, err := app.logPoller(chainID, "CreateLogPollerBackfillJob")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := lp.CreateBackfillJob(ctx, filterNames, fromBlock, toBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backfill job: %w", err)
+	}
+
+	return job, nil
+}
+
+// FindLogPollerBackfillJob - finds a LogPoller backfill job by id
+func (app *ChainlinkApplication) FindLogPollerBackfillJob(ctx context.Context, chainID *big.Int, id int64) (*logpoller.BackfillJob, error) {
+	lp, err := app.logPoller(chainID, "FindLogPollerBackfillJob")
+	if err != nil {
+		return nil, err
+	}
+	return lp.GetBackfillJob(ctx, id)
+}
+
+// FindLogPollerBackfillJobs - finds all LogPoller backfill jobs of the chain
+func (app *ChainlinkApplication) FindLogPollerBackfillJobs(ctx context.Context, chainID *big.Int) ([]logpoller.BackfillJob, error) {
+	lp, err := app.logPoller(chainID, "FindLogPollerBackfillJobs")
+	if err != nil {
+		return nil, err
+	}
+	return lp.GetBackfillJobs(ctx)
+}
+
+// FindLogPollerLogsStats - finds the number and size of the logs stored for each LogPoller filter of the chain
+func (app *ChainlinkApplication) FindLogPollerLogsStats(ctx context.Context, chainID *big.Int) ([]logpoller.FilterLogsStats, error) {
+	lp, err := app.logPoller(chainID, "FindLogPollerLogsStats")
+	if err != nil {
+		return nil, err
+	}
+	return lp.LogsStats(ctx)
+}
+
+// AcknowledgeSafeMode - takes the chain out of safe mode, and returns the acknowledged finality violations
+func (app *ChainlinkApplication) AcknowledgeSafeMode(ctx context.Context, chainID *big.Int) ([]httypes.ReorgEvent, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	return chain.SafeMode().Acknowledge(ctx)
+}
+
+func (app *ChainlinkApplication) logPoller(chainID *big.Int, method string) (logpoller.LogPoller, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("%s is only available if LogPoller is enabled", method)
+	}
+	return chain.LogPoller(), nil
+}
@@ -226,6 +226,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Threshold"))
 	}
 
+	if err2 := s.Alerting.SetFrom(&f.Alerting); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Alerting"))
+	}
+
 	_, err = commonconfig.MultiErrorList(err)
 
 	return err
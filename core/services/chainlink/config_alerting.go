@@ -0,0 +1,105 @@
+package chainlink
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.uber.org/multierr"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.Alerting = (*alertingConfig)(nil)
+
+type alertingConfig struct {
+	c toml.Alerting
+	s toml.AlertingSecrets
+}
+
+func (a *alertingConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *alertingConfig) CheckInterval() time.Duration {
+	return a.c.CheckInterval.Duration()
+}
+
+func (a *alertingConfig) Cooldown() time.Duration {
+	return a.c.Cooldown.Duration()
+}
+
+func (a *alertingConfig) JobErrorRateThreshold() float64 {
+	return *a.c.JobErrorRateThreshold
+}
+
+func (a *alertingConfig) JobErrorRateWindow() time.Duration {
+	return a.c.JobErrorRateWindow.Duration()
+}
+
+func (a *alertingConfig) JobErrorRateMinRuns() uint32 {
+	return *a.c.JobErrorRateMinRuns
+}
+
+func (a *alertingConfig) Webhooks() []config.AlertingWebhook {
+	var webhooks []config.AlertingWebhook
+	for _, w := range a.c.Webhooks {
+		webhooks = append(webhooks, &alertingWebhookConfig{c: w, s: a.s.Webhooks[*w.Name]})
+	}
+	return webhooks
+}
+
+var _ config.AlertingWebhook = (*alertingWebhookConfig)(nil)
+
+type alertingWebhookConfig struct {
+	c toml.AlertingWebhook
+	s toml.AlertingWebhookSecrets
+}
+
+func (w *alertingWebhookConfig) Name() string {
+	return *w.c.Name
+}
+
+func (w *alertingWebhookConfig) URL() *url.URL {
+	if w.s.URL == nil {
+		return nil
+	}
+	return w.s.URL.URL()
+}
+
+func (w *alertingWebhookConfig) Format() string {
+	if w.c.Format == nil {
+		return toml.AlertingWebhookFormatJSON
+	}
+	return *w.c.Format
+}
+
+func (w *alertingWebhookConfig) RoutingKey() string {
+	if w.s.RoutingKey == nil {
+		return ""
+	}
+	return string(*w.s.RoutingKey)
+}
+
+// validateAlertingSecrets checks that every configured webhook has the secrets it needs.
+func validateAlertingSecrets(c toml.Alerting, s toml.AlertingSecrets) (err error) {
+	if c.Enabled == nil || !*c.Enabled {
+		return nil
+	}
+	for _, w := range c.Webhooks {
+		if w.Name == nil {
+			continue // reported by toml.Alerting.ValidateConfig
+		}
+		ws, ok := s.Webhooks[*w.Name]
+		if !ok || ws.URL == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Alerting.Webhooks.%s.URL", *w.Name), Msg: "must be set in secrets"})
+		}
+		if w.Format != nil && *w.Format == toml.AlertingWebhookFormatPagerDuty && ws.RoutingKey == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Alerting.Webhooks.%s.RoutingKey", *w.Name), Msg: "must be set in secrets for the pagerduty format"})
+		}
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertingConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	a := cfg.Alerting()
+	assert.True(t, a.Enabled())
+	assert.Equal(t, 30*time.Second, a.CheckInterval())
+	assert.Equal(t, 2*time.Hour, a.Cooldown())
+	assert.Equal(t, 0.25, a.JobErrorRateThreshold())
+	assert.Equal(t, 30*time.Minute, a.JobErrorRateWindow())
+	assert.Equal(t, uint32(5), a.JobErrorRateMinRuns())
+
+	webhooks := a.Webhooks()
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, "slack", webhooks[0].Name())
+	assert.Equal(t, "https://hooks.slack.com/services/test", webhooks[0].URL().String())
+	assert.Equal(t, "slack", webhooks[0].Format())
+	assert.Equal(t, "", webhooks[0].RoutingKey())
+	assert.Equal(t, "pagerduty", webhooks[1].Name())
+	assert.Equal(t, "https://events.pagerduty.com/v2/enqueue", webhooks[1].URL().String())
+	assert.Equal(t, "pagerduty", webhooks[1].Format())
+	assert.Equal(t, "test-routing-key", webhooks[1].RoutingKey())
+}
+
+func TestAlertingConfig_validateSecrets(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	require.NoError(t, opts.parse())
+	err := validateAlertingSecrets(opts.Config.Alerting, opts.Secrets.Alerting)
+	assert.ErrorContains(t, err, "Alerting.Webhooks.slack.URL: missing: must be set in secrets")
+	assert.ErrorContains(t, err, "Alerting.Webhooks.pagerduty.RoutingKey: missing: must be set in secrets for the pagerduty format")
+
+	opts = GeneralConfigOpts{
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	require.NoError(t, opts.parse())
+	assert.NoError(t, validateAlertingSecrets(opts.Config.Alerting, opts.Secrets.Alerting))
+}
@@ -214,7 +214,9 @@ func (g *generalConfig) AptosConfigs() RawConfigs {
 }
 
 func (g *generalConfig) Validate() error {
-	return g.validate(g.secrets.Validate)
+	return g.validate(func() error {
+		return multierr.Combine(g.secrets.Validate(), validateAlertingSecrets(g.c.Alerting, g.secrets.Alerting))
+	})
 }
 
 func (g *generalConfig) validate(secretsValidationFn func() error) error {
@@ -497,6 +499,10 @@ func (g *generalConfig) AuditLogger() coreconfig.AuditLogger {
 	return auditLoggerConfig{c: g.c.AuditLogger}
 }
 
+func (g *generalConfig) Alerting() coreconfig.Alerting {
+	return &alertingConfig{c: g.c.Alerting, s: g.secrets.Alerting}
+}
+
 func (g *generalConfig) Insecure() config.Insecure {
 	return &insecureConfig{c: g.c.Insecure}
 }
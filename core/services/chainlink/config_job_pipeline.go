@@ -30,7 +30,11 @@ func (j *jobPipelineConfig) MaxSuccessfulRuns() uint64 {
 	return *j.c.MaxSuccessfulRuns
 }
 
+// ReaperInterval returns 0 when Retention is enabled, as the retention reaper replaces the periodic reaper.
 func (j *jobPipelineConfig) ReaperInterval() time.Duration {
+	if *j.c.Retention.Enabled {
+		return 0
+	}
 	return j.c.ReaperInterval.Duration()
 }
 
@@ -49,3 +53,79 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) Retention() config.JobPipelineRetention {
+	return &jobPipelineRetentionConfig{c: j.c.Retention}
+}
+
+var _ config.JobPipelineRetention = (*jobPipelineRetentionConfig)(nil)
+
+type jobPipelineRetentionConfig struct {
+	c toml.JobPipelineRetention
+}
+
+func (r *jobPipelineRetentionConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *jobPipelineRetentionConfig) Interval() time.Duration {
+	return r.c.Interval.Duration()
+}
+
+func (r *jobPipelineRetentionConfig) ArchiveDir() string {
+	return *r.c.ArchiveDir
+}
+
+func (r *jobPipelineRetentionConfig) MaxAge() time.Duration {
+	return r.c.MaxAge.Duration()
+}
+
+func (r *jobPipelineRetentionConfig) MaxRuns() uint32 {
+	return *r.c.MaxRuns
+}
+
+func (r *jobPipelineRetentionConfig) ErroredMaxAge() time.Duration {
+	return r.c.ErroredMaxAge.Duration()
+}
+
+func (r *jobPipelineRetentionConfig) JobTypes() []config.JobPipelineRetentionJobType {
+	var jobTypes []config.JobPipelineRetentionJobType
+	for _, jt := range r.c.JobTypes {
+		jobTypes = append(jobTypes, &jobPipelineRetentionJobTypeConfig{c: jt, retention: r})
+	}
+	return jobTypes
+}
+
+var _ config.JobPipelineRetentionJobType = (*jobPipelineRetentionJobTypeConfig)(nil)
+
+// jobPipelineRetentionJobTypeConfig falls back to the retention defaults for
+// the fields which are not set.
+type jobPipelineRetentionJobTypeConfig struct {
+	c         toml.JobPipelineRetentionJobType
+	retention *jobPipelineRetentionConfig
+}
+
+func (r *jobPipelineRetentionJobTypeConfig) Type() string {
+	return *r.c.Type
+}
+
+func (r *jobPipelineRetentionJobTypeConfig) MaxAge() time.Duration {
+	if r.c.MaxAge == nil {
+		return r.retention.MaxAge()
+	}
+	return r.c.MaxAge.Duration()
+}
+
+func (r *jobPipelineRetentionJobTypeConfig) MaxRuns() uint32 {
+	if r.c.MaxRuns == nil {
+		return r.retention.MaxRuns()
+	}
+	return *r.c.MaxRuns
+}
+
+func (r *jobPipelineRetentionJobTypeConfig) ErroredMaxAge() time.Duration {
+	if r.c.ErroredMaxAge == nil {
+		return r.retention.ErroredMaxAge()
+	}
+	return r.c.ErroredMaxAge.Duration()
+}
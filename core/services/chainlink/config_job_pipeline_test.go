@@ -30,4 +30,45 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+
+	r := jp.Retention()
+	assert.False(t, r.Enabled())
+	assert.Equal(t, 2*time.Hour, r.Interval())
+	assert.Equal(t, 48*time.Hour, r.MaxAge())
+	assert.Equal(t, uint32(500), r.MaxRuns())
+	assert.Equal(t, 720*time.Hour, r.ErroredMaxAge())
+	assert.Equal(t, "test/archive/runs", r.ArchiveDir())
+	require.Len(t, r.JobTypes(), 1)
+	jt := r.JobTypes()[0]
+	assert.Equal(t, "webhook", jt.Type())
+	assert.Equal(t, 12*time.Hour, jt.MaxAge())
+	assert.Equal(t, uint32(10), jt.MaxRuns())
+	assert.Equal(t, 72*time.Hour, jt.ErroredMaxAge())
+}
+
+func TestJobPipelineRetentionConfig_JobTypeDefaults(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{`
+[JobPipeline.Retention]
+Enabled = true
+MaxRuns = 50
+
+[[JobPipeline.Retention.JobTypes]]
+Type = 'webhook'
+MaxAge = '1h'
+`},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+	require.NoError(t, cfg.(*generalConfig).c.Validate())
+	// the periodic reaper is replaced by the retention reaper, despite the default ReaperInterval
+	assert.Zero(t, cfg.JobPipeline().ReaperInterval())
+
+	r := cfg.JobPipeline().Retention()
+	require.Len(t, r.JobTypes(), 1)
+	jt := r.JobTypes()[0]
+	assert.Equal(t, time.Hour, jt.MaxAge())
+	// inherited
+	assert.Equal(t, uint32(50), jt.MaxRuns())
+	assert.Equal(t, 168*time.Hour, jt.ErroredMaxAge())
 }
@@ -298,6 +298,21 @@ func TestConfig_Marshal(t *testing.T) {
 		Headers:        ptr(serviceHeaders),
 		JsonWrapperKey: ptr("event"),
 	}
+	full.Alerting = toml.Alerting{
+		Enabled:               ptr(true),
+		CheckInterval:         commoncfg.MustNewDuration(30 * time.Second),
+		Cooldown:              commoncfg.MustNewDuration(2 * time.Hour),
+		JobErrorRateThreshold: ptr(0.25),
+		JobErrorRateWindow:    commoncfg.MustNewDuration(30 * time.Minute),
+		JobErrorRateMinRuns:   ptr[uint32](5),
+		Webhooks: []toml.AlertingWebhook{{
+			Name:   ptr("slack"),
+			Format: ptr("slack"),
+		}, {
+			Name:   ptr("pagerduty"),
+			Format: ptr("pagerduty"),
+		}},
+	}
 
 	full.Feature = toml.Feature{
 		FeedsManager:       ptr(true),
@@ -359,18 +374,20 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 	}
 	full.WebServer = toml.WebServer{
-		AuthenticationMethod:    ptr("local"),
-		AllowOrigins:            ptr("*"),
-		BridgeResponseURL:       mustURL("https://bridge.response"),
-		BridgeCacheTTL:          commoncfg.MustNewDuration(10 * time.Second),
-		HTTPWriteTimeout:        commoncfg.MustNewDuration(time.Minute),
-		HTTPPort:                ptr[uint16](56),
-		SecureCookies:           ptr(true),
-		SessionTimeout:          commoncfg.MustNewDuration(time.Hour),
-		SessionReaperExpiration: commoncfg.MustNewDuration(7 * 24 * time.Hour),
-		HTTPMaxSize:             ptr(utils.FileSize(uint64(32770))),
-		StartTimeout:            commoncfg.MustNewDuration(15 * time.Second),
-		ListenIP:                mustIP("192.158.1.37"),
+		AuthenticationMethod:              ptr("local"),
+		AllowOrigins:                      ptr("*"),
+		BridgeResponseURL:                 mustURL("https://bridge.response"),
+		BridgeCacheTTL:                    commoncfg.MustNewDuration(10 * time.Second),
+		BridgeCircuitBreakerThreshold:     ptr[uint32](7),
+		BridgeCircuitBreakerProbeInterval: commoncfg.MustNewDuration(time.Minute),
+		HTTPWriteTimeout:                  commoncfg.MustNewDuration(time.Minute),
+		HTTPPort:                          ptr[uint16](56),
+		SecureCookies:                     ptr(true),
+		SessionTimeout:                    commoncfg.MustNewDuration(time.Hour),
+		SessionReaperExpiration:           commoncfg.MustNewDuration(7 * 24 * time.Hour),
+		HTTPMaxSize:                       ptr(utils.FileSize(uint64(32770))),
+		StartTimeout:                      commoncfg.MustNewDuration(15 * time.Second),
+		ListenIP:                          mustIP("192.158.1.37"),
 		MFA: toml.WebServerMFA{
 			RPID:     ptr("test-rpid"),
 			RPOrigin: ptr("test-rp-origin"),
@@ -421,6 +438,20 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		Retention: toml.JobPipelineRetention{
+			Enabled:       ptr(false),
+			Interval:      commoncfg.MustNewDuration(2 * time.Hour),
+			MaxAge:        commoncfg.MustNewDuration(48 * time.Hour),
+			MaxRuns:       ptr[uint32](500),
+			ErroredMaxAge: commoncfg.MustNewDuration(30 * 24 * time.Hour),
+			ArchiveDir:    ptr("test/archive/runs"),
+			JobTypes: []toml.JobPipelineRetentionJobType{{
+				Type:          ptr("webhook"),
+				MaxAge:        commoncfg.MustNewDuration(12 * time.Hour),
+				MaxRuns:       ptr[uint32](10),
+				ErroredMaxAge: commoncfg.MustNewDuration(72 * time.Hour),
+			}},
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -571,6 +602,9 @@ func TestConfig_Marshal(t *testing.T) {
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
 					Enabled: ptr(true),
+					Funding: evmcfg.FundingConfig{
+						Enabled: ptr(false),
+					},
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
@@ -619,6 +653,11 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Mempool: evmcfg.MempoolEstimator{
+						TargetBlocks:   ptr[uint16](3),
+						Percentile:     ptr[uint16](70),
+						TxPoolInterval: &minute,
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -635,6 +674,7 @@ func TestConfig_Marshal(t *testing.T) {
 				LogPollInterval:              &minute,
 				LogKeepBlocksDepth:           ptr[uint32](100000),
 				LogPrunePageSize:             ptr[uint32](0),
+				LogCompactAfter:              &minute,
 				BackupLogPollerBlockDelay:    ptr[uint64](532),
 				MinContractPayment:           commonassets.NewLinkFromJuels(math.MaxInt64),
 				MinIncomingConfirmations:     ptr[uint32](13),
@@ -656,6 +696,10 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					UserOperations: evmcfg.UserOperationsConfig{
+						Enabled:    ptr(false),
+						EntryPoint: ptr(types.MustEIP55Address("0x0000000071727De22E5E9d8BAf0edAc6f37da032")),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -678,6 +722,8 @@ func TestConfig_Marshal(t *testing.T) {
 					EnforceRepeatableRead:      ptr(true),
 					DeathDeclarationDelay:      &minute,
 					NewHeadsPollInterval:       &zeroSeconds,
+					ReadMode:                   ptr("Single"),
+					ReadQuorum:                 ptr[uint32](2),
 					Errors: evmcfg.ClientErrors{
 						NonceTooLow:                       ptr[string]("(: |^)nonce too low"),
 						NonceTooHigh:                      ptr[string]("(: |^)nonce too high"),
@@ -695,6 +741,12 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					RateLimit: evmcfg.RPCRateLimit{
+						GetLogsPerSecond:     ptr[uint32](20),
+						CallPerSecond:        ptr[uint32](50),
+						OtherPerSecond:       ptr[uint32](100),
+						MonthlyRequestBudget: ptr[uint64](1000000),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -871,6 +923,22 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+`},
+		{"Alerting", Config{Core: toml.Core{Alerting: full.Alerting}}, `[Alerting]
+Enabled = true
+CheckInterval = '30s'
+Cooldown = '2h0m0s'
+JobErrorRateThreshold = 0.25
+JobErrorRateWindow = '30m0s'
+JobErrorRateMinRuns = 5
+
+[[Alerting.Webhooks]]
+Name = 'slack'
+Format = 'slack'
+
+[[Alerting.Webhooks]]
+Name = 'pagerduty'
+Format = 'pagerduty'
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -936,6 +1004,8 @@ AuthenticationMethod = 'local'
 AllowOrigins = '*'
 BridgeResponseURL = 'https://bridge.response'
 BridgeCacheTTL = '10s'
+BridgeCircuitBreakerThreshold = 7
+BridgeCircuitBreakerProbeInterval = '1m0s'
 HTTPWriteTimeout = '1m0s'
 HTTPPort = 56
 SecureCookies = true
@@ -998,6 +1068,20 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.Retention]
+Enabled = false
+Interval = '2h0m0s'
+MaxAge = '48h0m0s'
+MaxRuns = 500
+ErroredMaxAge = '720h0m0s'
+ArchiveDir = 'test/archive/runs'
+
+[[JobPipeline.Retention.JobTypes]]
+Type = 'webhook'
+MaxAge = '12h0m0s'
+MaxRuns = 10
+ErroredMaxAge = '72h0m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1095,6 +1179,7 @@ LogBackfillBatchSize = 17
 LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
 LogPrunePageSize = 0
+LogCompactAfter = '1m0s'
 BackupLogPollerBlockDelay = 532
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
@@ -1118,9 +1203,16 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.UserOperations]
+Enabled = false
+EntryPoint = '0x0000000071727De22E5E9d8BAf0edAc6f37da032'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
+[EVM.BalanceMonitor.Funding]
+Enabled = false
+
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
 PriceDefault = '9.223372036854775807 ether'
@@ -1159,6 +1251,11 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Mempool]
+TargetBlocks = 3
+Percentile = 70
+TxPoolInterval = '1m0s'
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1184,6 +1281,8 @@ FinalizedBlockPollInterval = '1s'
 EnforceRepeatableRead = true
 DeathDeclarationDelay = '1m0s'
 NewHeadsPollInterval = '0s'
+ReadMode = 'Single'
+ReadQuorum = 2
 
 [EVM.NodePool.Errors]
 NonceTooLow = '(: |^)nonce too low'
@@ -1202,6 +1301,12 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.RateLimit]
+GetLogsPerSecond = 20
+CallPerSecond = 50
+OtherPerSecond = 100
+MonthlyRequestBudget = 1000000
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1398,6 +1503,30 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Transactions.AutoPurge.DetectionApiUrl == nil {
 			got.EVM[c].Transactions.AutoPurge.DetectionApiUrl = new(commoncfg.URL)
 		}
+		if got.EVM[c].Transactions.UserOperations.BundlerURL == nil {
+			got.EVM[c].Transactions.UserOperations.BundlerURL = new(commoncfg.URL)
+		}
+		if got.EVM[c].Transactions.UserOperations.Account == nil {
+			got.EVM[c].Transactions.UserOperations.Account = new(types.EIP55Address)
+		}
+		if got.EVM[c].Transactions.UserOperations.PaymasterURL == nil {
+			got.EVM[c].Transactions.UserOperations.PaymasterURL = new(commoncfg.URL)
+		}
+		if got.EVM[c].BalanceMonitor.AlertThreshold == nil {
+			got.EVM[c].BalanceMonitor.AlertThreshold = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.Funding.TreasuryAddress == nil {
+			got.EVM[c].BalanceMonitor.Funding.TreasuryAddress = new(types.EIP55Address)
+		}
+		if got.EVM[c].BalanceMonitor.Funding.MinBalance == nil {
+			got.EVM[c].BalanceMonitor.Funding.MinBalance = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.Funding.TargetBalance == nil {
+			got.EVM[c].BalanceMonitor.Funding.TargetBalance = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.Funding.DailySpendCap == nil {
+			got.EVM[c].BalanceMonitor.Funding.DailySpendCap = new(assets.Wei)
+		}
 		if got.EVM[c].GasEstimator.DAOracle.OracleType == nil {
 			oracleType := evmcfg.DAOracleOPStack
 			got.EVM[c].GasEstimator.DAOracle.OracleType = &oracleType
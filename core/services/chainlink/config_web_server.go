@@ -137,6 +137,14 @@ func (w *webServerConfig) BridgeCacheTTL() time.Duration {
 	return w.c.BridgeCacheTTL.Duration()
 }
 
+func (w *webServerConfig) BridgeCircuitBreakerThreshold() uint32 {
+	return *w.c.BridgeCircuitBreakerThreshold
+}
+
+func (w *webServerConfig) BridgeCircuitBreakerProbeInterval() time.Duration {
+	return w.c.BridgeCircuitBreakerProbeInterval.Duration()
+}
+
 func (w *webServerConfig) HTTPMaxSize() int64 {
 	return int64(*w.c.HTTPMaxSize)
 }
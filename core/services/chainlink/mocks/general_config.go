@@ -36,6 +36,53 @@ func (_m *GeneralConfig) EXPECT() *GeneralConfig_Expecter {
 	return &GeneralConfig_Expecter{mock: &_m.Mock}
 }
 
+// Alerting provides a mock function with given fields:
+func (_m *GeneralConfig) Alerting() config.Alerting {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerting")
+	}
+
+	var r0 config.Alerting
+	if rf, ok := ret.Get(0).(func() config.Alerting); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Alerting)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_Alerting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alerting'
+type GeneralConfig_Alerting_Call struct {
+	*mock.Call
+}
+
+// Alerting is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Alerting() *GeneralConfig_Alerting_Call {
+	return &GeneralConfig_Alerting_Call{Call: _e.mock.On("Alerting")}
+}
+
+func (_c *GeneralConfig_Alerting_Call) Run(run func()) *GeneralConfig_Alerting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Alerting_Call) Return(_a0 config.Alerting) *GeneralConfig_Alerting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_Alerting_Call) RunAndReturn(run func() config.Alerting) *GeneralConfig_Alerting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AppID provides a mock function with given fields:
 func (_m *GeneralConfig) AppID() uuid.UUID {
 	ret := _m.Called()
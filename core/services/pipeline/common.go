@@ -76,6 +76,8 @@ type (
 	BridgeConfig interface {
 		BridgeResponseURL() *url.URL
 		BridgeCacheTTL() time.Duration
+		BridgeCircuitBreakerThreshold() uint32
+		BridgeCircuitBreakerProbeInterval() time.Duration
 	}
 )
 
@@ -317,6 +319,7 @@ const (
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
@@ -324,6 +327,7 @@ const (
 	TaskTypeMultiply         TaskType = "multiply"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
+	TaskTypeValidate         TaskType = "validate"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
@@ -373,6 +377,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeValidate:
+		task = &ValidateTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -405,6 +411,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLength:
 		task = &LengthTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLessThan:
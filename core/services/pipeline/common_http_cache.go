@@ -0,0 +1,153 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxHTTPCacheEntries bounds the memory used by the http task response cache.
+const maxHTTPCacheEntries = 1000
+
+// httpCache holds the responses of http tasks. It is shared by all the jobs
+// of the node, so that tasks fetching the same resource don't each have to
+// make a request.
+type httpCache struct {
+	mu      sync.Mutex
+	entries map[string]httpCacheEntry
+	now     func() time.Time
+}
+
+type httpCacheEntry struct {
+	body       []byte
+	storedAt   time.Time
+	freshUntil time.Time
+}
+
+func newHTTPCache() *httpCache {
+	return &httpCache{entries: make(map[string]httpCacheEntry), now: time.Now}
+}
+
+// httpCacheKey identifies a request by its method, URL, a hash of its body and
+// headers, and whether it may access local resources. Keeping restricted and
+// unrestricted requests apart ensures a restricted task can't be served a
+// response that only an unrestricted one was allowed to fetch.
+func httpCacheKey(method StringParam, url URLParam, requestData MapParam, reqHeaders []string, allowUnrestrictedNetworkAccess BoolParam) (string, error) {
+	h := sha256.New()
+	if requestData != nil {
+		body, err := json.Marshal(requestData)
+		if err != nil {
+			return "", err
+		}
+		h.Write(body)
+	}
+	for _, header := range reqHeaders {
+		h.Write([]byte{0})
+		h.Write([]byte(header))
+	}
+	access := "restricted"
+	if allowUnrestrictedNetworkAccess {
+		access = "unrestricted"
+	}
+	return access + " " + strings.ToUpper(string(method)) + " " + url.String() + " " + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fresh returns the response stored for key, if it is still fresh.
+func (c *httpCache) fresh(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.freshUntil) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// stale returns the response stored for key, if it is not older than maxAge.
+func (c *httpCache) stale(key string, maxAge time.Duration) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || c.now().Sub(entry.storedAt) > maxAge {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// store saves the response for key, fresh for at most ttl. The response is
+// not stored at all if its Cache-Control header forbids it.
+func (c *httpCache) store(key string, body []byte, headers http.Header, ttl time.Duration) {
+	ttl, ok := cacheControlTTL(headers, ttl)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= maxHTTPCacheEntries {
+		c.evict(now)
+	}
+	c.entries[key] = httpCacheEntry{body: body, storedAt: now, freshUntil: now.Add(ttl)}
+}
+
+// evict removes the responses too old to be used as a fallback, or the
+// oldest one if there are none.
+func (c *httpCache) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if now.Sub(entry.storedAt) > stalenessCap {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+	if len(c.entries) >= maxHTTPCacheEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// cacheControlTTL returns how long a response may be served from the cache,
+// which is ttl unless the Cache-Control header asks for less. ok is false when
+// the response must not be stored.
+func cacheControlTTL(headers http.Header, ttl time.Duration) (_ time.Duration, ok bool) {
+	var maxAge, sharedMaxAge *time.Duration
+	for _, value := range headers.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(name) {
+			case "no-store":
+				return 0, false
+			case "no-cache":
+				ttl = 0
+			case "max-age", "s-maxage":
+				seconds, err := strconv.ParseUint(strings.Trim(arg, `"`), 10, 32)
+				if err != nil {
+					continue
+				}
+				d := time.Duration(seconds) * time.Second
+				if strings.EqualFold(name, "s-maxage") {
+					sharedMaxAge = &d
+				} else {
+					maxAge = &d
+				}
+			}
+		}
+	}
+	// s-maxage overrides max-age for shared caches
+	if sharedMaxAge != nil {
+		maxAge = sharedMaxAge
+	}
+	if maxAge != nil && *maxAge < ttl {
+		ttl = *maxAge
+	}
+	return ttl, true
+}
@@ -0,0 +1,415 @@
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fragmentNodeType is the type of the nodes instantiating a fragment.
+const fragmentNodeType = "fragment"
+
+var fragmentHeaderRegexp = regexp.MustCompile(`\Afragment\s+([a-zA-Z_][a-zA-Z0-9_]*)\s*\(([^()]*)\)\s*\{`)
+var fragmentParamRegexp = regexp.MustCompile(`\A[a-zA-Z_][a-zA-Z0-9_]*\z`)
+
+// fragment is a reusable subgraph, defined inline in the pipeline
+// source and instantiated with parameters by nodes of type "fragment".
+//
+// e.g.
+//
+//	fragment fetch_price(url, path) {
+//		fetch [type=http method=GET url="$(url)"];
+//		parse [type=jsonparse path="$(path)"];
+//		fetch -> parse;
+//	}
+//
+//	ds1 [type=fragment fragment=fetch_price url="https://a.example/price" path="data,result"];
+//	ds2 [type=fragment fragment=fetch_price url="https://b.example/price" path="price"];
+//	median [type=median];
+//
+//	ds1 -> median;
+//	ds2 -> median;
+//
+// Each instance is replaced by a copy of the fragment's tasks, named
+// <instance>_<task> (ds1_fetch, ds1_parse, ...). References to a parameter
+// within the fragment, e.g. $(url), are replaced by the instance's argument.
+// Edges into the instance are connected to every task of the fragment without
+// inputs, and edges out of the instance, as well as variable references to it,
+// to the single task of the fragment without outputs.
+type fragment struct {
+	name   string
+	params []string
+	source string
+}
+
+// extractFragments removes the fragment definitions from the pipeline source,
+// keeping line numbers intact for the DOT parser's error messages.
+func extractFragments(text string) (string, map[string]*fragment, error) {
+	fragments := make(map[string]*fragment)
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	var brackets int
+	for i := 0; i < len(text); {
+		if end := skipDOTLiteral(text, i); end > i {
+			sb.WriteString(text[i:end])
+			i = end
+			continue
+		}
+
+		switch text[i] {
+		case '[':
+			brackets++
+		case ']':
+			brackets--
+		case 'f':
+			if brackets > 0 || !isDOTStatementStart(text, i) {
+				break
+			}
+			m := fragmentHeaderRegexp.FindStringSubmatch(text[i:])
+			if m == nil {
+				break
+			}
+			bodyStart := i + len(m[0])
+			bodyEnd, err := matchingBrace(text, bodyStart)
+			if err != nil {
+				return "", nil, errors.Wrapf(err, "fragment %s", m[1])
+			}
+			frag, err := newFragment(m[1], m[2], text[bodyStart:bodyEnd])
+			if err != nil {
+				return "", nil, err
+			}
+			if _, exists := fragments[frag.name]; exists {
+				return "", nil, errors.Errorf("fragment %s is defined more than once", frag.name)
+			}
+			fragments[frag.name] = frag
+
+			sb.WriteString(strings.Repeat("\n", strings.Count(text[i:bodyEnd+1], "\n")))
+			i = bodyEnd + 1
+			continue
+		}
+		sb.WriteByte(text[i])
+		i++
+	}
+	return sb.String(), fragments, nil
+}
+
+func newFragment(name, params, source string) (*fragment, error) {
+	frag := &fragment{name: name, source: source}
+	if strings.TrimSpace(params) == "" {
+		return frag, nil
+	}
+	seen := make(map[string]bool)
+	for _, param := range strings.Split(params, ",") {
+		param = strings.TrimSpace(param)
+		if !fragmentParamRegexp.MatchString(param) {
+			return nil, errors.Errorf("fragment %s: invalid parameter name %q", name, param)
+		}
+		if param == "type" || param == fragmentNodeType {
+			return nil, errors.Errorf("fragment %s: parameter name %s is reserved", name, param)
+		}
+		if seen[param] {
+			return nil, errors.Errorf("fragment %s: duplicate parameter %s", name, param)
+		}
+		seen[param] = true
+		frag.params = append(frag.params, param)
+	}
+	return frag, nil
+}
+
+// skipDOTLiteral returns the end of the quoted string, angle-bracketed string
+// or comment starting at i, or i if there is none.
+func skipDOTLiteral(text string, i int) int {
+	switch {
+	case text[i] == '"':
+		for j := i + 1; j < len(text); j++ {
+			switch text[j] {
+			case '\\':
+				j++
+			case '"':
+				return j + 1
+			}
+		}
+		return len(text)
+	case text[i] == '<':
+		depth := 0
+		for j := i; j < len(text); j++ {
+			switch text[j] {
+			case '<':
+				depth++
+			case '>':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+		return len(text)
+	case strings.HasPrefix(text[i:], "//"), text[i] == '#' && isLineStart(text, i):
+		if end := strings.IndexByte(text[i:], '\n'); end >= 0 {
+			return i + end
+		}
+		return len(text)
+	case strings.HasPrefix(text[i:], "/*"):
+		if end := strings.Index(text[i+2:], "*/"); end >= 0 {
+			return i + 2 + end + 2
+		}
+		return len(text)
+	}
+	return i
+}
+
+func isLineStart(text string, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		switch text[j] {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isDOTStatementStart(text string, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		switch text[j] {
+		case ' ', '\t', '\r', '\n':
+		case ';', '}':
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// matchingBrace returns the index of the brace closing the block starting at i.
+func matchingBrace(text string, i int) (int, error) {
+	depth := 1
+	for i < len(text) {
+		if end := skipDOTLiteral(text, i); end > i {
+			i = end
+			continue
+		}
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+		i++
+	}
+	return 0, errors.New("missing closing brace")
+}
+
+// expandFragments replaces every fragment instance of the graph with a copy of
+// the fragment's tasks.
+func (g *Graph) expandFragments(fragments map[string]*fragment) error {
+	var instances []*GraphNode
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if node.attrs["type"] == fragmentNodeType {
+			instances = append(instances, node)
+		}
+	}
+	if len(instances) == 0 {
+		return nil
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].ID() < instances[j].ID()
+	})
+
+	dotIDs := make(map[string]bool)
+	for nodes := g.Nodes(); nodes.Next(); {
+		dotIDs[nodes.Node().(*GraphNode).dotID] = true
+	}
+
+	bodies := make(map[string]*Graph)
+	outputs := make(map[string]string)
+	for _, instance := range instances {
+		name := instance.attrs[fragmentNodeType]
+		frag, exists := fragments[name]
+		if !exists {
+			return errors.Errorf("%s: unknown fragment %q", instance.dotID, name)
+		}
+		body, exists := bodies[name]
+		if !exists {
+			var err error
+			if body, err = parseFragmentBody(frag); err != nil {
+				return err
+			}
+			bodies[name] = body
+		}
+		outputs[instance.dotID] = instance.dotID + "_" + fragmentOutput(body).dotID
+	}
+
+	// References to an instance now point to the output of its fragment
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		for key, value := range node.attrs {
+			node.attrs[key] = renameVariables(value, outputs)
+		}
+	}
+
+	for _, instance := range instances {
+		if err := g.expandFragment(instance, fragments[instance.attrs[fragmentNodeType]], bodies[instance.attrs[fragmentNodeType]], dotIDs); err != nil {
+			return errors.Wrapf(err, "%s", instance.dotID)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) expandFragment(instance *GraphNode, frag *fragment, body *Graph, dotIDs map[string]bool) error {
+	args := make(map[string]string)
+	for key, value := range instance.attrs {
+		if key == "type" || key == fragmentNodeType {
+			continue
+		}
+		args[key] = value
+	}
+	for _, param := range frag.params {
+		if _, exists := args[param]; !exists {
+			return errors.Errorf("missing argument %s for fragment %s", param, frag.name)
+		}
+	}
+	if len(args) != len(frag.params) {
+		var unknown []string
+		for key := range args {
+			if !frag.hasParam(key) {
+				unknown = append(unknown, key)
+			}
+		}
+		sort.Strings(unknown)
+		return errors.Errorf("fragment %s has no parameter %s", frag.name, strings.Join(unknown, ", "))
+	}
+
+	renames := make(map[string]string)
+	for nodes := body.Nodes(); nodes.Next(); {
+		local := nodes.Node().(*GraphNode).dotID
+		renames[local] = instance.dotID + "_" + local
+	}
+
+	copies := make(map[int64]*GraphNode)
+	for nodes := body.Nodes(); nodes.Next(); {
+		local := nodes.Node().(*GraphNode)
+		node := g.NewNode().(*GraphNode)
+		node.dotID = renames[local.dotID]
+		if dotIDs[node.dotID] {
+			return errors.Errorf("task %s of fragment %s conflicts with an existing task", node.dotID, frag.name)
+		}
+		dotIDs[node.dotID] = true
+		node.attrs = make(map[string]string, len(local.attrs))
+		for key, value := range local.attrs {
+			node.attrs[key] = expandFragmentVariables(value, args, renames)
+		}
+		node.fragment = frag.name
+		node.fragmentInstance = instance.dotID
+		g.AddNode(node)
+		copies[local.ID()] = node
+	}
+
+	for edges := body.Edges(); edges.Next(); {
+		edge := edges.Edge().(*GraphEdge)
+		// Implicit edges are added back once all the fragments are expanded
+		if !edge.IsImplicit() {
+			g.SetEdge(g.NewEdge(copies[edge.From().ID()], copies[edge.To().ID()]))
+		}
+	}
+
+	for inputs := g.To(instance.ID()); inputs.Next(); {
+		from := inputs.Node()
+		for nodes := body.Nodes(); nodes.Next(); {
+			if local := nodes.Node(); body.To(local.ID()).Len() == 0 {
+				g.SetEdge(g.NewEdge(from, copies[local.ID()]))
+			}
+		}
+	}
+
+	output := copies[fragmentOutput(body).ID()]
+	for outputs := g.From(instance.ID()); outputs.Next(); {
+		g.SetEdge(g.NewEdge(output, outputs.Node()))
+	}
+
+	g.RemoveNode(instance.ID())
+	return nil
+}
+
+func (f *fragment) hasParam(param string) bool {
+	for _, p := range f.params {
+		if p == param {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFragmentBody(frag *fragment) (*Graph, error) {
+	body := NewGraph()
+	if err := body.unmarshalDOT([]byte(frag.source)); err != nil {
+		return nil, errors.Wrapf(err, "fragment %s", frag.name)
+	}
+	if body.Nodes().Len() == 0 {
+		return nil, errors.Errorf("fragment %s has no tasks", frag.name)
+	}
+	body.AddImplicitDependenciesAsEdges()
+
+	var outputs int
+	for nodes := body.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if node.attrs["type"] == fragmentNodeType {
+			return nil, errors.Errorf("fragment %s: fragments cannot be nested", frag.name)
+		}
+		if body.From(node.ID()).Len() == 0 {
+			outputs++
+		}
+	}
+	if outputs != 1 {
+		return nil, errors.Errorf("fragment %s must have exactly one task without outputs, found %d", frag.name, outputs)
+	}
+	return body, nil
+}
+
+// fragmentOutput returns the single task of a validated fragment body without outputs.
+func fragmentOutput(body *Graph) *GraphNode {
+	for nodes := body.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if body.From(node.ID()).Len() == 0 {
+			return node
+		}
+	}
+	panic("unreachable")
+}
+
+// renameVariables replaces the first segment of the variable references found
+// in value, according to renames.
+func renameVariables(value string, renames map[string]string) string {
+	return expandFragmentVariables(value, nil, renames)
+}
+
+// expandFragmentVariables replaces references to the fragment's parameters by
+// their arguments, and renames references to the fragment's tasks.
+func expandFragmentVariables(value string, args map[string]string, renames map[string]string) string {
+	return variableRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		expr := strings.TrimSpace(match[2 : len(match)-1])
+		if arg, exists := args[expr]; exists {
+			return arg
+		}
+		head, tail, _ := strings.Cut(expr, KeypathSeparator)
+		renamed, exists := renames[head]
+		if !exists {
+			return match
+		}
+		if tail != "" {
+			renamed += KeypathSeparator + tail
+		}
+		return fmt.Sprintf("$(%s)", renamed)
+	})
+}
@@ -0,0 +1,134 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const fetchPriceFragment = `
+fragment fetch_price(url, path) {
+	// comments and literals may contain braces }
+	fetch    [type=http method=POST url="$(url)" requestData=<{"asset": "$(jobRun.asset)"}>];
+	parse    [type=jsonparse path="$(path)" data="$(fetch)"];
+	multiply [type=multiply times=100];
+	fetch -> parse -> multiply;
+}
+`
+
+func TestParse_Fragments(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(fetchPriceFragment + `
+ds1    [type=fragment fragment=fetch_price url="https://a.example/price" path="data,result"];
+ds2    [type=fragment fragment=fetch_price url="https://b.example/price" path="price"];
+median [type=median values=<[ $(ds1), $(ds2.foo) ]>];
+
+ds1 -> median;
+ds2 -> median;
+`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 7)
+	assert.Nil(t, p.ByDotID("ds1"))
+	assert.Nil(t, p.ByDotID("ds2"))
+
+	for _, instance := range []string{"ds1", "ds2"} {
+		fetch, ok := p.ByDotID(instance + "_fetch").(*pipeline.HTTPTask)
+		require.True(t, ok)
+		parse, ok := p.ByDotID(instance + "_parse").(*pipeline.JSONParseTask)
+		require.True(t, ok)
+		multiply, ok := p.ByDotID(instance + "_multiply").(*pipeline.MultiplyTask)
+		require.True(t, ok)
+
+		assert.Equal(t, "https://"+map[string]string{"ds1": "a", "ds2": "b"}[instance]+".example/price", fetch.URL)
+		assert.Equal(t, `{"asset": "$(jobRun.asset)"}`, fetch.RequestData)
+		assert.Equal(t, "$("+instance+"_fetch)", parse.Data)
+		assert.Equal(t, "100", multiply.Times)
+
+		require.Len(t, fetch.Outputs(), 1)
+		assert.Equal(t, parse, fetch.Outputs()[0])
+		require.Len(t, multiply.Outputs(), 1)
+		assert.Equal(t, "median", multiply.Outputs()[0].DotID())
+
+		for _, task := range []pipeline.Task{fetch, parse, multiply} {
+			fragment, fragmentInstance := task.Base().Fragment()
+			assert.Equal(t, "fetch_price", fragment)
+			assert.Equal(t, instance, fragmentInstance)
+		}
+	}
+	assert.Equal(t, "data,result", p.ByDotID("ds1_parse").(*pipeline.JSONParseTask).Path)
+	assert.Equal(t, "price", p.ByDotID("ds2_parse").(*pipeline.JSONParseTask).Path)
+
+	median := p.ByDotID("median").(*pipeline.MedianTask)
+	assert.Equal(t, "[ $(ds1_multiply), $(ds2_multiply.foo) ]", median.Values)
+	fragment, fragmentInstance := median.Base().Fragment()
+	assert.Empty(t, fragment)
+	assert.Empty(t, fragmentInstance)
+}
+
+func TestParse_FragmentInputs(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+fragment scale(factor) {
+	a [type=multiply times="$(factor)"];
+	b [type=multiply times="$(factor)"];
+	sum [type=sum values=<[ $(a), $(b) ]>];
+}
+
+input_value [type=memo value=2];
+scaled [type=fragment fragment=scale factor=10];
+input_value -> scaled;
+`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 4)
+
+	input := p.ByDotID("input_value")
+	require.Len(t, input.Outputs(), 2)
+	assert.ElementsMatch(t, []string{"scaled_a", "scaled_b"}, []string{input.Outputs()[0].DotID(), input.Outputs()[1].DotID()})
+
+	sum := p.ByDotID("scaled_sum")
+	require.Len(t, sum.Inputs(), 2)
+	for _, dep := range sum.Inputs() {
+		// Edges to the fragment's output from variable references are implicit
+		assert.False(t, dep.PropagateResult)
+	}
+}
+
+func TestParse_FragmentErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		pipeline string
+		err      string
+	}{
+		{"unknown fragment", `ds1 [type=fragment fragment=nope];`, `unknown fragment "nope"`},
+		{"missing argument", fetchPriceFragment + `ds1 [type=fragment fragment=fetch_price url="https://a.example"];`, "missing argument path"},
+		{"unknown argument", fetchPriceFragment + `ds1 [type=fragment fragment=fetch_price url="x" path="y" method=GET];`, "has no parameter method"},
+		{"duplicate fragment", fetchPriceFragment + fetchPriceFragment, "defined more than once"},
+		{"duplicate parameter", `fragment f(a, a) { x [type=memo value=1]; }`, "duplicate parameter a"},
+		{"reserved parameter", `fragment f(type) { x [type=memo value=1]; }`, "reserved"},
+		{"unclosed", `fragment f(a) { x [type=memo value=1];`, "missing closing brace"},
+		{"no tasks", `fragment f() {}
+ds1 [type=fragment fragment=f];`, "has no tasks"},
+		{"several outputs", `fragment f() { x [type=memo value=1]; y [type=memo value=2]; }
+ds1 [type=fragment fragment=f];`, "exactly one task without outputs, found 2"},
+		{"nested", `fragment f() { x [type=fragment fragment=g]; }
+ds1 [type=fragment fragment=f];`, "cannot be nested"},
+		{"conflicting task", fetchPriceFragment + `ds1_parse [type=memo value=1];
+ds1 [type=fragment fragment=fetch_price url="x" path="y"];`, "conflicts with an existing task"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pipeline.Parse(tt.pipeline)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
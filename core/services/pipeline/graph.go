@@ -34,6 +34,16 @@ func (g *Graph) NewEdge(from, to graph.Node) graph.Edge {
 }
 
 func (g *Graph) UnmarshalText(bs []byte) (err error) {
+	if err = g.unmarshalDOT(bs); err != nil {
+		return err
+	}
+	g.AddImplicitDependenciesAsEdges()
+	return nil
+}
+
+// unmarshalDOT adds the nodes and edges of the DOT source to the graph,
+// without looking for implicit dependencies.
+func (g *Graph) unmarshalDOT(bs []byte) (err error) {
 	if g.DirectedGraph == nil {
 		g.DirectedGraph = simple.NewDirectedGraph()
 	}
@@ -48,7 +58,6 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
 	}
-	g.AddImplicitDependenciesAsEdges()
 	return nil
 }
 
@@ -115,6 +124,10 @@ type GraphNode struct {
 	graph.Node
 	dotID string
 	attrs map[string]string
+
+	// Set when the node was expanded from a pipeline fragment
+	fragment         string
+	fragmentInstance string
 }
 
 func (n *GraphNode) DOTID() string {
@@ -212,17 +225,31 @@ func Parse(text string) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
 	}
-	g := NewGraph()
-	err := g.UnmarshalText([]byte(text))
-
+	source, fragments, err := extractFragments(text)
 	if err != nil {
 		return nil, err
 	}
 
+	g := NewGraph()
+	if err = g.unmarshalDOT([]byte(source)); err != nil {
+		return nil, err
+	}
+	if err = g.expandFragments(fragments); err != nil {
+		return nil, err
+	}
+	g.AddImplicitDependenciesAsEdges()
+
+	return newPipeline(g, text, fragments)
+}
+
+// newPipeline creates the tasks of the graph, with their inputs and outputs
+// linked. Fragments are only used by map tasks, the graph must already be
+// expanded.
+func newPipeline(g *Graph, source string, fragments map[string]*fragment) (*Pipeline, error) {
 	p := &Pipeline{
 		tree:   g,
 		Tasks:  make([]Task, 0, g.Nodes().Len()),
-		Source: text,
+		Source: source,
 	}
 
 	// toposort all the nodes: dependencies ordered before outputs. This also does cycle checking for us.
@@ -252,6 +279,14 @@ func Parse(text string) (*Pipeline, error) {
 		if err != nil {
 			return nil, err
 		}
+		task.Base().fragment = node.fragment
+		task.Base().fragmentInstance = node.fragmentInstance
+
+		if mapTask, is := task.(*MapTask); is {
+			if err = mapTask.setFragment(fragments); err != nil {
+				return nil, err
+			}
+		}
 
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
@@ -43,6 +43,11 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.uuid = id
 	t.httpClient = httpClient
 	t.specId = specId
+	t.circuitBreakers = bridges.NewCircuitBreakers(bridgeConfig.BridgeCircuitBreakerThreshold(), bridgeConfig.BridgeCircuitBreakerProbeInterval())
+}
+
+func (t *BridgeTask) HelperSetCircuitBreakers(circuitBreakers *bridges.CircuitBreakers) {
+	t.circuitBreakers = circuitBreakers
 }
 
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
@@ -51,6 +56,14 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+// HelperSetHTTPCache makes the tasks share a new response cache.
+func HelperSetHTTPCache(tasks ...*HTTPTask) {
+	cache := newHTTPCache()
+	for _, t := range tasks {
+		t.cache = cache
+	}
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
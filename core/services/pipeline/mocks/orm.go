@@ -274,6 +274,63 @@ func (_c *ORM_DeleteRun_Call) RunAndReturn(run func(context.Context, int64) erro
 	return _c
 }
 
+// DeleteRunsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ORM) DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRunsByIDs")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteRunsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRunsByIDs'
+type ORM_DeleteRunsByIDs_Call struct {
+	*mock.Call
+}
+
+// DeleteRunsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *ORM_Expecter) DeleteRunsByIDs(ctx interface{}, ids interface{}) *ORM_DeleteRunsByIDs_Call {
+	return &ORM_DeleteRunsByIDs_Call{Call: _e.mock.On("DeleteRunsByIDs", ctx, ids)}
+}
+
+func (_c *ORM_DeleteRunsByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *ORM_DeleteRunsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteRunsByIDs_Call) Return(_a0 int64, _a1 error) *ORM_DeleteRunsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteRunsByIDs_Call) RunAndReturn(run func(context.Context, []int64) (int64, error)) *ORM_DeleteRunsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
 func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
 	ret := _m.Called(_a0, _a1)
@@ -378,6 +435,125 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// FindRunIDsToReap provides a mock function with given fields: ctx, policy, limit
+func (_m *ORM) FindRunIDsToReap(ctx context.Context, policy pipeline.RetentionPolicy, limit uint) ([]int64, error) {
+	ret := _m.Called(ctx, policy, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRunIDsToReap")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RetentionPolicy, uint) ([]int64, error)); ok {
+		return rf(ctx, policy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RetentionPolicy, uint) []int64); ok {
+		r0 = rf(ctx, policy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RetentionPolicy, uint) error); ok {
+		r1 = rf(ctx, policy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindRunIDsToReap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRunIDsToReap'
+type ORM_FindRunIDsToReap_Call struct {
+	*mock.Call
+}
+
+// FindRunIDsToReap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy pipeline.RetentionPolicy
+//   - limit uint
+func (_e *ORM_Expecter) FindRunIDsToReap(ctx interface{}, policy interface{}, limit interface{}) *ORM_FindRunIDsToReap_Call {
+	return &ORM_FindRunIDsToReap_Call{Call: _e.mock.On("FindRunIDsToReap", ctx, policy, limit)}
+}
+
+func (_c *ORM_FindRunIDsToReap_Call) Run(run func(ctx context.Context, policy pipeline.RetentionPolicy, limit uint)) *ORM_FindRunIDsToReap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.RetentionPolicy), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *ORM_FindRunIDsToReap_Call) Return(_a0 []int64, _a1 error) *ORM_FindRunIDsToReap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindRunIDsToReap_Call) RunAndReturn(run func(context.Context, pipeline.RetentionPolicy, uint) ([]int64, error)) *ORM_FindRunIDsToReap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRunsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ORM) FindRunsByIDs(ctx context.Context, ids []int64) ([]pipeline.Run, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRunsByIDs")
+	}
+
+	var r0 []pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]pipeline.Run, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []pipeline.Run); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindRunsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRunsByIDs'
+type ORM_FindRunsByIDs_Call struct {
+	*mock.Call
+}
+
+// FindRunsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *ORM_Expecter) FindRunsByIDs(ctx interface{}, ids interface{}) *ORM_FindRunsByIDs_Call {
+	return &ORM_FindRunsByIDs_Call{Call: _e.mock.On("FindRunsByIDs", ctx, ids)}
+}
+
+func (_c *ORM_FindRunsByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *ORM_FindRunsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *ORM_FindRunsByIDs_Call) Return(_a0 []pipeline.Run, _a1 error) *ORM_FindRunsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindRunsByIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]pipeline.Run, error)) *ORM_FindRunsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	context "context"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,6 +28,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeCircuitBreakers provides a mock function with given fields:
+func (_m *Runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuitBreakers")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeCircuitBreakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuitBreakers'
+type Runner_BridgeCircuitBreakers_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuitBreakers is a helper method to define mock.On call
+func (_e *Runner_Expecter) BridgeCircuitBreakers() *Runner_BridgeCircuitBreakers_Call {
+	return &Runner_BridgeCircuitBreakers_Call{Call: _e.mock.On("BridgeCircuitBreakers")}
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) Run(run func()) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) Return(_a0 *bridges.CircuitBreakers) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeCircuitBreakers_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Runner_BridgeCircuitBreakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -275,6 +275,10 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// Fragment and FragmentInstance are set for tasks expanded from a
+	// pipeline fragment
+	Fragment         null.String `json:"fragment"`
+	FragmentInstance null.String `json:"fragmentInstance"`
 
 	// Used internally for sorting completed results
 	task Task
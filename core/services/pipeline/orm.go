@@ -91,6 +91,11 @@ type ORM interface {
 	InsertFinishedRuns(ctx context.Context, run []*Run, saveSuccessfulTaskRuns bool) (err error)
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
+	// FindRunIDsToReap returns the IDs of up to limit finished runs which
+	// have to be deleted according to policy, oldest first.
+	FindRunIDsToReap(ctx context.Context, policy RetentionPolicy, limit uint) ([]int64, error)
+	DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error)
+	FindRunsByIDs(ctx context.Context, ids []int64) ([]Run, error)
 	FindRun(ctx context.Context, id int64) (Run, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
@@ -212,8 +217,8 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, fragment, fragment_instance, created_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :fragment, :fragment_instance, :created_at);`
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -292,8 +297,8 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, fragment, fragment_instance, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :fragment, :fragment_instance, :created_at, :finished_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
 		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
 		RETURNING *;
@@ -399,8 +404,8 @@ RETURNING id
 		}()
 
 		pipelineTaskRunsQuery := `
-INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
+INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, fragment, fragment_instance, created_at, finished_at)
+VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :fragment, :fragment_instance, :created_at, :finished_at);
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
@@ -505,8 +510,8 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 
 	defer o.prune(ctx, o.ds, run.PruningKey)
 	sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, fragment, fragment_instance, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :fragment, :fragment_instance, :created_at, :finished_at);`
 	_, err = o.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
@@ -566,6 +571,98 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 	return nil
 }
 
+func (o *orm) FindRunIDsToReap(ctx context.Context, policy RetentionPolicy, limit uint) (ids []int64, err error) {
+	var threshold, erroredThreshold *time.Time
+	now := time.Now()
+	if policy.MaxAge > 0 {
+		t := now.Add(-policy.MaxAge)
+		threshold = &t
+	}
+	if policy.ErroredMaxAge > 0 {
+		t := now.Add(-policy.ErroredMaxAge)
+		erroredThreshold = &t
+	}
+	if policy.JobTypes == nil {
+		policy.JobTypes = []string{}
+	}
+	// Both branches are bounded: the first only reads the runs finished before
+	// the cutoffs, and the second finds the oldest run to keep of each job with
+	// an index scan of at most MaxRuns rows, before reading the runs up to it.
+	// Errored runs are counted separately, so that they are not pushed out by
+	// the completed ones.
+	err = o.ds.SelectContext(ctx, &ids, `
+WITH expired AS (
+	SELECT pr.id
+	FROM pipeline_runs pr
+	LEFT JOIN job_pipeline_specs jps ON jps.pipeline_spec_id = pr.pipeline_spec_id
+	LEFT JOIN jobs ON jobs.id = jps.job_id
+	WHERE ((pr.state != $1 AND pr.finished_at < $4) OR (pr.state = $1 AND pr.finished_at < $5))
+	AND (coalesce(jobs.type, '') = ANY($2)) != $3
+	ORDER BY pr.id ASC
+	LIMIT $7
+), job_specs AS (
+	SELECT jps.job_id, array_agg(jps.pipeline_spec_id) AS spec_ids
+	FROM job_pipeline_specs jps
+	LEFT JOIN jobs ON jobs.id = jps.job_id
+	WHERE $6 > 0
+	AND (coalesce(jobs.type, '') = ANY($2)) != $3
+	GROUP BY jps.job_id
+), cursors AS (
+	SELECT js.spec_ids, s.errored, (
+		SELECT pr.id FROM pipeline_runs pr
+		WHERE pr.pipeline_spec_id = ANY(js.spec_ids)
+		AND pr.finished_at IS NOT NULL
+		AND (pr.state = $1) = s.errored
+		ORDER BY pr.id DESC
+		OFFSET $6 LIMIT 1
+	) AS max_id
+	FROM job_specs js
+	CROSS JOIN (VALUES (false), (true)) AS s(errored)
+), excess AS (
+	SELECT r.id
+	FROM cursors c
+	CROSS JOIN LATERAL (
+		SELECT pr.id FROM pipeline_runs pr
+		WHERE pr.pipeline_spec_id = ANY(c.spec_ids)
+		AND pr.finished_at IS NOT NULL
+		AND (pr.state = $1) = c.errored
+		AND pr.id <= c.max_id
+		ORDER BY pr.id ASC
+		LIMIT $7
+	) r
+	WHERE c.max_id IS NOT NULL
+)
+SELECT id FROM expired
+UNION
+SELECT id FROM excess
+ORDER BY id ASC
+LIMIT $7`, RunStatusErrored, policy.JobTypes, policy.ExcludeJobTypes, threshold, erroredThreshold, policy.MaxRuns, limit)
+	return ids, errors.Wrap(err, "FindRunIDsToReap failed")
+}
+
+func (o *orm) DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return 0, errors.Wrap(err, "DeleteRunsByIDs failed")
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) FindRunsByIDs(ctx context.Context, ids []int64) (runs []Run, err error) {
+	var runsPtrs []*Run
+	err = o.transact(ctx, func(tx *orm) error {
+		if err = tx.ds.SelectContext(ctx, &runsPtrs, `SELECT * FROM pipeline_runs WHERE id = ANY($1) ORDER BY id ASC`, ids); err != nil {
+			return errors.Wrap(err, "failed to load runs")
+		}
+		return loadAssociations(ctx, tx.ds, runsPtrs)
+	})
+	runs = make([]Run, len(runsPtrs))
+	for i, runPtr := range runsPtrs {
+		runs[i] = *runPtr
+	}
+	return runs, err
+}
+
 func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	var runs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
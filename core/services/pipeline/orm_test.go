@@ -914,3 +914,70 @@ func Test_Prune(t *testing.T) {
 	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2", ps2.ID, pipeline.RunStatusSuspended)
 	assert.Equal(t, 3, cnt)
 }
+
+func Test_PipelineORM_FindRunIDsToReap(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	porm := pipeline.NewORM(db, logger.TestLogger(t), 0)
+	torm := newTestORM(porm, db)
+
+	ps := cltest.MustInsertPipelineSpec(t, db)
+	require.NoError(t, torm.AddJobPipelineSpecWithoutConstraints(ctx, ps.ID, ps.ID))
+	_, err := db.Exec(`SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	require.NoError(t, err)
+
+	var completed, errored []int64
+	for i := 0; i < 5; i++ {
+		completed = append(completed, cltest.MustInsertPipelineRunWithStatus(t, db, ps.ID, pipeline.RunStatusCompleted, ps.ID).ID)
+	}
+	for i := 0; i < 3; i++ {
+		errored = append(errored, cltest.MustInsertPipelineRunWithStatus(t, db, ps.ID, pipeline.RunStatusErrored, ps.ID).ID)
+	}
+	for i := 0; i < 2; i++ {
+		cltest.MustInsertPipelineRunWithStatus(t, db, ps.ID, pipeline.RunStatusRunning, ps.ID)
+	}
+	_, err = db.Exec(`UPDATE pipeline_runs SET finished_at = NOW() - interval '2 hours' WHERE id = ANY($1)`, append(completed[:2:2], errored[0]))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name   string
+		policy pipeline.RetentionPolicy
+		exp    []int64
+	}{
+		{"no limits", pipeline.RetentionPolicy{ExcludeJobTypes: true}, nil},
+		{"max age", pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxAge: time.Hour}, completed[:2]},
+		{"errored max age", pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxAge: time.Hour, ErroredMaxAge: time.Hour}, append(completed[:2:2], errored[0])},
+		{"max runs", pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxRuns: 3}, completed[:2]},
+		{"max runs for errored", pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxRuns: 2}, append(completed[:3:3], errored[0])},
+		{"other job type", pipeline.RetentionPolicy{JobTypes: []string{"webhook"}, MaxRuns: 1}, nil},
+		{"excluded job type", pipeline.RetentionPolicy{JobTypes: []string{""}, ExcludeJobTypes: true, MaxRuns: 1}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := porm.FindRunIDsToReap(ctx, tt.policy, 100)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, ids)
+		})
+	}
+
+	t.Run("limit", func(t *testing.T) {
+		ids, err := porm.FindRunIDsToReap(ctx, pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxRuns: 1}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, completed[:2], ids)
+	})
+
+	t.Run("find and delete", func(t *testing.T) {
+		runs, err := porm.FindRunsByIDs(ctx, completed[:2])
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, completed[0], runs[0].ID)
+		assert.Equal(t, ps.ID, runs[0].PipelineSpec.ID)
+
+		n, err := porm.DeleteRunsByIDs(ctx, completed[:2])
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+		cnt := pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1", ps.ID)
+		assert.Equal(t, 8, cnt)
+	})
+}
@@ -0,0 +1,252 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// retentionBatchSize is the number of runs archived and deleted at once.
+const retentionBatchSize = 1000
+
+var (
+	promPipelineRunsReaped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_reaped_total",
+		Help: "Number of pipeline runs deleted by the retention reaper",
+	},
+		[]string{"policy"},
+	)
+	promPipelineRunsArchived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_archived_total",
+		Help: "Number of pipeline runs archived by the retention reaper before being deleted",
+	},
+		[]string{"policy"},
+	)
+)
+
+// RetentionPolicy selects the finished runs to delete. Runs are kept for
+// MaxAge, and at most MaxRuns are kept per job. Errored runs are counted
+// separately from the completed ones, and kept for ErroredMaxAge instead.
+// Zero values disable the corresponding limit.
+type RetentionPolicy struct {
+	// JobTypes the policy applies to, or every other job type when
+	// ExcludeJobTypes is true.
+	JobTypes        []string
+	ExcludeJobTypes bool
+
+	MaxAge        time.Duration
+	MaxRuns       uint32
+	ErroredMaxAge time.Duration
+}
+
+func (p RetentionPolicy) name() string {
+	if p.ExcludeJobTypes {
+		return "default"
+	}
+	return strings.Join(p.JobTypes, ",")
+}
+
+// RetentionPolicies returns the policy of each job type configured, followed
+// by the default policy for all the other job types.
+func RetentionPolicies(cfg config.JobPipelineRetention) []RetentionPolicy {
+	var policies []RetentionPolicy
+	var configured []string
+	for _, jt := range cfg.JobTypes() {
+		configured = append(configured, jt.Type())
+		policies = append(policies, RetentionPolicy{
+			JobTypes:      []string{jt.Type()},
+			MaxAge:        jt.MaxAge(),
+			MaxRuns:       jt.MaxRuns(),
+			ErroredMaxAge: jt.ErroredMaxAge(),
+		})
+	}
+	return append(policies, RetentionPolicy{
+		JobTypes:        configured,
+		ExcludeJobTypes: true,
+		MaxAge:          cfg.MaxAge(),
+		MaxRuns:         cfg.MaxRuns(),
+		ErroredMaxAge:   cfg.ErroredMaxAge(),
+	})
+}
+
+// RetentionReaper periodically deletes the finished runs which are past the
+// retention configured for their job type. When an archive directory is
+// configured, the runs are first written to a gzipped NDJSON file there.
+// It supersedes the ReaperInterval/ReaperThreshold reaper of the runner.
+type RetentionReaper struct {
+	services.Service
+	eng *services.Engine
+
+	orm        ORM
+	interval   time.Duration
+	archiveDir string
+	policies   []RetentionPolicy
+}
+
+func NewRetentionReaper(orm ORM, cfg config.JobPipelineRetention, lggr logger.Logger) *RetentionReaper {
+	r := &RetentionReaper{
+		orm:        orm,
+		interval:   cfg.Interval(),
+		archiveDir: cfg.ArchiveDir(),
+		policies:   RetentionPolicies(cfg),
+	}
+	r.Service, r.eng = services.Config{
+		Name:  "PipelineRetentionReaper",
+		Start: r.start,
+	}.NewServiceEngine(lggr)
+	return r
+}
+
+func (r *RetentionReaper) start(_ context.Context) error {
+	if r.archiveDir != "" {
+		if err := os.MkdirAll(r.archiveDir, 0700); err != nil {
+			return errors.Wrap(err, "failed to create pipeline run archive directory")
+		}
+	}
+	ticker := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(r.interval)
+	r.eng.GoTick(ticker, r.reap)
+	return nil
+}
+
+func (r *RetentionReaper) reap(ctx context.Context) {
+	start := time.Now()
+	for _, policy := range r.policies {
+		n, err := r.ReapRuns(ctx, policy)
+		if err != nil {
+			r.eng.Errorw("Failed to reap pipeline runs", "policy", policy.name(), "err", err)
+			continue
+		}
+		if n > 0 {
+			r.eng.Debugw("Reaped pipeline runs", "policy", policy.name(), "count", n)
+		}
+	}
+	r.eng.Debugw("Pipeline run retention completed", "duration", time.Since(start))
+}
+
+// ReapRuns archives and deletes the runs selected by policy, and returns how
+// many were deleted.
+func (r *RetentionReaper) ReapRuns(ctx context.Context, policy RetentionPolicy) (int64, error) {
+	var deleted int64
+	for {
+		ids, err := r.orm.FindRunIDsToReap(ctx, policy, retentionBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		if r.archiveDir != "" {
+			if err = r.archive(ctx, ids); err != nil {
+				return deleted, err
+			}
+			promPipelineRunsArchived.WithLabelValues(policy.name()).Add(float64(len(ids)))
+		}
+
+		n, err := r.orm.DeleteRunsByIDs(ctx, ids)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+		promPipelineRunsReaped.WithLabelValues(policy.name()).Add(float64(n))
+
+		if len(ids) < retentionBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// archive writes the runs to a new file in the archive directory. The file is
+// synced to disk before returning, so that the runs can safely be deleted.
+func (r *RetentionReaper) archive(ctx context.Context, ids []int64) error {
+	runs, err := r.orm.FindRunsByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(r.archiveDir, fmt.Sprintf("pipeline_runs_%s_%d.ndjson.gz", time.Now().UTC().Format("20060102T150405Z"), ids[0]))
+	return WriteRunArchive(name, runs)
+}
+
+// ArchivedRun is the representation of a run in an archive file.
+type ArchivedRun struct {
+	ID           int64                             `json:"id"`
+	JobID        int32                             `json:"jobID"`
+	JobName      string                            `json:"jobName"`
+	JobType      string                            `json:"jobType"`
+	State        RunStatus                         `json:"state"`
+	Meta         jsonserializable.JSONSerializable `json:"meta"`
+	AllErrors    RunErrors                         `json:"allErrors"`
+	FatalErrors  RunErrors                         `json:"fatalErrors"`
+	Inputs       jsonserializable.JSONSerializable `json:"inputs"`
+	Outputs      jsonserializable.JSONSerializable `json:"outputs"`
+	CreatedAt    time.Time                         `json:"createdAt"`
+	FinishedAt   null.Time                         `json:"finishedAt"`
+	DotDagSource string                            `json:"dotDagSource"`
+	TaskRuns     []TaskRun                         `json:"taskRuns"`
+}
+
+func newArchivedRun(run Run) ArchivedRun {
+	return ArchivedRun{
+		ID:           run.ID,
+		JobID:        run.PipelineSpec.JobID,
+		JobName:      run.PipelineSpec.JobName,
+		JobType:      run.PipelineSpec.JobType,
+		State:        run.State,
+		Meta:         run.Meta,
+		AllErrors:    run.AllErrors,
+		FatalErrors:  run.FatalErrors,
+		Inputs:       run.Inputs,
+		Outputs:      run.Outputs,
+		CreatedAt:    run.CreatedAt,
+		FinishedAt:   run.FinishedAt,
+		DotDagSource: run.PipelineSpec.DotDagSource,
+		TaskRuns:     run.PipelineTaskRuns,
+	}
+}
+
+// WriteRunArchive writes runs to a new gzipped file at name, one JSON encoded
+// ArchivedRun per line.
+func WriteRunArchive(name string, runs []Run) (err error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create pipeline run archive")
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = errors.Wrap(cerr, "failed to close pipeline run archive")
+		}
+		if err != nil {
+			_ = os.Remove(name)
+		}
+	}()
+
+	zw := gzip.NewWriter(f)
+	enc := json.NewEncoder(zw)
+	for _, run := range runs {
+		if err = enc.Encode(newArchivedRun(run)); err != nil {
+			return errors.Wrapf(err, "failed to archive pipeline run %d", run.ID)
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to write pipeline run archive")
+	}
+	return errors.Wrap(f.Sync(), "failed to sync pipeline run archive")
+}
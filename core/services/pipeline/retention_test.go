@@ -0,0 +1,148 @@
+package pipeline_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestRetentionPolicies(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.Retention.JobTypes = []toml.JobPipelineRetentionJobType{{
+			Type:    ptr("webhook"),
+			MaxAge:  commonconfig.MustNewDuration(time.Hour),
+			MaxRuns: ptr[uint32](10),
+		}}
+	})
+
+	assert.Equal(t, []pipeline.RetentionPolicy{
+		{JobTypes: []string{"webhook"}, MaxAge: time.Hour, MaxRuns: 10, ErroredMaxAge: 168 * time.Hour},
+		{JobTypes: []string{"webhook"}, ExcludeJobTypes: true, MaxAge: 24 * time.Hour, MaxRuns: 10000, ErroredMaxAge: 168 * time.Hour},
+	}, pipeline.RetentionPolicies(cfg.JobPipeline().Retention()))
+}
+
+func TestRetentionReaper_ReapRuns(t *testing.T) {
+	t.Parallel()
+
+	policy := pipeline.RetentionPolicy{ExcludeJobTypes: true, MaxRuns: 1}
+	runs := []pipeline.Run{{
+		ID:    1,
+		State: pipeline.RunStatusErrored,
+		PipelineSpec: pipeline.Spec{
+			JobID:        7,
+			JobName:      "job",
+			JobType:      "webhook",
+			DotDagSource: "a [type=fail];",
+		},
+		Meta:        jsonserializable.JSONSerializable{Val: map[string]interface{}{"foo": "bar"}, Valid: true},
+		FatalErrors: pipeline.RunErrors{null.StringFrom("fail")},
+		FinishedAt:  null.TimeFrom(time.Now()),
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			Type:  pipeline.TaskTypeFail,
+			DotID: "a",
+			Error: null.StringFrom("fail"),
+		}},
+	}, {
+		ID:    2,
+		State: pipeline.RunStatusCompleted,
+	}}
+
+	t.Run("without archive", func(t *testing.T) {
+		orm := mocks.NewORM(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		r := pipeline.NewRetentionReaper(orm, cfg.JobPipeline().Retention(), logger.TestLogger(t))
+
+		orm.On("FindRunIDsToReap", mock.Anything, policy, uint(1000)).Return([]int64{1, 2}, nil).Once()
+		orm.On("DeleteRunsByIDs", mock.Anything, []int64{1, 2}).Return(int64(2), nil).Once()
+
+		n, err := r.ReapRuns(testutils.Context(t), policy)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+	})
+
+	t.Run("with archive", func(t *testing.T) {
+		dir := t.TempDir()
+		orm := mocks.NewORM(t)
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.JobPipeline.Retention.ArchiveDir = &dir
+		})
+		r := pipeline.NewRetentionReaper(orm, cfg.JobPipeline().Retention(), logger.TestLogger(t))
+
+		orm.On("FindRunIDsToReap", mock.Anything, policy, uint(1000)).Return([]int64{1, 2}, nil).Once()
+		orm.On("FindRunsByIDs", mock.Anything, []int64{1, 2}).Return(runs, nil).Once()
+		orm.On("DeleteRunsByIDs", mock.Anything, []int64{1, 2}).Return(int64(2), nil).Once()
+
+		n, err := r.ReapRuns(testutils.Context(t), policy)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+
+		names, err := filepath.Glob(filepath.Join(dir, "pipeline_runs_*_1.ndjson.gz"))
+		require.NoError(t, err)
+		require.Len(t, names, 1)
+		archived := readRunArchive(t, names[0])
+		require.Len(t, archived, 2)
+		assert.Equal(t, int64(1), archived[0].ID)
+		assert.Equal(t, int32(7), archived[0].JobID)
+		assert.Equal(t, "webhook", archived[0].JobType)
+		assert.Equal(t, pipeline.RunStatusErrored, archived[0].State)
+		assert.Equal(t, "a [type=fail];", archived[0].DotDagSource)
+		assert.Equal(t, pipeline.RunErrors{null.StringFrom("fail")}, archived[0].FatalErrors)
+		require.Len(t, archived[0].TaskRuns, 1)
+		assert.Equal(t, "a", archived[0].TaskRuns[0].DotID)
+		assert.Equal(t, int64(2), archived[1].ID)
+	})
+
+	t.Run("does not delete runs which failed to be archived", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "missing")
+		orm := mocks.NewORM(t)
+		cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.JobPipeline.Retention.ArchiveDir = &dir
+		})
+		r := pipeline.NewRetentionReaper(orm, cfg.JobPipeline().Retention(), logger.TestLogger(t))
+
+		orm.On("FindRunIDsToReap", mock.Anything, policy, uint(1000)).Return([]int64{1, 2}, nil).Once()
+		orm.On("FindRunsByIDs", mock.Anything, []int64{1, 2}).Return(runs, nil).Once()
+
+		_, err := r.ReapRuns(testutils.Context(t), policy)
+		require.Error(t, err)
+	})
+}
+
+func readRunArchive(t *testing.T, name string) (runs []pipeline.ArchivedRun) {
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		var run pipeline.ArchivedRun
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &run))
+		runs = append(runs, run)
+	}
+	require.NoError(t, scanner.Err())
+	return runs
+}
@@ -53,6 +53,9 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// BridgeCircuitBreakers returns the circuit breakers guarding the bridges called by bridge tasks.
+	BridgeCircuitBreakers() *bridges.CircuitBreakers
 }
 
 type runner struct {
@@ -68,6 +71,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *httpCache
+	bridgeCircuitBreakers  *bridges.CircuitBreakers
 
 	// test helper
 	runFinished func(*Run)
@@ -133,6 +138,8 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              newHTTPCache(),
+		bridgeCircuitBreakers:  bridges.NewCircuitBreakers(bridgeCfg.BridgeCircuitBreakerThreshold(), bridgeCfg.BridgeCircuitBreakerProbeInterval()),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -322,14 +329,25 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	return run, taskRunResults, nil
 }
 
+func (r *runner) BridgeCircuitBreakers() *bridges.CircuitBreakers {
+	return r.bridgeCircuitBreakers
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
 		return
 	}
 
+	r.initializeTasks(spec, pipeline.Tasks)
+
+	return pipeline, nil
+}
+
+// initializeTasks sets the dependencies of the tasks which need them.
+func (r *runner) initializeTasks(spec Spec, tasks []Task) {
 	// initialize certain task params
-	for _, task := range pipeline.Tasks {
+	for _, task := range tasks {
 		task.Base().uuid = uuid.New()
 
 		switch task.Type() {
@@ -337,6 +355,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -347,6 +366,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.bridgeCircuitBreakers
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -368,50 +388,26 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeMap:
+			task.(*MapTask).runner = r
+			task.(*MapTask).spec = spec
+			r.initializeTasks(spec, task.(*MapTask).pipeline.Tasks)
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
-	scheduler := newScheduler(pipeline, run, vars, l)
-	go scheduler.Run()
-
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	scheduler := r.executeTasks(ctx, pipeline, run, vars, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -432,17 +428,20 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
 		output := result.Result.OutputDB()
+		fragment, fragmentInstance := result.Task.Base().Fragment()
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-			ID:            result.ID,
-			PipelineRunID: run.ID,
-			Type:          result.Task.Type(),
-			Index:         result.Task.OutputIndex(),
-			Output:        output,
-			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
-			CreatedAt:     result.CreatedAt,
-			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
+			ID:               result.ID,
+			PipelineRunID:    run.ID,
+			Type:             result.Task.Type(),
+			Index:            result.Task.OutputIndex(),
+			Output:           output,
+			Error:            result.Result.ErrorDB(),
+			DotID:            result.Task.DotID(),
+			Fragment:         null.NewString(fragment, fragment != ""),
+			FragmentInstance: null.NewString(fragmentInstance, fragmentInstance != ""),
+			CreatedAt:        result.CreatedAt,
+			FinishedAt:       result.FinishedAt,
+			task:             result.Task,
 		})
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
@@ -525,6 +524,42 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// executeTasks schedules the tasks of the pipeline and executes them until
+// none are left to run, or the run is suspended. The results are left in the
+// returned scheduler.
+func (r *runner) executeTasks(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) *scheduler {
+	scheduler := newScheduler(pipeline, run, vars, l)
+	go scheduler.Run()
+
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+
+			logTaskRunToPrometheus(result, run.PipelineSpec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+
+	return scheduler
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -650,13 +685,16 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 			for _, task := range pipeline.Tasks {
 				switch task.Type() {
 				case TaskTypeETHTx:
+					fragment, fragmentInstance := task.Base().Fragment()
 					run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-						ID:            task.Base().uuid,
-						PipelineRunID: run.ID,
-						Type:          task.Type(),
-						Index:         task.OutputIndex(),
-						DotID:         task.DotID(),
-						CreatedAt:     now,
+						ID:               task.Base().uuid,
+						PipelineRunID:    run.ID,
+						Type:             task.Type(),
+						Index:            task.OutputIndex(),
+						DotID:            task.DotID(),
+						Fragment:         null.NewString(fragment, fragment != ""),
+						FragmentInstance: null.NewString(fragmentInstance, fragmentInstance != ""),
+						CreatedAt:        now,
 					})
 				default:
 				}
@@ -0,0 +1,156 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	ErrMissingFixture = errors.New("missing fixture for side-effecting task")
+	ErrUnknownFixture = errors.New("fixture does not match any task")
+)
+
+// TaskFixture is a recorded or user-supplied result that is returned instead
+// of running a task during a simulated run.
+type TaskFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error"`
+}
+
+// Fixtures holds everything needed to simulate a run of a pipeline without
+// any side effects. Tasks are keyed by their DOT ID.
+type Fixtures struct {
+	Vars  map[string]interface{} `json:"vars"`
+	Tasks map[string]TaskFixture `json:"tasks"`
+}
+
+// ParseFixtures unmarshals fixtures from their JSON representation.
+func ParseFixtures(bs []byte) (Fixtures, error) {
+	var f Fixtures
+	if err := json.Unmarshal(bs, &f); err != nil {
+		return f, errors.Wrap(err, "failed to unmarshal fixtures")
+	}
+	return f, nil
+}
+
+// IsSideEffecting returns true for task types that talk to the outside world,
+// and must therefore be replaced by a fixture when simulating a run.
+func (t TaskType) IsSideEffecting() bool {
+	switch t {
+	case TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeHTTP:
+		return true
+	default:
+		return false
+	}
+}
+
+// Simulate executes a run of spec in-memory, with every side-effecting task
+// replaced by its fixture. Tasks without side effects are executed as usual,
+// unless a fixture is provided for them too. Nothing is persisted.
+func Simulate(ctx context.Context, r Runner, spec Spec, fixtures Fixtures) (*Run, TaskRunResults, error) {
+	// always parse a fresh copy, the cached pipeline may be in use by a running job
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulated, err := p.withFixtures(fixtures.Tasks)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec.Pipeline = simulated
+
+	return r.ExecuteRun(ctx, spec, NewVarsFrom(fixtures.Vars))
+}
+
+// withFixtures returns a copy of the pipeline where each task that has a fixture
+// is swapped for a simulatedTask.
+func (p *Pipeline) withFixtures(fixtures map[string]TaskFixture) (*Pipeline, error) {
+	used := make(map[string]bool, len(fixtures))
+	tasks := make([]Task, len(p.Tasks))
+	for i, task := range p.Tasks {
+		fixture, ok := fixtures[task.DotID()]
+		if !ok {
+			if task.Type().IsSideEffecting() {
+				return nil, errors.Wrapf(ErrMissingFixture, "task %q of type %s", task.DotID(), task.Type())
+			}
+			if mapTask, is := task.(*MapTask); is {
+				// the tasks run for each element cannot be replaced individually
+				for _, t := range mapTask.pipeline.Tasks {
+					if t.Type().IsSideEffecting() {
+						return nil, errors.Wrapf(ErrMissingFixture, "task %q of type %s runs %q of type %s", task.DotID(), task.Type(), t.DotID(), t.Type())
+					}
+				}
+			}
+			tasks[i] = task
+			continue
+		}
+		used[task.DotID()] = true
+		tasks[i] = &simulatedTask{Task: task, fixture: fixture}
+	}
+
+	var unknown []string
+	for dotID := range fixtures {
+		if !used[dotID] {
+			unknown = append(unknown, dotID)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, errors.Wrap(ErrUnknownFixture, strings.Join(unknown, ", "))
+	}
+
+	return &Pipeline{Tasks: tasks, tree: p.tree, Source: p.Source}, nil
+}
+
+// simulatedTask wraps a Task, returning the fixture result rather than
+// running it. Everything else, including the position in the graph, is
+// delegated to the wrapped task.
+type simulatedTask struct {
+	Task
+	fixture TaskFixture
+}
+
+func (t *simulatedTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	if t.fixture.Error != "" {
+		return Result{Error: errors.New(t.fixture.Error)}, RunInfo{}
+	}
+	value, err := t.fixtureValue()
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "fixture")}, RunInfo{}
+	}
+	return Result{Value: value}, RunInfo{}
+}
+
+// fixtureValue converts the fixture value into the type the real task would
+// have returned, so that downstream tasks behave the same way.
+func (t *simulatedTask) fixtureValue() (interface{}, error) {
+	switch t.Type() {
+	case TaskTypeHTTP, TaskTypeBridge:
+		// response bodies are always returned as a string
+		if s, ok := t.fixture.Value.(string); ok {
+			return s, nil
+		}
+		bs, err := json.Marshal(t.fixture.Value)
+		if err != nil {
+			return nil, err
+		}
+		return string(bs), nil
+	case TaskTypeETHCall:
+		s, ok := t.fixture.Value.(string)
+		if !ok {
+			return nil, errors.Errorf("expected hex encoded string for %s, got %T", t.Type(), t.fixture.Value)
+		}
+		return hexutil.Decode(s)
+	default:
+		return t.fixture.Value, nil
+	}
+}
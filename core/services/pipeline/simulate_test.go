@@ -0,0 +1,122 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestParseFixtures(t *testing.T) {
+	t.Parallel()
+
+	fixtures, err := pipeline.ParseFixtures([]byte(`{
+		"vars": {"jobRun": {"meta": {"foo": "bar"}}},
+		"tasks": {
+			"ds1": {"value": {"data": {"result": 42}}},
+			"ds2": {"error": "connection refused"}
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"meta": map[string]interface{}{"foo": "bar"}}, fixtures.Vars["jobRun"])
+	require.Len(t, fixtures.Tasks, 2)
+	assert.Equal(t, "connection refused", fixtures.Tasks["ds2"].Error)
+
+	_, err = pipeline.ParseFixtures([]byte(`{"tasks": []}`))
+	require.Error(t, err)
+}
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	dag := `
+ds1          [type=http method=GET url="https://example.com/price"]
+ds1_parse    [type=jsonparse path="data,result"]
+ds1_multiply [type=multiply times=100]
+
+ds1 -> ds1_parse -> ds1_multiply
+`
+	spec := pipeline.Spec{DotDagSource: dag}
+
+	t.Run("replaces side-effecting tasks with fixtures", func(t *testing.T) {
+		run, trrs, err := pipeline.Simulate(testutils.Context(t), r, spec, pipeline.Fixtures{
+			Tasks: map[string]pipeline.TaskFixture{
+				"ds1": {Value: map[string]interface{}{"data": map[string]interface{}{"result": 1.23}}},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, trrs, 3)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.Equal(t, `{"data":{"result":1.23}}`, trrs[0].Result.Value)
+
+		final := trrs.FinalResult()
+		require.False(t, final.HasErrors())
+		assert.Equal(t, "123", final.Values[0].(decimal.Decimal).String())
+	})
+
+	t.Run("propagates fixture errors", func(t *testing.T) {
+		run, trrs, err := pipeline.Simulate(testutils.Context(t), r, spec, pipeline.Fixtures{
+			Tasks: map[string]pipeline.TaskFixture{
+				"ds1": {Error: "connection refused"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		require.Len(t, trrs, 3)
+		assert.EqualError(t, trrs[0].Result.Error, "connection refused")
+	})
+
+	t.Run("overrides tasks without side effects", func(t *testing.T) {
+		_, trrs, err := pipeline.Simulate(testutils.Context(t), r, spec, pipeline.Fixtures{
+			Tasks: map[string]pipeline.TaskFixture{
+				"ds1":       {Value: `{}`},
+				"ds1_parse": {Value: 2},
+			},
+		})
+		require.NoError(t, err)
+		final := trrs.FinalResult()
+		require.False(t, final.HasErrors())
+		assert.Equal(t, "200", final.Values[0].(decimal.Decimal).String())
+	})
+
+	t.Run("errors on missing fixture", func(t *testing.T) {
+		_, _, err := pipeline.Simulate(testutils.Context(t), r, spec, pipeline.Fixtures{})
+		require.ErrorIs(t, err, pipeline.ErrMissingFixture)
+		assert.Contains(t, err.Error(), `"ds1"`)
+	})
+
+	t.Run("errors on unknown fixture", func(t *testing.T) {
+		_, _, err := pipeline.Simulate(testutils.Context(t), r, spec, pipeline.Fixtures{
+			Tasks: map[string]pipeline.TaskFixture{
+				"ds1":         {Value: `{}`},
+				"nonexistent": {Value: 1},
+			},
+		})
+		require.ErrorIs(t, err, pipeline.ErrUnknownFixture)
+		assert.Contains(t, err.Error(), "nonexistent")
+	})
+
+	t.Run("does not use cached pipeline", func(t *testing.T) {
+		cached := spec
+		var err error
+		cached.Pipeline, err = cached.ParsePipeline()
+		require.NoError(t, err)
+
+		_, _, err = pipeline.Simulate(testutils.Context(t), r, cached, pipeline.Fixtures{
+			Tasks: map[string]pipeline.TaskFixture{"ds1": {Value: `{"data":{"result":1}}`}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.TaskTypeHTTP, cached.Pipeline.Tasks[0].Type())
+		_, isHTTP := cached.Pipeline.Tasks[0].(*pipeline.HTTPTask)
+		assert.True(t, isHTTP)
+	})
+}
@@ -25,6 +25,9 @@ type BaseTask struct {
 	Tags string `mapstructure:"tags" json:"-"`
 
 	uuid uuid.UUID
+
+	fragment         string
+	fragmentInstance string
 }
 
 func NewBaseTask(id int, dotID string, inputs []TaskDependency, outputs []Task, index int32) BaseTask {
@@ -43,6 +46,13 @@ func (t BaseTask) DotID() string {
 	return t.dotID
 }
 
+// Fragment returns the name of the pipeline fragment the task was expanded
+// from, and the dot ID of the node which instantiated it. Both are empty for
+// tasks defined directly in the pipeline.
+func (t BaseTask) Fragment() (fragment string, instance string) {
+	return t.fragment, t.fragmentInstance
+}
+
 func (t BaseTask) OutputIndex() int32 {
 	return t.Index
 }
@@ -49,6 +49,12 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeCircuitOpen = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_open_total",
+		Help: "Bridge requests rejected by an open circuit breaker scoped by name",
+	},
+		[]string{"name"},
+	)
 )
 
 // Return types:
@@ -69,6 +75,8 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+
+	circuitBreakers *bridges.CircuitBreakers
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -169,22 +177,44 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		elapsed        time.Duration
+	)
+	// an open circuit fails fast, without waiting on a bridge which is known to be down
+	if err = t.circuitBreakers.Allow(bridges.BridgeName(name)); err == nil {
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
 
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+		// client errors mean the bridge is up, only the request was wrong, and
+		// the run being cancelled says nothing about the bridge
+		if ctx.Err() == nil {
+			t.circuitBreakers.Record(bridges.BridgeName(name), !isRetryableHTTPError(statusCode, err))
+		}
 	}
+	circuitOpen := errors.Is(err, bridges.ErrCircuitOpen)
 
 	if err != nil || statusCode != http.StatusOK {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
 			err = adapterErr
 		}
 
-		promBridgeErrors.WithLabelValues(t.Name).Inc()
+		if circuitOpen {
+			promBridgeCircuitOpen.WithLabelValues(t.Name).Inc()
+		} else {
+			promBridgeErrors.WithLabelValues(t.Name).Inc()
+		}
+		// retrying is pointless until the next probe of the bridge
+		isRetryable := !circuitOpen && isRetryableHTTPError(statusCode, err)
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryable}
 		}
 
 		var cacheErr error
@@ -197,7 +227,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryable}
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -1280,3 +1280,62 @@ ds [type=bridge name="adapter-error-bridge" timeout="50ms" requestData="{\"data\
 	require.ErrorContains(t, finalResult.Result.Error, "AdapterLWBAError: bid ask violation detected")
 	require.Nil(t, finalResult.Result.Value)
 }
+
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := w.Write([]byte(`{"data": {"result": 42}}`))
+		require.NoError(t, err)
+	}))
+	defer s1.Close()
+
+	feedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: feedURL.String()})
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: ethUSDPairing,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, c)
+	circuitBreakers := bridges.NewCircuitBreakers(2, 50*time.Millisecond)
+	task.HelperSetCircuitBreakers(circuitBreakers)
+
+	for i := 0; i < 2; i++ {
+		result, runInfo := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.True(t, runInfo.IsRetryable)
+	}
+	assert.Equal(t, bridges.CircuitOpen, circuitBreakers.Status(bridge.Name).State)
+
+	// fails fast without calling the bridge
+	result, runInfo := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+	assert.False(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// the next probe closes the circuit once the bridge has recovered
+	healthy.Store(true)
+	time.Sleep(50 * time.Millisecond)
+	result, _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data": {"result": 42}}`, result.Value)
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Equal(t, bridges.CircuitClosed, circuitBreakers.Status(bridge.Name).State)
+}
@@ -25,6 +25,7 @@ import (
 // Return types:
 //
 //	nil
+//	string (the hash of the user operation, if userOperation is set)
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
@@ -39,6 +40,12 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority is one of low, normal or high. It defaults to high for keeper jobs and to low for
+	// VRF jobs, and to normal otherwise.
+	Priority string `json:"priority"`
+	// UserOperation, if set, sends the transaction as an ERC-4337 user operation of the chain's smart account
+	// through its bundler. The task completes once the bundler accepts it, so minConfirmations must be 0.
+	UserOperation string `json:"userOperation"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -95,6 +102,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		priority              StringParam
+		userOperation         BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,11 +114,16 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&priority, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority), "")), "priority"),
+		errors.Wrap(ResolveParam(&userOperation, From(NonemptyString(t.UserOperation), false)), "userOperation"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
 	}
 	minOutgoingConfirmations, isMinConfirmationSet := maybeMinConfirmations.Uint64()
+	if bool(userOperation) && (!isMinConfirmationSet || minOutgoingConfirmations > 0) {
+		return Result{Error: errors.Wrap(ErrBadInput, "minConfirmations must be 0 for user operations")}, RunInfo{}
+	}
 
 	txMeta, err := decodeMeta(txMetaMap)
 	if err != nil {
@@ -123,6 +137,11 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: err}, RunInfo{}
 	}
 
+	txPriority, err := decodeTxPriority(string(priority), t.jobType)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -134,7 +153,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	strategy := txmgrcommon.NewSendEveryStrategy()
 
 	var forwarderAddress common.Address
-	if t.forwardingAllowed {
+	if t.forwardingAllowed && !bool(userOperation) {
 		var fwderr error
 		forwarderAddress, fwderr = chain.TxManager().GetForwarderForEOA(ctx, fromAddr)
 		if fwderr != nil {
@@ -152,6 +171,19 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		Priority:         txPriority,
+	}
+
+	if userOperation {
+		// the task run ID is kept when the task is retried or the run is resumed, so a user operation which may have
+		// reached the bundler is returned instead of being sent again
+		idempotencyKey := fmt.Sprintf("pipeline-task-run-%s", t.uuid)
+		txRequest.IdempotencyKey = &idempotencyKey
+		opHash, uerr := txManager.SendUserOperation(ctx, txRequest)
+		if uerr != nil {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while sending user operation: %v", uerr)}, retryableRunInfo()
+		}
+		return Result{Value: opHash.Hex()}, RunInfo{}
 	}
 
 	if !isMinConfirmationSet {
@@ -254,3 +286,25 @@ func setJobIDOnMeta(lggr logger.Logger, vars Vars, meta *txmgr.TxMeta) {
 		logger.Sugared(lggr).AssumptionViolationf("expected type int32 for vars.jobSpec.databaseID; got: %T (value: %v)", jobID, jobID)
 	}
 }
+
+func decodeTxPriority(priority string, jobType string) (txmgr.TxPriority, error) {
+	switch priority {
+	case "low":
+		return txmgr.TxPriorityLow, nil
+	case "normal":
+		return txmgr.TxPriorityNormal, nil
+	case "high":
+		return txmgr.TxPriorityHigh, nil
+	case "":
+	default:
+		return txmgr.TxPriorityNormal, errors.Wrapf(ErrBadInput, "priority: must be one of low, normal or high, got %q", priority)
+	}
+	switch jobType {
+	case KeeperJobType:
+		return txmgr.TxPriorityHigh, nil
+	case VRFJobType:
+		return txmgr.TxPriorityLow, nil
+	default:
+		return txmgr.TxPriorityNormal, nil
+	}
+}
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -604,3 +605,136 @@ func TestETHTxTask(t *testing.T) {
 }
 
 func ptr[T any](t T) *T { return &t }
+
+func TestETHTxTask_Priority(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+
+	tests := []struct {
+		name     string
+		priority string
+		jobType  string
+		expected txmgr.TxPriority
+	}{
+		{"default", "", pipeline.DirectRequestJobType, txmgr.TxPriorityNormal},
+		{"keeper default", "", pipeline.KeeperJobType, txmgr.TxPriorityHigh},
+		{"vrf default", "", pipeline.VRFJobType, txmgr.TxPriorityLow},
+		{"low", "low", pipeline.DirectRequestJobType, txmgr.TxPriorityLow},
+		{"normal", "normal", pipeline.VRFJobType, txmgr.TxPriorityNormal},
+		{"high", "high", pipeline.DirectRequestJobType, txmgr.TxPriorityHigh},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:     `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:       "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+				Data:     "foobar",
+				GasLimit: "12345",
+				Priority: test.priority,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+				return tx.Priority == test.expected
+			})).Return(txmgr.Tx{}, nil)
+			task.HelperSetDependencies(legacyChains, keyStore, nil, test.jobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		task := pipeline.ETHTxTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:     `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:       "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:     "foobar",
+			GasLimit: "12345",
+			Priority: "urgent",
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
+
+func TestETHTxTask_UserOperation(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	opHash := common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2")
+
+	newTask := func(minConfirmations string) pipeline.ETHTxTask {
+		return pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: minConfirmations,
+			UserOperation:    "true",
+		}
+	}
+
+	t.Run("sends a user operation", func(t *testing.T) {
+		t.Parallel()
+
+		task := newTask("0")
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+		txManager.On("SendUserOperation", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+			return tx.FromAddress == from && tx.FeeLimit == 12345 && tx.PipelineTaskRunID == nil &&
+				tx.IdempotencyKey != nil && strings.HasPrefix(*tx.IdempotencyKey, "pipeline-task-run-")
+		})).Return(opHash, nil)
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, opHash.Hex(), result.Value)
+		assert.False(t, runInfo.IsPending)
+	})
+
+	t.Run("requires minConfirmations to be 0", func(t *testing.T) {
+		t.Parallel()
+
+		for _, minConfirmations := range []string{"", "3"} {
+			task := newTask(minConfirmations)
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+			task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -14,6 +15,16 @@ import (
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
+// HTTPTask makes an HTTP request.
+//
+// When cacheTTL is set, responses are cached for that long, or less if the
+// Cache-Control header of the response says so, and requests are served from
+// the cache without hitting the upstream. If the upstream then errors, a
+// response no older than stalenessCap is returned instead of the error. The
+// cache is shared by all jobs, and keyed by method, URL, body, headers and
+// allowUnrestrictedNetworkAccess, so that restricted tasks are never served
+// responses fetched from local resources.
+//
 // Return types:
 //
 //	string
@@ -24,10 +35,12 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CacheTTL                       string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *httpCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -45,6 +58,20 @@ var (
 	},
 		[]string{"pipeline_task_spec_id"},
 	)
+	// NOTE: Like the bridge metrics, the cache metrics generate a new label per
+	// host, which is bounded by the number of upstreams the jobs fetch from.
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "HTTP task cache hits count scoped by host",
+	},
+		[]string{"name"},
+	)
+	promHTTPCacheErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_errors_total",
+		Help: "HTTP task cache errors count scoped by host, when the upstream errored and no cached response could be used",
+	},
+		[]string{"name"},
+	)
 )
 
 func (t *HTTPTask) Type() TaskType {
@@ -63,6 +90,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +101,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -94,6 +123,30 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
+	// cacheTTL should not exceed stalenessCap.
+	cacheDuration := time.Duration(cacheTTL) * time.Second
+	if cacheDuration > stalenessCap {
+		lggr.Warnf("http task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
+		cacheDuration = stalenessCap
+	}
+
+	var cacheKey string
+	if cacheDuration > 0 && t.cache != nil {
+		cacheKey, err = httpCacheKey(method, url, requestData, reqHeaders, allowUnrestrictedNetworkAccess)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		if responseBytes, ok := t.cache.fresh(cacheKey); ok {
+			promHTTPCacheHits.WithLabelValues(url.Host).Inc()
+			lggr.Debugw("HTTP task: using cached response",
+				"response", string(responseBytes),
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: string(responseBytes)}, runInfo
+		}
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
@@ -108,9 +161,26 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
+		if cacheKey != "" {
+			if cached, ok := t.cache.stale(cacheKey, stalenessCap); ok {
+				promHTTPCacheHits.WithLabelValues(url.Host).Inc()
+				lggr.Debugw("HTTP task: request failed, falling back to cache",
+					"err", err,
+					"response", string(cached),
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+				return Result{Value: string(cached)}, runInfo
+			}
+			promHTTPCacheErrors.WithLabelValues(url.Host).Inc()
+		}
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
+	if cacheKey != "" {
+		t.cache.store(cacheKey, responseBytes, respHeaders, cacheDuration)
+	}
+
 	lggr.Debugw("HTTP task got response",
 		"response", string(responseBytes),
 		"respHeaders", respHeaders,
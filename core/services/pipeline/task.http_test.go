@@ -2,10 +2,12 @@ package pipeline_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -412,3 +414,118 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	type upstream struct {
+		server       *httptest.Server
+		requests     *atomic.Int32
+		failing      *atomic.Bool
+		cacheControl string
+	}
+	newUpstream := func(t *testing.T, cacheControl string) upstream {
+		u := upstream{requests: new(atomic.Int32), failing: new(atomic.Bool), cacheControl: cacheControl}
+		u.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := u.requests.Add(1)
+			if u.failing.Load() {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			if u.cacheControl != "" {
+				w.Header().Set("Cache-Control", u.cacheControl)
+			}
+			_, err := fmt.Fprintf(w, `{"request": %d}`, n)
+			assert.NoError(t, err)
+		}))
+		t.Cleanup(u.server.Close)
+		return u
+	}
+	newTask := func(dotID string, u upstream, requestData, cacheTTL string) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, dotID, nil, nil, 0),
+			Method:      "POST",
+			URL:         u.server.URL,
+			RequestData: requestData,
+			CacheTTL:    cacheTTL,
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		return task
+	}
+	run := func(t *testing.T, task *pipeline.HTTPTask) pipeline.Result {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsPending)
+		return result
+	}
+
+	t.Run("serves fresh responses shared across tasks", func(t *testing.T) {
+		u := newUpstream(t, "")
+		task1 := newTask("ds1", u, ethUSDPairing, "1m")
+		task2 := newTask("ds2", u, ethUSDPairing, "1m")
+		other := newTask("ds3", u, btcUSDPairing, "1m")
+		pipeline.HelperSetHTTPCache(task1, task2, other)
+
+		assert.Equal(t, `{"request": 1}`, run(t, task1).Value)
+		assert.Equal(t, `{"request": 1}`, run(t, task2).Value)
+		assert.Equal(t, int32(1), u.requests.Load())
+
+		// a different body is a different resource
+		assert.Equal(t, `{"request": 2}`, run(t, other).Value)
+		assert.Equal(t, int32(2), u.requests.Load())
+	})
+
+	t.Run("restricted tasks are not served responses cached by unrestricted ones", func(t *testing.T) {
+		u := newUpstream(t, "")
+		unrestricted := newTask("ds1", u, ethUSDPairing, "1m")
+		unrestricted.AllowUnrestrictedNetworkAccess = "true"
+		restricted := newTask("ds2", u, ethUSDPairing, "1m")
+		restricted.AllowUnrestrictedNetworkAccess = "false"
+		pipeline.HelperSetHTTPCache(unrestricted, restricted)
+
+		assert.Equal(t, `{"request": 1}`, run(t, unrestricted).Value)
+		assert.Equal(t, `{"request": 2}`, run(t, restricted).Value)
+		assert.Equal(t, int32(2), u.requests.Load())
+	})
+
+	t.Run("Cache-Control max-age limits freshness but not the fallback", func(t *testing.T) {
+		u := newUpstream(t, "public, max-age=0")
+		task := newTask("ds1", u, ethUSDPairing, "1m")
+		pipeline.HelperSetHTTPCache(task)
+
+		assert.Equal(t, `{"request": 1}`, run(t, task).Value)
+		assert.Equal(t, `{"request": 2}`, run(t, task).Value)
+
+		u.failing.Store(true)
+		result := run(t, task)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"request": 2}`, result.Value)
+		assert.Equal(t, int32(3), u.requests.Load())
+	})
+
+	t.Run("Cache-Control no-store responses are not cached", func(t *testing.T) {
+		u := newUpstream(t, "no-store")
+		task := newTask("ds1", u, ethUSDPairing, "1m")
+		pipeline.HelperSetHTTPCache(task)
+
+		assert.Equal(t, `{"request": 1}`, run(t, task).Value)
+		assert.Equal(t, `{"request": 2}`, run(t, task).Value)
+
+		u.failing.Store(true)
+		require.Error(t, run(t, task).Error)
+	})
+
+	t.Run("not cached without cacheTTL", func(t *testing.T) {
+		u := newUpstream(t, "max-age=60")
+		task := newTask("ds1", u, ethUSDPairing, "")
+		pipeline.HelperSetHTTPCache(task)
+
+		assert.Equal(t, `{"request": 1}`, run(t, task).Value)
+		assert.Equal(t, `{"request": 2}`, run(t, task).Value)
+
+		u.failing.Store(true)
+		require.Error(t, run(t, task).Error)
+	})
+}
@@ -0,0 +1,146 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MapTask runs the tasks of a pipeline fragment once for each element of an
+// array, and collects the results into an array of the same length.
+//
+// The first parameter of the fragment is set to the element, and the optional
+// second parameter to its index. Each iteration is executed by the scheduler
+// like any other pipeline, so retries and timeouts apply to the fragment's
+// tasks in every iteration. The task fails as soon as one iteration fails.
+//
+// e.g.
+//
+//	fragment fetch_price(priceURL) {
+//		fetch [type=http method=GET url="$(priceURL)"];
+//		parse [type=jsonparse path="price" data="$(fetch)"];
+//	}
+//
+//	prices [type=map fragment=fetch_price values="$(priceURLs)" concurrency=4];
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Fragment    string `json:"fragment"`
+	Values      string `json:"values"`
+	Concurrency string `json:"concurrency"`
+
+	pipeline   *Pipeline
+	valueParam string
+	indexParam string
+	runner     *runner
+	spec       Spec
+}
+
+var _ Task = (*MapTask)(nil)
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+// setFragment parses the fragment to run for each element.
+func (t *MapTask) setFragment(fragments map[string]*fragment) error {
+	frag, exists := fragments[t.Fragment]
+	if !exists {
+		return errors.Errorf("%s: unknown fragment %q", t.DotID(), t.Fragment)
+	}
+	if len(frag.params) == 0 || len(frag.params) > 2 {
+		return errors.Errorf("%s: fragment %s must have one parameter for the element, and optionally one for its index", t.DotID(), frag.name)
+	}
+
+	body, err := parseFragmentBody(frag)
+	if err != nil {
+		return err
+	}
+	for nodes := body.Nodes(); nodes.Next(); {
+		if TaskType(nodes.Node().(*GraphNode).attrs["type"]) == TaskTypeMap {
+			return errors.Errorf("%s: map tasks cannot be nested", t.DotID())
+		}
+	}
+	p, err := newPipeline(body, frag.source, nil)
+	if err != nil {
+		return errors.Wrapf(err, "fragment %s", frag.name)
+	}
+	if p.RequiresPreInsert() {
+		return errors.Errorf("%s: fragment %s has tasks which suspend the run, they cannot be run by a map task", t.DotID(), frag.name)
+	}
+
+	t.pipeline = p
+	t.valueParam = frag.params[0]
+	if len(frag.params) == 2 {
+		t.indexParam = frag.params[1]
+	}
+	return nil
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		values      SliceParam
+		concurrency Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Input(inputs, 0))), "values"),
+		errors.Wrap(ResolveParam(&concurrency, From(VarExpr(t.Concurrency, vars), NonemptyString(t.Concurrency), 1)), "concurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if concurrency == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "concurrency must be greater than 0")}, runInfo
+	}
+
+	results := make([]interface{}, len(values))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(int(concurrency))
+	for i, value := range values {
+		i, value := i, value
+		g.Go(func() error {
+			iterationVars := vars.Copy()
+			if err := iterationVars.Set(t.valueParam, value); err != nil {
+				return err
+			}
+			if t.indexParam != "" {
+				if err := iterationVars.Set(t.indexParam, i); err != nil {
+					return err
+				}
+			}
+
+			run := &Run{PipelineSpec: t.spec}
+			scheduler := t.runner.executeTasks(gctx, t.pipeline, run, iterationVars, lggr.With("mapIndex", i))
+			var trrs TaskRunResults
+			for _, trr := range scheduler.results {
+				trrs = append(trrs, trr)
+			}
+			iterationResult, err := trrs.FinalResult().SingularResult()
+			if err != nil {
+				return err
+			}
+			if iterationResult.Error != nil {
+				return errors.Wrapf(iterationResult.Error, "element %d", i)
+			}
+			results[i] = iterationResult.Value
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: results}, runInfo
+}
@@ -0,0 +1,112 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMapTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	const scaleFragment = `
+fragment scale(value, index) {
+	mult [type=multiply input="$(value)" times=10];
+	sum  [type=sum values=<[ $(mult), $(index) ]>];
+}
+`
+	execute := func(t *testing.T, dag string, values interface{}) pipeline.FinalResult {
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: dag}, pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"values": values},
+		}))
+		require.NoError(t, err)
+		return trrs.FinalResult()
+	}
+
+	t.Run("collects the results in order", func(t *testing.T) {
+		final := execute(t, scaleFragment+`scaled [type=map fragment=scale values="$(jobRun.values)" concurrency=2];`, []interface{}{1, 2, 3, 4})
+		require.False(t, final.HasErrors())
+		require.Len(t, final.Values, 1)
+
+		results, ok := final.Values[0].([]interface{})
+		require.True(t, ok)
+		var got []string
+		for _, result := range results {
+			got = append(got, result.(decimal.Decimal).String())
+		}
+		assert.Equal(t, []string{"10", "21", "32", "43"}, got)
+	})
+
+	t.Run("values from input", func(t *testing.T) {
+		final := execute(t, scaleFragment+`
+parse  [type=jsonparse path="values" data="$(jobRun.values)"];
+scaled [type=map fragment=scale];
+parse -> scaled;
+`, `{"values": [5]}`)
+		require.False(t, final.HasErrors())
+		results := final.Values[0].([]interface{})
+		require.Len(t, results, 1)
+		assert.Equal(t, "50", results[0].(decimal.Decimal).String())
+	})
+
+	t.Run("empty values", func(t *testing.T) {
+		final := execute(t, scaleFragment+`scaled [type=map fragment=scale values="$(jobRun.values)"];`, []interface{}{})
+		require.False(t, final.HasErrors())
+		assert.Equal(t, []interface{}{}, final.Values[0])
+	})
+
+	t.Run("fails when an iteration fails", func(t *testing.T) {
+		final := execute(t, `
+fragment double(value) {
+	mult [type=multiply input="$(value)" times=2];
+}
+doubled [type=map fragment=double values="$(jobRun.values)"];
+`, []interface{}{1, "foo", 3})
+		require.True(t, final.HasFatalErrors())
+		assert.Contains(t, final.FatalErrors[0].Error(), "element 1")
+		assert.Nil(t, final.Values[0])
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		final := execute(t, scaleFragment+`scaled [type=map fragment=scale values="$(jobRun.values)" concurrency=0];`, []interface{}{1})
+		require.True(t, final.HasFatalErrors())
+		assert.ErrorIs(t, final.FatalErrors[0], pipeline.ErrBadInput)
+	})
+}
+
+func TestMapTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		pipeline string
+		err      string
+	}{
+		{"unknown fragment", `m [type=map fragment=nope values="$(foo)"];`, `unknown fragment "nope"`},
+		{"no parameters", `fragment f() { x [type=memo value=1]; }
+m [type=map fragment=f values="$(foo)"];`, "must have one parameter for the element"},
+		{"nested", `fragment f(v) { x [type=map fragment=f values="$(v)"]; }
+m [type=map fragment=f values="$(foo)"];`, "cannot be nested"},
+		{"suspending tasks", `fragment f(v) { x [type=ethtx to="$(v)" data="0x"]; }
+m [type=map fragment=f values="$(foo)"];`, "cannot be run by a map task"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pipeline.Parse(tt.pipeline)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
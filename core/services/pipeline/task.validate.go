@@ -0,0 +1,235 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ValidateTask checks a value against a JSON Schema, and either passes it
+// through unchanged or fails with a SchemaValidationError listing every path
+// that violated the schema.
+//
+// The schema may be given inline, or by reference to a variable holding it.
+// String and byte inputs holding a JSON object or array (e.g. the response of
+// a bridge or http task) are decoded before validation.
+//
+// e.g. [type=validate schema=<{"type": "object", "required": ["data"]}>]
+//
+// Return types:
+//
+//	the input value
+type ValidateTask struct {
+	BaseTask `mapstructure:",squash"`
+	Schema   string `json:"schema"`
+	Data     string `json:"data"`
+}
+
+var _ Task = (*ValidateTask)(nil)
+
+// SchemaViolation is a single failed check of a ValidateTask.
+type SchemaViolation struct {
+	// Path is a JSON pointer to the offending value, "" being the root
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// SchemaValidationError is returned by ValidateTask when the value does not
+// match the schema.
+type SchemaValidationError struct {
+	Violations []SchemaViolation
+}
+
+func (e *SchemaValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("schema validation failed: ")
+	for i, v := range e.Violations {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		path := v.Path
+		if path == "" {
+			path = "/"
+		}
+		fmt.Fprintf(&sb, "%s: %s", path, v.Message)
+	}
+	return sb.String()
+}
+
+func (t *ValidateTask) Type() TaskType {
+	return TaskTypeValidate
+}
+
+func (t *ValidateTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var schemaParam MapParam
+	err = errors.Wrap(ResolveParam(&schemaParam, From(VarExpr(t.Schema, vars), NonemptyString(t.Schema))), "schema")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	schema, err := compileSchema(schemaParam)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "schema")}, runInfo
+	}
+
+	var data interface{}
+	if t.Data != "" {
+		data, err = VarExpr(t.Data, vars)()
+	} else {
+		data, err = Input(inputs, 0)()
+	}
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "data")}, runInfo
+	}
+
+	instance, err := toJSONInstance(data)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "data: %v", err)}, runInfo
+	}
+
+	if err = schema.Validate(instance); err != nil {
+		var ve *jsonschema.ValidationError
+		if errors.As(err, &ve) {
+			return Result{Error: &SchemaValidationError{Violations: schemaViolations(ve)}}, runInfo
+		}
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: data}, runInfo
+}
+
+const validateTaskSchemaURL = "validate-task-schema.json"
+
+func compileSchema(schema MapParam) (*jsonschema.Schema, error) {
+	bs, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	// Only self-contained schemas are supported, never fetch a $ref from the network or disk.
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, errors.Errorf("unable to load %s: external references are not supported", s)
+	}
+	if err = c.AddResource(validateTaskSchemaURL, bytes.NewReader(bs)); err != nil {
+		return nil, err
+	}
+	return c.Compile(validateTaskSchemaURL)
+}
+
+// toJSONInstance converts a pipeline value into the generic form produced by
+// encoding/json, which is what the validator expects.
+func toJSONInstance(val interface{}) (interface{}, error) {
+	var bs []byte
+	switch v := val.(type) {
+	case string:
+		if !isJSONContainer([]byte(v)) {
+			return v, nil
+		}
+		bs = []byte(v)
+	case []byte:
+		if !isJSONContainer(v) {
+			return string(v), nil
+		}
+		bs = v
+	default:
+		var err error
+		bs, err = json.Marshal(decimalsAsNumbers(val))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(bs))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// decimalsAsNumbers replaces the decimals and big ints found in val with
+// json.Number, since they would otherwise be marshalled as JSON strings.
+func decimalsAsNumbers(val interface{}) interface{} {
+	switch v := val.(type) {
+	case decimal.Decimal:
+		return json.Number(v.String())
+	case *decimal.Decimal:
+		if v == nil {
+			return nil
+		}
+		return json.Number(v.String())
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return json.Number(v.String())
+	case ObjectParam:
+		switch v.Type {
+		case DecimalType:
+			return json.Number(v.DecimalValue.Decimal().String())
+		case MapType:
+			return decimalsAsNumbers(map[string]interface{}(v.MapValue))
+		case SliceType:
+			return decimalsAsNumbers([]interface{}(v.SliceValue))
+		default:
+			return v
+		}
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			m[k] = decimalsAsNumbers(elem)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, elem := range v {
+			s[i] = decimalsAsNumbers(elem)
+		}
+		return s
+	default:
+		return val
+	}
+}
+
+func isJSONContainer(bs []byte) bool {
+	trimmed := bytes.TrimSpace(bs)
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+// schemaViolations flattens the tree of validation errors into the leaves,
+// which are the checks that actually failed.
+func schemaViolations(ve *jsonschema.ValidationError) []SchemaViolation {
+	var violations []SchemaViolation
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			violations = append(violations, SchemaViolation{Path: e.InstanceLocation, Message: e.Message})
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(ve)
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations
+}
@@ -0,0 +1,162 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestValidateTask(t *testing.T) {
+	t.Parallel()
+
+	const priceSchema = `{
+		"type": "object",
+		"required": ["data"],
+		"properties": {
+			"data": {
+				"type": "object",
+				"required": ["result", "market"],
+				"properties": {
+					"result": {"type": "number"},
+					"market": {"type": "string"}
+				}
+			}
+		}
+	}`
+
+	tests := []struct {
+		name       string
+		schema     string
+		data       string
+		vars       map[string]interface{}
+		inputs     []pipeline.Result
+		wantValue  interface{}
+		wantErrIs  error
+		wantPaths  []string
+		wantErrMsg string
+	}{
+		{
+			name:      "valid bridge response string",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Value: `{"data":{"result":123.45,"market":"USD"}}`}},
+			wantValue: `{"data":{"result":123.45,"market":"USD"}}`,
+		},
+		{
+			name:      "valid decoded map",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Value: map[string]interface{}{"data": map[string]interface{}{"result": 1, "market": "USD"}}}},
+			wantValue: map[string]interface{}{"data": map[string]interface{}{"result": 1, "market": "USD"}},
+		},
+		{
+			name:      "valid decimal",
+			schema:    `{"type": "number", "minimum": 0}`,
+			inputs:    []pipeline.Result{{Value: decimal.RequireFromString("1.5")}},
+			wantValue: decimal.RequireFromString("1.5"),
+		},
+		{
+			name:      "valid nested decimal",
+			schema:    `{"type": "object", "properties": {"price": {"type": "number"}}}`,
+			inputs:    []pipeline.Result{{Value: map[string]interface{}{"price": decimal.RequireFromString("2")}}},
+			wantValue: map[string]interface{}{"price": decimal.RequireFromString("2")},
+		},
+		{
+			name:      "valid scalar string",
+			schema:    `{"type": "string", "pattern": "^0x"}`,
+			inputs:    []pipeline.Result{{Value: "0xdeadbeef"}},
+			wantValue: "0xdeadbeef",
+		},
+		{
+			name:      "schema and data from vars",
+			schema:    "$(schemas.price)",
+			data:      "$(ds.body)",
+			vars:      map[string]interface{}{"schemas": map[string]interface{}{"price": priceSchema}, "ds": map[string]interface{}{"body": `{"data":{"result":1,"market":"USD"}}`}},
+			wantValue: `{"data":{"result":1,"market":"USD"}}`,
+		},
+		{
+			name:      "invalid lists all violated paths",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Value: `{"data":{"result":"123.45"}}`}},
+			wantPaths: []string{"/data", "/data/result"},
+		},
+		{
+			name:      "invalid root",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Value: `[]`}},
+			wantPaths: []string{""},
+		},
+		{
+			name:      "malformed JSON",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Value: `{"data":`}},
+			wantErrIs: pipeline.ErrBadInput,
+		},
+		{
+			name:       "invalid schema",
+			schema:     `{"type": 42}`,
+			inputs:     []pipeline.Result{{Value: `{}`}},
+			wantErrMsg: "schema",
+		},
+		{
+			name:       "external references are not loaded",
+			schema:     `{"$ref": "https://example.com/schema.json"}`,
+			inputs:     []pipeline.Result{{Value: `{}`}},
+			wantErrMsg: "external references are not supported",
+		},
+		{
+			name:      "missing schema",
+			inputs:    []pipeline.Result{{Value: `{}`}},
+			wantErrIs: pipeline.ErrParameterEmpty,
+		},
+		{
+			name:      "input error",
+			schema:    priceSchema,
+			inputs:    []pipeline.Result{{Error: errors.New("bridge down")}},
+			wantErrIs: pipeline.ErrTooManyErrors,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ValidateTask{
+				BaseTask: pipeline.NewBaseTask(0, "validate", nil, nil, 0),
+				Schema:   test.schema,
+				Data:     test.data,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			switch {
+			case test.wantPaths != nil:
+				var verr *pipeline.SchemaValidationError
+				require.ErrorAs(t, result.Error, &verr)
+				var paths []string
+				for _, v := range verr.Violations {
+					paths = append(paths, v.Path)
+					assert.NotEmpty(t, v.Message)
+				}
+				assert.Equal(t, test.wantPaths, paths)
+				assert.Nil(t, result.Value)
+			case test.wantErrIs != nil:
+				require.ErrorIs(t, result.Error, test.wantErrIs)
+				assert.Nil(t, result.Value)
+			case test.wantErrMsg != "":
+				require.ErrorContains(t, result.Error, test.wantErrMsg)
+				assert.Nil(t, result.Value)
+			default:
+				require.NoError(t, result.Error)
+				assert.Equal(t, test.wantValue, result.Value)
+			}
+		})
+	}
+}
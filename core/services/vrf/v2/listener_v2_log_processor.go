@@ -611,6 +611,7 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 			RequestTxHash: &requestTxHash,
 			// No max link since simulation failed
 		},
+		Priority: txmgr.TxPriorityLow,
 	})
 }
 
@@ -827,6 +828,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						VRFCoordinatorAddress: &coordinatorAddress,
 						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw().BlockNumber),
 					},
+					Priority: txmgr.TxPriorityLow,
 				})
 				return err
 			})
@@ -250,6 +250,7 @@ func (lsn *listenerV2) processBatch(
 				GlobalSubID:     txMetaGlobalSubID,
 				RequestTxHashes: txHashes,
 			},
+			Priority: txmgr.TxPriorityLow,
 		})
 		if err != nil {
 			return fmt.Errorf("create batch fulfillment eth transaction: %w", err)
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs
+ADD COLUMN fragment TEXT,
+ADD COLUMN fragment_instance TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs
+DROP COLUMN IF EXISTS fragment,
+DROP COLUMN IF EXISTS fragment_instance;
+-- +goose StatementEnd
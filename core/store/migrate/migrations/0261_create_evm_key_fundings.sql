@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.key_fundings (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL,
+    treasury_address bytea NOT NULL,
+    address bytea NOT NULL,
+    amount numeric(78,0) NOT NULL,
+    eth_tx_id bigint REFERENCES evm.txes(id) ON DELETE SET NULL,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT chk_treasury_address_length CHECK ((octet_length(treasury_address) = 20)),
+    CONSTRAINT chk_address_length CHECK ((octet_length(address) = 20))
+);
+CREATE INDEX idx_evm_key_fundings_chain_created_at ON evm.key_fundings (evm_chain_id, created_at);
+CREATE INDEX idx_evm_key_fundings_eth_tx_id ON evm.key_fundings (eth_tx_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.key_fundings;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.tx_costs (
+    eth_tx_id bigint NOT NULL,
+    evm_chain_id numeric(78,0) NOT NULL,
+    from_address bytea NOT NULL,
+    job_id integer,
+    tx_hash bytea NOT NULL,
+    block_number bigint NOT NULL,
+    gas_used bigint NOT NULL,
+    effective_gas_price numeric(78,0) NOT NULL,
+    l1_fee numeric(78,0) NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT pk_evm_tx_costs PRIMARY KEY (evm_chain_id, eth_tx_id),
+    CONSTRAINT chk_from_address_length CHECK ((octet_length(from_address) = 20))
+);
+CREATE INDEX idx_evm_tx_costs_created_at ON evm.tx_costs (created_at);
+CREATE INDEX idx_evm_tx_costs_job_id_created_at ON evm.tx_costs (job_id, created_at) WHERE job_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.tx_costs;
+-- +goose StatementEnd
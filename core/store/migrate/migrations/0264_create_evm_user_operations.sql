@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.user_operations (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL,
+    op_hash bytea NOT NULL,
+    previous_op_hashes bytea[] NOT NULL DEFAULT '{}',
+    entry_point bytea NOT NULL,
+    sender bytea NOT NULL,
+    owner bytea NOT NULL,
+    nonce numeric(78,0) NOT NULL,
+    user_operation jsonb NOT NULL,
+    idempotency_key varchar(2000),
+    meta jsonb,
+    state text NOT NULL DEFAULT 'unconfirmed',
+    sent_before_block_num bigint,
+    tx_hash bytea,
+    block_number bigint,
+    success boolean,
+    error text,
+    actual_gas_cost numeric(78,0),
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_state CHECK (state IN ('unconfirmed', 'confirmed', 'fatal_error')),
+    CONSTRAINT chk_confirmed_receipt CHECK (state <> 'confirmed' OR (tx_hash IS NOT NULL AND block_number IS NOT NULL AND success IS NOT NULL)),
+    CONSTRAINT chk_sender_length CHECK ((octet_length(sender) = 20)),
+    CONSTRAINT chk_owner_length CHECK ((octet_length(owner) = 20))
+);
+CREATE UNIQUE INDEX idx_evm_user_operations_op_hash ON evm.user_operations (evm_chain_id, op_hash);
+CREATE UNIQUE INDEX idx_evm_user_operations_idempotency_key ON evm.user_operations (evm_chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE INDEX idx_evm_user_operations_unconfirmed ON evm.user_operations (evm_chain_id, sender, nonce) WHERE state = 'unconfirmed';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.user_operations;
+-- +goose StatementEnd
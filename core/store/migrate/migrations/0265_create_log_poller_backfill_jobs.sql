@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.log_poller_backfill_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL,
+    filter_names text[] NOT NULL DEFAULT '{}',
+    from_block bigint NOT NULL,
+    to_block bigint NOT NULL,
+    next_block bigint NOT NULL,
+    batch_size bigint NOT NULL,
+    state text NOT NULL DEFAULT 'pending',
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_state CHECK (state IN ('pending', 'running', 'completed', 'failed')),
+    CONSTRAINT chk_block_range CHECK (from_block >= 0 AND from_block <= to_block),
+    CONSTRAINT chk_next_block CHECK (next_block >= from_block AND next_block <= to_block + 1),
+    CONSTRAINT chk_batch_size CHECK (batch_size > 0)
+);
+CREATE INDEX idx_evm_log_poller_backfill_jobs_active ON evm.log_poller_backfill_jobs (evm_chain_id, id) WHERE state IN ('pending', 'running');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.log_poller_backfill_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- Logs older than compact_after (in nanoseconds, like retention) for every filter they match have their data dropped,
+-- keeping only the topics and the tx hash. 0 keeps the full data until the log is pruned.
+ALTER TABLE evm.log_poller_filters ADD COLUMN compact_after BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE evm.logs ADD COLUMN compacted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+
+ALTER TABLE evm.logs DROP COLUMN compacted;
+ALTER TABLE evm.log_poller_filters DROP COLUMN compact_after;
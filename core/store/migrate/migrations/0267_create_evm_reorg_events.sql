@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.reorg_events (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL,
+    old_head_number bigint NOT NULL,
+    old_head_hash bytea NOT NULL,
+    new_head_number bigint NOT NULL,
+    new_head_hash bytea NOT NULL,
+    common_ancestor_number bigint,
+    common_ancestor_hash bytea,
+    from_block bigint NOT NULL,
+    to_block bigint NOT NULL,
+    depth bigint NOT NULL,
+    latest_finalized_block bigint NOT NULL,
+    finality_violated boolean NOT NULL,
+    detected_at timestamptz NOT NULL,
+    CONSTRAINT chk_block_range CHECK (from_block <= to_block),
+    CONSTRAINT chk_common_ancestor CHECK ((common_ancestor_number IS NULL) = (common_ancestor_hash IS NULL))
+);
+CREATE INDEX idx_evm_reorg_events_chain_detected_at ON evm.reorg_events (evm_chain_id, detected_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.reorg_events;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- A chain stays in safe mode while one of its finality violations has not been acknowledged by an operator.
+ALTER TABLE evm.reorg_events ADD COLUMN acknowledged_at timestamptz;
+CREATE INDEX idx_evm_reorg_events_unacknowledged_violations ON evm.reorg_events (evm_chain_id, id) WHERE finality_violated AND acknowledged_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS evm.idx_evm_reorg_events_unacknowledged_violations;
+ALTER TABLE evm.reorg_events DROP COLUMN acknowledged_at;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Marks the transactions cancelled by an operator, which are purged like the terminally stuck ones.
+ALTER TABLE evm.txes ADD COLUMN cancelled boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+
+ALTER TABLE evm.txes DROP COLUMN cancelled;
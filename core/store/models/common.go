@@ -258,6 +258,15 @@ type SendEtherRequest struct {
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
 }
 
+// BumpEthTxRequest represents a request to rebroadcast an unconfirmed
+// transaction at a higher fee. GasPrice is used for legacy transactions,
+// while GasFeeCap and GasTipCap are used for EIP-1559 transactions.
+type BumpEthTxRequest struct {
+	GasPrice  *assets.Wei `json:"gasPrice"`
+	GasFeeCap *assets.Wei `json:"gasFeeCap"`
+	GasTipCap *assets.Wei `json:"gasTipCap"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
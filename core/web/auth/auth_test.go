@@ -245,9 +245,12 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
+	{"POST", "/v2/transactions/evm/MOCK/cancel", false, false, false},
+	{"POST", "/v2/transactions/evm/MOCK/bump", false, false, false},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
+	{"POST", "/v2/safe_mode/acknowledge", false, false, false},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
 	{"POST", "/v2/keys/csa/import", false, false, false},
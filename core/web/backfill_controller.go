@@ -0,0 +1,115 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// BackfillController manages the LogPoller backfill jobs.
+type BackfillController struct {
+	App chainlink.Application
+}
+
+// CreateBackfillJobRequest is a JSONAPI request for creating a LogPoller backfill job.
+type CreateBackfillJobRequest struct {
+	// FilterNames are the names of the LogPoller filters to backfill. All filters are backfilled when empty.
+	FilterNames []string `json:"filterNames"`
+	FromBlock   int64    `json:"fromBlock"`
+	// ToBlock defaults to the latest finalized block when 0.
+	ToBlock int64 `json:"toBlock"`
+}
+
+// Create creates a job backfilling the logs of the LogPoller filters in a block range. The job runs in the background,
+// and its progress can be followed with Show.
+// Example:
+//
+//	"<application>/v2/backfill_jobs"
+func (bc *BackfillController) Create(c *gin.Context) {
+	request := CreateBackfillJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, ok := bc.getChain(c)
+	if !ok {
+		return
+	}
+
+	job, err := bc.App.CreateLogPollerBackfillJob(c.Request.Context(), chain.ID(), request.FilterNames, request.FromBlock, request.ToBlock)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewLogPollerBackfillJobResource(*job), "log_poller_backfill_job", http.StatusCreated)
+}
+
+// Index lists the LogPoller backfill jobs of the chain, the most recent first.
+// Example:
+//
+//	"<application>/v2/backfill_jobs"
+func (bc *BackfillController) Index(c *gin.Context) {
+	chain, ok := bc.getChain(c)
+	if !ok {
+		return
+	}
+
+	jobs, err := bc.App.FindLogPollerBackfillJobs(c.Request.Context(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLogPollerBackfillJobResources(jobs), "log_poller_backfill_job")
+}
+
+// Show returns the progress of a LogPoller backfill job.
+// Example:
+//
+//	"<application>/v2/backfill_jobs/:ID"
+func (bc *BackfillController) Show(c *gin.Context) {
+	id, err := stringutils.ToInt64(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, ok := bc.getChain(c)
+	if !ok {
+		return
+	}
+
+	job, err := bc.App.FindLogPollerBackfillJob(c.Request.Context(), chain.ID(), id)
+	if errors.Is(err, logpoller.ErrBackfillJobNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLogPollerBackfillJobResource(*job), "log_poller_backfill_job")
+}
+
+func (bc *BackfillController) getChain(c *gin.Context) (legacyevm.Chain, bool) {
+	chain, err := getChain(bc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return chain, true
+}
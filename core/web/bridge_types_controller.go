@@ -114,9 +114,12 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 	ctx := c.Request.Context()
 	bridges, count, err := btc.App.BridgeORM().BridgeTypes(ctx, offset, size)
 
+	circuitBreakers := btc.App.BridgeCircuitBreakers()
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resource := presenters.NewBridgeResource(bridge)
+		resource.CircuitBreaker = presenters.NewBridgeCircuitBreakerResource(circuitBreakers.Status(bridge.Name))
+		resources = append(resources, *resource)
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +146,10 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	resource.CircuitBreaker = presenters.NewBridgeCircuitBreakerResource(btc.App.BridgeCircuitBreakers().Status(bt.Name))
+
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -181,6 +187,8 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	// the bridge may now point to a different adapter
+	btc.App.BridgeCircuitBreakers().Reset(bt.Name)
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
 		"bridgeName":                   bt.Name,
@@ -226,6 +234,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	btc.App.BridgeCircuitBreakers().Reset(bt.Name)
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": name})
 
@@ -293,6 +293,9 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	require.NotNil(t, resource.CircuitBreaker)
+	assert.Equal(t, bridges.CircuitClosed, resource.CircuitBreaker.State)
+	assert.Nil(t, resource.CircuitBreaker.NextProbeAt)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
@@ -3,11 +3,18 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 )
@@ -47,3 +54,110 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels an unstarted or unconfirmed Ethereum Transaction, given its ID
+// or the hash of one of its attempts. An unconfirmed transaction is replaced by
+// an empty transaction at the same nonce.
+// Example:
+//
+//	"<application>/transactions/evm/:TxID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	etx, chain, ok := tc.findTx(c)
+	if !ok {
+		return
+	}
+
+	etx, err := chain.TxManager().CancelTransaction(c, etx.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to cancel transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	jsonAPIResponse(c, newEthTxResourceFromLatestAttempt(etx), "transaction")
+}
+
+// Bump rebroadcasts an unconfirmed Ethereum Transaction at the fee given in the
+// request body, which must be higher than the fee of its latest attempt.
+// Example:
+//
+//	"<application>/transactions/evm/:TxID/bump"
+func (tc *TransactionsController) Bump(c *gin.Context) {
+	var br models.BumpEthTxRequest
+	if err := c.ShouldBindJSON(&br); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if br.GasPrice == nil && (br.GasFeeCap == nil || br.GasTipCap == nil) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("either gasPrice, or both gasFeeCap and gasTipCap must be set"))
+		return
+	}
+
+	etx, chain, ok := tc.findTx(c)
+	if !ok {
+		return
+	}
+
+	fee := gas.EvmFee{GasPrice: br.GasPrice, DynamicFee: gas.DynamicFee{GasFeeCap: br.GasFeeCap, GasTipCap: br.GasTipCap}}
+	etx, err := chain.TxManager().BumpTransaction(c, etx.ID, fee)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to bump transaction: %v", err))
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionBumped, map[string]interface{}{
+		"ethTX": etx,
+		"fee":   fee,
+	})
+
+	jsonAPIResponse(c, newEthTxResourceFromLatestAttempt(etx), "transaction")
+}
+
+// findTx finds the transaction given by the TxID param, either an ID or the
+// hash of one of its attempts, and the chain it belongs to. It renders the
+// error and returns false if either can't be found.
+func (tc *TransactionsController) findTx(c *gin.Context) (etx txmgr.Tx, chain legacyevm.Chain, ok bool) {
+	txID := c.Param("TxID")
+
+	var err error
+	if id, perr := strconv.ParseInt(txID, 10, 64); perr == nil {
+		etx, err = tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	} else if hash, herr := hexutil.Decode(txID); herr == nil && len(hash) == common.HashLength {
+		var tx *txmgr.Tx
+		if tx, err = tc.App.TxmStorageService().FindTxByHash(c, common.BytesToHash(hash)); err == nil {
+			etx = *tx
+		}
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid transaction ID or hash: %s", txID))
+		return etx, nil, false
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return etx, nil, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return etx, nil, false
+	}
+
+	chain, err = tc.App.GetRelayers().LegacyEVMChains().Get(etx.ChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("chain %s of transaction is not enabled: %v", etx.ChainID, err))
+		return etx, nil, false
+	}
+	return etx, chain, true
+}
+
+// newEthTxResourceFromLatestAttempt presents the transaction with its latest
+// attempt, if it has any.
+func newEthTxResourceFromLatestAttempt(etx txmgr.Tx) presenters.EthTxResource {
+	if len(etx.TxAttempts) == 0 {
+		return presenters.NewEthTxResource(etx)
+	}
+	attempt := etx.TxAttempts[0]
+	attempt.Tx = etx
+	return presenters.NewEthTxResourceFromAttempt(attempt)
+}
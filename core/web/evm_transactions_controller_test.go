@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -129,3 +131,109 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+	require.Len(t, tx.TxAttempts, 1)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+tx.TxAttempts[0].Hash.String()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, "unconfirmed", ptx.State)
+	assert.NotEqual(t, tx.TxAttempts[0].Hash, ptx.Hash)
+
+	dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+	require.NoError(t, err)
+	require.Len(t, dbTx.TxAttempts, 2)
+	assert.True(t, dbTx.TxAttempts[0].IsPurgeAttempt)
+}
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Post("/v2/transactions/evm/12345/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Cancel_RequiresAdminRole(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	resp, cleanup = client.Post(fmt.Sprintf("/v2/transactions/evm/%d/bump", tx.ID), bytes.NewBufferString(`{"gasPrice": "50 gwei"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+	dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+	require.NoError(t, err)
+	require.Len(t, dbTx.TxAttempts, 1)
+}
+
+func TestTransactionsController_Bump_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+	body := bytes.NewBufferString(`{"gasPrice": "50 gwei"}`)
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/bump", tx.ID), body)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, assets.GWei(50).ToInt().String(), ptx.GasPrice)
+
+	dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+	require.NoError(t, err)
+	require.Len(t, dbTx.TxAttempts, 2)
+	assert.Equal(t, assets.GWei(50), dbTx.TxAttempts[0].TxFee.GasPrice)
+}
+
+func TestTransactionsController_Bump_MissingFee(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Post("/v2/transactions/evm/1/bump", bytes.NewBufferString(`{"gasTipCap": "1 gwei"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
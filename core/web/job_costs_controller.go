@@ -0,0 +1,76 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobCostsController reports the gas spent by the transactions of jobs.
+type JobCostsController struct {
+	App chainlink.Application
+}
+
+// Index lists the gas spent since the given period, grouped by job, chain and
+// sending key, most expensive first. The optional jobID param restricts the
+// report to a single job.
+// Example:
+// "GET <application>/job_costs?since=7d&jobID=1"
+func (jcc *JobCostsController) Index(c *gin.Context) {
+	window, err := ParseCostsWindow(c.Query("since"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	since := time.Now().Add(-window)
+
+	var costs []txmgr.TxCost
+	if s := c.Query("jobID"); s != "" {
+		jobID, perr := strconv.ParseInt(s, 10, 32)
+		if perr != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(perr, "invalid jobID"))
+			return
+		}
+		costs, err = jcc.App.TxCostORM().JobTxCostsSince(c.Request.Context(), int32(jobID), since)
+	} else {
+		costs, err = jcc.App.TxCostORM().TxCostsSince(c.Request.Context(), since)
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobCostResources(costs), "job_costs")
+}
+
+// ParseCostsWindow parses a period like 36h or 7d, defaulting to txmgr.DefaultTxCostsWindow if empty.
+func ParseCostsWindow(s string) (time.Duration, error) {
+	if s == "" {
+		return txmgr.DefaultTxCostsWindow, nil
+	}
+	var d time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseUint(days, 10, 16)
+		if err != nil {
+			return 0, errors.Errorf("invalid period %q: %v", s, err)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, errors.Errorf("invalid period %q: %v", s, err)
+		}
+	}
+	if d <= 0 {
+		return 0, errors.Errorf("invalid period %q: must be positive", s)
+	}
+	return d, nil
+}
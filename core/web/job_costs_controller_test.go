@@ -0,0 +1,95 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobCostsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	insertCost := func(txID int64, jobID any, gasUsed, gasPrice int64, createdAt time.Time) {
+		_, err := app.GetDB().ExecContext(testutils.Context(t), `INSERT INTO evm.tx_costs (eth_tx_id, evm_chain_id, from_address, job_id, tx_hash, block_number, gas_used, effective_gas_price, created_at)
+VALUES ($1, $2, $3, $4, $5, 1, $6, $7, $8)`, txID, testutils.FixtureChainID.String(), from, jobID, testutils.NewAddress().Bytes(), gasUsed, gasPrice, createdAt)
+		require.NoError(t, err)
+	}
+	insertCost(1, 1, 21_000, 2, time.Now())
+	insertCost(2, 1, 21_000, 2, time.Now())
+	insertCost(3, nil, 100_000, 1, time.Now())
+	insertCost(4, 1, 21_000, 2, time.Now().Add(-48*time.Hour))
+
+	t.Run("all jobs", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/job_costs?since=1d")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var costs []presenters.JobCostResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &costs))
+		require.Len(t, costs, 2)
+		assert.Nil(t, costs[0].JobID)
+		assert.Equal(t, "100000", costs[0].TotalFee)
+		require.NotNil(t, costs[1].JobID)
+		assert.Equal(t, int32(1), *costs[1].JobID)
+		assert.Equal(t, int64(2), costs[1].TxCount)
+		assert.Equal(t, "84000", costs[1].GasFee)
+	})
+
+	t.Run("single job", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/job_costs?since=72h&jobID=1")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var costs []presenters.JobCostResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &costs))
+		require.Len(t, costs, 1)
+		assert.Equal(t, int64(3), costs[0].TxCount)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/job_costs?since=forever")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestParseCostsWindow(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		in     string
+		exp    time.Duration
+		expErr bool
+	}{
+		{"", 7 * 24 * time.Hour, false},
+		{"7d", 7 * 24 * time.Hour, false},
+		{"36h", 36 * time.Hour, false},
+		{"0d", 0, true},
+		{"-1h", 0, true},
+		{"d", 0, true},
+		{"week", 0, true},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := web.ParseCostsWindow(tt.in)
+			if tt.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, d)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// LogPollerController reports the logs stored by the LogPoller, to size the database.
+type LogPollerController struct {
+	App chainlink.Application
+}
+
+// LogsStats returns the number of logs stored for each LogPoller filter of the chain, and their size in the database.
+// Example:
+//
+//	"<application>/v2/logs_stats"
+func (lpc *LogPollerController) LogsStats(c *gin.Context) {
+	chain, ok := lpc.getChain(c)
+	if !ok {
+		return
+	}
+
+	stats, err := lpc.App.FindLogPollerLogsStats(c.Request.Context(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLogPollerLogsStatsResources(stats), "log_poller_logs_stats")
+}
+
+func (lpc *LogPollerController) getChain(c *gin.Context) (legacyevm.Chain, bool) {
+	chain, err := getChain(lpc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return chain, true
+}
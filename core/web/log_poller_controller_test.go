@@ -0,0 +1,41 @@
+package web_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+)
+
+func TestLogPollerController_LogsStats(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	ec := setupEthClientForControllerTests(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey, ec)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	assertError := func(t *testing.T, resp *http.Response, status int, msg string) {
+		assert.Equal(t, status, resp.StatusCode)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), msg)
+	}
+
+	t.Run("with unknown chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/logs_stats?evmChainID=1")
+		t.Cleanup(cleanup)
+		assertError(t, resp, http.StatusUnprocessableEntity, "chain id does not match any local chains")
+	})
+
+	t.Run("with LogPoller disabled", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/logs_stats")
+		t.Cleanup(cleanup)
+		assertError(t, resp, http.StatusInternalServerError, "FindLogPollerLogsStats is only available if LogPoller is enabled")
+	})
+}
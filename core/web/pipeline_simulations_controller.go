@@ -0,0 +1,71 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineSimulationsController executes job pipelines against fixtures,
+// without any side effects.
+type PipelineSimulationsController struct {
+	App chainlink.Application
+}
+
+// SimulatePipelineRunRequest represents a request to simulate a run of the
+// pipeline of a job spec.
+type SimulatePipelineRunRequest struct {
+	TOML     string            `json:"toml"`
+	Fixtures pipeline.Fixtures `json:"fixtures"`
+}
+
+// Create validates a job spec and simulates a run of its pipeline, replacing
+// side-effecting tasks with the given fixtures. Nothing is persisted.
+// Example:
+// "POST <application>/pipeline/simulations"
+func (psc *PipelineSimulationsController) Create(c *gin.Context) {
+	request := SimulatePipelineRunRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jc := JobsController{App: psc.App}
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if jb.Pipeline.Source == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s job has no pipeline to simulate", jb.Type))
+		return
+	}
+
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           jb.Type.String(),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+
+	run, _, err := psc.App.SimulateJobRun(c.Request.Context(), spec, request.Fixtures)
+	if errors.Is(err, pipeline.ErrMissingFixture) || errors.Is(err, pipeline.ErrUnknownFixture) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(*run, psc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
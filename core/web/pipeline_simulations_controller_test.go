@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const simulatedCronSpec = `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 *"
+observationSource   = """
+ds          [type=http method=GET url="https://example.com/price"];
+ds_parse    [type=jsonparse path="data,result"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`
+
+func TestPipelineSimulationsController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	simulate := func(t *testing.T, request web.SimulatePipelineRunRequest) (*http.Response, func()) {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		return client.Post("/v2/pipeline/simulations", bytes.NewReader(body))
+	}
+
+	t.Run("runs the pipeline against fixtures", func(t *testing.T) {
+		resp, cleanup := simulate(t, web.SimulatePipelineRunRequest{
+			TOML: simulatedCronSpec,
+			Fixtures: pipeline.Fixtures{
+				Tasks: map[string]pipeline.TaskFixture{
+					"ds": {Value: `{"data":{"result":1.5}}`},
+				},
+			},
+		})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var run presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &run))
+		require.Len(t, run.TaskRuns, 3)
+		require.Len(t, run.Outputs, 1)
+		assert.Equal(t, "150", *run.Outputs[0])
+		assert.Nil(t, run.FatalErrors[0])
+	})
+
+	t.Run("missing fixture", func(t *testing.T) {
+		resp, cleanup := simulate(t, web.SimulatePipelineRunRequest{TOML: simulatedCronSpec})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		resp, cleanup := simulate(t, web.SimulatePipelineRunRequest{TOML: `type = "cron"`})
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
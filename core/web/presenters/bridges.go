@@ -18,6 +18,18 @@ type BridgeResource struct {
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+	// The CircuitBreaker is only provided when showing or listing Bridges
+	CircuitBreaker *BridgeCircuitBreakerResource `json:"circuitBreaker,omitempty"`
+}
+
+// BridgeCircuitBreakerResource represents the state of the circuit breaker
+// of a Bridge.
+type BridgeCircuitBreakerResource struct {
+	State               bridges.CircuitState `json:"state"`
+	ConsecutiveFailures uint32               `json:"consecutiveFailures"`
+	HealthScore         float64              `json:"healthScore"`
+	OpenedAt            *time.Time           `json:"openedAt"`
+	NextProbeAt         *time.Time           `json:"nextProbeAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -38,3 +50,19 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+// NewBridgeCircuitBreakerResource constructs a new BridgeCircuitBreakerResource
+func NewBridgeCircuitBreakerResource(s bridges.CircuitBreakerStatus) *BridgeCircuitBreakerResource {
+	r := &BridgeCircuitBreakerResource{
+		State:               s.State,
+		ConsecutiveFailures: s.ConsecutiveFailures,
+		HealthScore:         s.HealthScore,
+	}
+	if !s.OpenedAt.IsZero() {
+		r.OpenedAt = &s.OpenedAt
+	}
+	if !s.NextProbeAt.IsZero() {
+		r.NextProbeAt = &s.NextProbeAt
+	}
+	return r
+}
@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// JobCostResource is the gas spent by a job from a single key on a single chain, as a JSONAPI resource.
+type JobCostResource struct {
+	JAID
+	JobID       *int32         `json:"jobID"`
+	EVMChainID  big.Big        `json:"evmChainID"`
+	FromAddress common.Address `json:"fromAddress"`
+	TxCount     int64          `json:"txCount"`
+	GasUsed     int64          `json:"gasUsed"`
+	// Fees are in wei
+	GasFee   string `json:"gasFee"`
+	L1Fee    string `json:"l1Fee"`
+	TotalFee string `json:"totalFee"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobCostResource) GetName() string {
+	return "job_costs"
+}
+
+// NewJobCostResource returns a new JobCostResource for the cost.
+func NewJobCostResource(cost txmgr.TxCost) JobCostResource {
+	jobID := "none"
+	if cost.JobID != nil {
+		jobID = fmt.Sprint(*cost.JobID)
+	}
+	return JobCostResource{
+		JAID:        NewJAID(fmt.Sprintf("%s-%s-%s", jobID, cost.EVMChainID.String(), cost.FromAddress.Hex())),
+		JobID:       cost.JobID,
+		EVMChainID:  cost.EVMChainID,
+		FromAddress: cost.FromAddress,
+		TxCount:     cost.TxCount,
+		GasUsed:     cost.GasUsed,
+		GasFee:      cost.GasFee.ToInt().String(),
+		L1Fee:       cost.L1Fee.ToInt().String(),
+		TotalFee:    cost.TotalFee().ToInt().String(),
+	}
+}
+
+// NewJobCostResources returns a slice of JobCostResources for the costs.
+func NewJobCostResources(costs []txmgr.TxCost) []JobCostResource {
+	rs := []JobCostResource{}
+	for _, cost := range costs {
+		rs = append(rs, NewJobCostResource(cost))
+	}
+	return rs
+}
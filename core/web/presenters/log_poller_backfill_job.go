@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// LogPollerBackfillJobResource is a LogPoller backfill job JSONAPI resource.
+type LogPollerBackfillJobResource struct {
+	JAID
+	EVMChainID  *big.Big                   `json:"evmChainId"`
+	FilterNames []string                   `json:"filterNames"`
+	FromBlock   int64                      `json:"fromBlock"`
+	ToBlock     int64                      `json:"toBlock"`
+	NextBlock   int64                      `json:"nextBlock"`
+	BlocksDone  int64                      `json:"blocksDone"`
+	BlocksTotal int64                      `json:"blocksTotal"`
+	BatchSize   int64                      `json:"batchSize"`
+	State       logpoller.BackfillJobState `json:"state"`
+	Error       *string                    `json:"error"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	UpdatedAt   time.Time                  `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogPollerBackfillJobResource) GetName() string {
+	return "log_poller_backfill_job"
+}
+
+// NewLogPollerBackfillJobResource returns a new LogPollerBackfillJobResource for job.
+func NewLogPollerBackfillJobResource(job logpoller.BackfillJob) LogPollerBackfillJobResource {
+	filterNames := []string(job.FilterNames)
+	if filterNames == nil {
+		filterNames = []string{}
+	}
+	return LogPollerBackfillJobResource{
+		JAID:        NewJAIDInt64(job.ID),
+		EVMChainID:  job.EvmChainId,
+		FilterNames: filterNames,
+		FromBlock:   job.FromBlock,
+		ToBlock:     job.ToBlock,
+		NextBlock:   job.NextBlock,
+		BlocksDone:  job.BlocksDone(),
+		BlocksTotal: job.BlocksTotal(),
+		BatchSize:   job.BatchSize,
+		State:       job.State,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// NewLogPollerBackfillJobResources returns a slice of LogPollerBackfillJobResources for jobs.
+func NewLogPollerBackfillJobResources(jobs []logpoller.BackfillJob) []LogPollerBackfillJobResource {
+	rs := []LogPollerBackfillJobResource{}
+	for _, job := range jobs {
+		rs = append(rs, NewLogPollerBackfillJobResource(job))
+	}
+	return rs
+}
@@ -0,0 +1,37 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+)
+
+// LogPollerLogsStatsResource is a JSONAPI resource reporting the logs stored for a LogPoller filter.
+type LogPollerLogsStatsResource struct {
+	JAID
+	Logs          int64 `json:"logs"`
+	CompactedLogs int64 `json:"compactedLogs"`
+	Bytes         int64 `json:"bytes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogPollerLogsStatsResource) GetName() string {
+	return "log_poller_logs_stats"
+}
+
+// NewLogPollerLogsStatsResource returns a new LogPollerLogsStatsResource for stats. Its ID is the name of the filter.
+func NewLogPollerLogsStatsResource(stats logpoller.FilterLogsStats) LogPollerLogsStatsResource {
+	return LogPollerLogsStatsResource{
+		JAID:          NewJAID(stats.FilterName),
+		Logs:          stats.Logs,
+		CompactedLogs: stats.CompactedLogs,
+		Bytes:         stats.Bytes,
+	}
+}
+
+// NewLogPollerLogsStatsResources returns a slice of LogPollerLogsStatsResources for stats.
+func NewLogPollerLogsStatsResources(stats []logpoller.FilterLogsStats) []LogPollerLogsStatsResource {
+	rs := []LogPollerLogsStatsResource{}
+	for _, s := range stats {
+		rs = append(rs, NewLogPollerLogsStatsResource(s))
+	}
+	return rs
+}
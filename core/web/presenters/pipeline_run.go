@@ -67,6 +67,10 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	// Fragment and FragmentInstance trace tasks expanded from a pipeline
+	// fragment back to it
+	Fragment         *string `json:"fragment,omitempty"`
+	FragmentInstance *string `json:"fragmentInstance,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -86,12 +90,14 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		errString = &tr.Error.String
 	}
 	return PipelineTaskRunResource{
-		Type:       tr.Type,
-		CreatedAt:  tr.CreatedAt,
-		FinishedAt: tr.FinishedAt,
-		Output:     output,
-		Error:      errString,
-		DotID:      tr.GetDotID(),
+		Type:             tr.Type,
+		CreatedAt:        tr.CreatedAt,
+		FinishedAt:       tr.FinishedAt,
+		Output:           output,
+		Error:            errString,
+		DotID:            tr.GetDotID(),
+		Fragment:         tr.Fragment.Ptr(),
+		FragmentInstance: tr.FragmentInstance.Ptr(),
 	}
 }
 
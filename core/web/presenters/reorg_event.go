@@ -0,0 +1,54 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+)
+
+// ReorgEventResource is a JSONAPI resource representing a reorg detected by the HeadTracker.
+type ReorgEventResource struct {
+	JAID
+	OldHeadNumber        int64     `json:"oldHeadNumber"`
+	OldHeadHash          string    `json:"oldHeadHash"`
+	NewHeadNumber        int64     `json:"newHeadNumber"`
+	NewHeadHash          string    `json:"newHeadHash"`
+	FromBlock            int64     `json:"fromBlock"`
+	ToBlock              int64     `json:"toBlock"`
+	Depth                int64     `json:"depth"`
+	LatestFinalizedBlock int64     `json:"latestFinalizedBlock"`
+	FinalityViolated     bool      `json:"finalityViolated"`
+	DetectedAt           time.Time `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgEventResource) GetName() string {
+	return "reorg_events"
+}
+
+// NewReorgEventResource returns a new ReorgEventResource for event. Its ID is the number of the new head.
+func NewReorgEventResource(event httypes.ReorgEvent) ReorgEventResource {
+	return ReorgEventResource{
+		JAID:                 NewJAID(strconv.FormatInt(event.NewHead.Number, 10)),
+		OldHeadNumber:        event.OldHead.Number,
+		OldHeadHash:          event.OldHead.Hash.Hex(),
+		NewHeadNumber:        event.NewHead.Number,
+		NewHeadHash:          event.NewHead.Hash.Hex(),
+		FromBlock:            event.FromBlock,
+		ToBlock:              event.ToBlock,
+		Depth:                event.Depth,
+		LatestFinalizedBlock: event.LatestFinalizedBlock,
+		FinalityViolated:     event.FinalityViolated,
+		DetectedAt:           event.DetectedAt,
+	}
+}
+
+// NewReorgEventResources returns a slice of ReorgEventResources for events.
+func NewReorgEventResources(events []httypes.ReorgEvent) []ReorgEventResource {
+	rs := []ReorgEventResource{}
+	for _, e := range events {
+		rs = append(rs, NewReorgEventResource(e))
+	}
+	return rs
+}
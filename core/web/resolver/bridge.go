@@ -4,21 +4,42 @@ import (
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
 
+type BridgeCircuitState string
+
+const (
+	BridgeCircuitStateClosed   BridgeCircuitState = "CLOSED"
+	BridgeCircuitStateOpen     BridgeCircuitState = "OPEN"
+	BridgeCircuitStateHalfOpen BridgeCircuitState = "HALF_OPEN"
+)
+
+func NewBridgeCircuitState(state bridges.CircuitState) BridgeCircuitState {
+	switch state {
+	case bridges.CircuitOpen:
+		return BridgeCircuitStateOpen
+	case bridges.CircuitHalfOpen:
+		return BridgeCircuitStateHalfOpen
+	default:
+		return BridgeCircuitStateClosed
+	}
+}
+
 // BridgeResolver resolves the Bridge type.
 type BridgeResolver struct {
 	bridge bridges.BridgeType
+	app    chainlink.Application
 }
 
-func NewBridge(bridge bridges.BridgeType) *BridgeResolver {
-	return &BridgeResolver{bridge: bridge}
+func NewBridge(bridge bridges.BridgeType, app chainlink.Application) *BridgeResolver {
+	return &BridgeResolver{bridge: bridge, app: app}
 }
 
-func NewBridges(bridges []bridges.BridgeType) []*BridgeResolver {
+func NewBridges(bridges []bridges.BridgeType, app chainlink.Application) []*BridgeResolver {
 	var resolvers []*BridgeResolver
 	for _, b := range bridges {
-		resolvers = append(resolvers, NewBridge(b))
+		resolvers = append(resolvers, NewBridge(b, app))
 	}
 
 	return resolvers
@@ -59,22 +80,68 @@ func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+// CircuitBreaker resolves the state of the bridge's circuit breaker.
+func (r *BridgeResolver) CircuitBreaker() *BridgeCircuitBreakerResolver {
+	return NewBridgeCircuitBreaker(r.app.BridgeCircuitBreakers().Status(r.bridge.Name))
+}
+
+// BridgeCircuitBreakerResolver resolves the BridgeCircuitBreaker type.
+type BridgeCircuitBreakerResolver struct {
+	status bridges.CircuitBreakerStatus
+}
+
+func NewBridgeCircuitBreaker(status bridges.CircuitBreakerStatus) *BridgeCircuitBreakerResolver {
+	return &BridgeCircuitBreakerResolver{status: status}
+}
+
+// State resolves the circuit breaker's state.
+func (r *BridgeCircuitBreakerResolver) State() BridgeCircuitState {
+	return NewBridgeCircuitState(r.status.State)
+}
+
+// ConsecutiveFailures resolves the number of requests which have failed since the last success.
+func (r *BridgeCircuitBreakerResolver) ConsecutiveFailures() int32 {
+	return int32(r.status.ConsecutiveFailures)
+}
+
+// HealthScore resolves the bridge's health score.
+func (r *BridgeCircuitBreakerResolver) HealthScore() float64 {
+	return r.status.HealthScore
+}
+
+// OpenedAt resolves when the circuit was opened.
+func (r *BridgeCircuitBreakerResolver) OpenedAt() *graphql.Time {
+	if r.status.OpenedAt.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: r.status.OpenedAt}
+}
+
+// NextProbeAt resolves when the bridge will be probed next.
+func (r *BridgeCircuitBreakerResolver) NextProbeAt() *graphql.Time {
+	if r.status.NextProbeAt.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: r.status.NextProbeAt}
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
 	bridge bridges.BridgeType
+	app    chainlink.Application
 	NotFoundErrorUnionType
 }
 
-func NewBridgePayload(bridge bridges.BridgeType, err error) *BridgePayloadResolver {
+func NewBridgePayload(bridge bridges.BridgeType, app chainlink.Application, err error) *BridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &BridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &BridgePayloadResolver{bridge: bridge, app: app, NotFoundErrorUnionType: e}
 }
 
 // ToBridge implements the Bridge union type of the payload
 func (r *BridgePayloadResolver) ToBridge() (*BridgeResolver, bool) {
 	if r.err == nil {
-		return NewBridge(r.bridge), true
+		return NewBridge(r.bridge, r.app), true
 	}
 
 	return nil, false
@@ -84,18 +151,20 @@ func (r *BridgePayloadResolver) ToBridge() (*BridgeResolver, bool) {
 type BridgesPayloadResolver struct {
 	bridges []bridges.BridgeType
 	total   int32
+	app     chainlink.Application
 }
 
-func NewBridgesPayload(bridges []bridges.BridgeType, total int32) *BridgesPayloadResolver {
+func NewBridgesPayload(bridges []bridges.BridgeType, total int32, app chainlink.Application) *BridgesPayloadResolver {
 	return &BridgesPayloadResolver{
 		bridges: bridges,
 		total:   total,
+		app:     app,
 	}
 }
 
 // Results returns the bridges.
 func (r *BridgesPayloadResolver) Results() []*BridgeResolver {
-	return NewBridges(r.bridges)
+	return NewBridges(r.bridges, r.app)
 }
 
 // Metadata returns the pagination metadata.
@@ -107,34 +176,38 @@ func (r *BridgesPayloadResolver) Metadata() *PaginationMetadataResolver {
 type CreateBridgePayloadResolver struct {
 	bridge        bridges.BridgeType
 	incomingToken string
+	app           chainlink.Application
 }
 
-func NewCreateBridgePayload(bridge bridges.BridgeType, incomingToken string) *CreateBridgePayloadResolver {
+func NewCreateBridgePayload(bridge bridges.BridgeType, incomingToken string, app chainlink.Application) *CreateBridgePayloadResolver {
 	return &CreateBridgePayloadResolver{
 		bridge:        bridge,
 		incomingToken: incomingToken,
+		app:           app,
 	}
 }
 
 func (r *CreateBridgePayloadResolver) ToCreateBridgeSuccess() (*CreateBridgeSuccessResolver, bool) {
-	return NewCreateBridgeSuccessResolver(r.bridge, r.incomingToken), true
+	return NewCreateBridgeSuccessResolver(r.bridge, r.incomingToken, r.app), true
 }
 
 type CreateBridgeSuccessResolver struct {
 	bridge        bridges.BridgeType
 	incomingToken string
+	app           chainlink.Application
 }
 
-func NewCreateBridgeSuccessResolver(bridge bridges.BridgeType, incomingToken string) *CreateBridgeSuccessResolver {
+func NewCreateBridgeSuccessResolver(bridge bridges.BridgeType, incomingToken string, app chainlink.Application) *CreateBridgeSuccessResolver {
 	return &CreateBridgeSuccessResolver{
 		bridge:        bridge,
 		incomingToken: incomingToken,
+		app:           app,
 	}
 }
 
 // Bridge resolves the bridge.
 func (r *CreateBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(r.bridge)
+	return NewBridge(r.bridge, r.app)
 }
 
 // Token resolves the bridge's incoming token.
@@ -144,18 +217,19 @@ func (r *CreateBridgeSuccessResolver) IncomingToken() string {
 
 type UpdateBridgePayloadResolver struct {
 	bridge *bridges.BridgeType
+	app    chainlink.Application
 	NotFoundErrorUnionType
 }
 
-func NewUpdateBridgePayload(bridge *bridges.BridgeType, err error) *UpdateBridgePayloadResolver {
+func NewUpdateBridgePayload(bridge *bridges.BridgeType, app chainlink.Application, err error) *UpdateBridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &UpdateBridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &UpdateBridgePayloadResolver{bridge: bridge, app: app, NotFoundErrorUnionType: e}
 }
 
 func (r *UpdateBridgePayloadResolver) ToUpdateBridgeSuccess() (*UpdateBridgeSuccessResolver, bool) {
 	if r.bridge != nil {
-		return NewUpdateBridgeSuccess(*r.bridge), true
+		return NewUpdateBridgeSuccess(*r.bridge, r.app), true
 	}
 
 	return nil, false
@@ -164,35 +238,38 @@ func (r *UpdateBridgePayloadResolver) ToUpdateBridgeSuccess() (*UpdateBridgeSucc
 // UpdateBridgePayloadResolver resolves
 type UpdateBridgeSuccessResolver struct {
 	bridge bridges.BridgeType
+	app    chainlink.Application
 }
 
-func NewUpdateBridgeSuccess(bridge bridges.BridgeType) *UpdateBridgeSuccessResolver {
+func NewUpdateBridgeSuccess(bridge bridges.BridgeType, app chainlink.Application) *UpdateBridgeSuccessResolver {
 	return &UpdateBridgeSuccessResolver{
 		bridge: bridge,
+		app:    app,
 	}
 }
 
 // Bridge resolves the success payload's bridge.
 func (r *UpdateBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(r.bridge)
+	return NewBridge(r.bridge, r.app)
 }
 
 // -- DeleteBridge mutation --
 
 type DeleteBridgePayloadResolver struct {
 	bridge *bridges.BridgeType
+	app    chainlink.Application
 	NotFoundErrorUnionType
 }
 
-func NewDeleteBridgePayload(bridge *bridges.BridgeType, err error) *DeleteBridgePayloadResolver {
+func NewDeleteBridgePayload(bridge *bridges.BridgeType, app chainlink.Application, err error) *DeleteBridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &DeleteBridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &DeleteBridgePayloadResolver{bridge: bridge, app: app, NotFoundErrorUnionType: e}
 }
 
 func (r *DeleteBridgePayloadResolver) ToDeleteBridgeSuccess() (*DeleteBridgeSuccessResolver, bool) {
 	if r.bridge != nil {
-		return NewDeleteBridgeSuccess(r.bridge), true
+		return NewDeleteBridgeSuccess(r.bridge, r.app), true
 	}
 
 	return nil, false
@@ -216,14 +293,15 @@ func (r *DeleteBridgePayloadResolver) ToDeleteBridgeInvalidNameError() (*DeleteB
 
 type DeleteBridgeSuccessResolver struct {
 	bridge *bridges.BridgeType
+	app    chainlink.Application
 }
 
-func NewDeleteBridgeSuccess(bridge *bridges.BridgeType) *DeleteBridgeSuccessResolver {
-	return &DeleteBridgeSuccessResolver{bridge: bridge}
+func NewDeleteBridgeSuccess(bridge *bridges.BridgeType, app chainlink.Application) *DeleteBridgeSuccessResolver {
+	return &DeleteBridgeSuccessResolver{bridge: bridge, app: app}
 }
 
 func (r *DeleteBridgeSuccessResolver) Bridge() *BridgeResolver {
-	return NewBridge(*r.bridge)
+	return NewBridge(*r.bridge, r.app)
 }
 
 type DeleteBridgeConflictErrorResolver struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -99,6 +100,11 @@ func Test_Bridge(t *testing.T) {
 						outgoingToken
 						minimumContractPayment
 						createdAt
+						circuitBreaker {
+							state
+							consecutiveFailures
+							healthScore
+						}
 					}
 					... on NotFoundError {
 						message
@@ -119,6 +125,7 @@ func Test_Bridge(t *testing.T) {
 			authenticated: true,
 			before: func(ctx context.Context, f *gqlTestFramework) {
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.App.On("BridgeCircuitBreakers").Return(bridges.NewCircuitBreakers(5, 30*time.Second))
 				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridges.BridgeType{
 					Name:                   name,
 					URL:                    models.WebURL(*bridgeURL),
@@ -137,7 +144,48 @@ func Test_Bridge(t *testing.T) {
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
-					"createdAt": "2021-01-01T00:00:00Z"
+					"createdAt": "2021-01-01T00:00:00Z",
+					"circuitBreaker": {
+						"state": "CLOSED",
+						"consecutiveFailures": 0,
+						"healthScore": 1
+					}
+				}
+			}`,
+		},
+		{
+			name:          "open circuit",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				circuitBreakers := bridges.NewCircuitBreakers(1, time.Minute)
+				circuitBreakers.Record(name, false)
+
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.App.On("BridgeCircuitBreakers").Return(circuitBreakers)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridges.BridgeType{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					Confirmations:          uint32(1),
+					OutgoingToken:          "outgoingToken",
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					CreatedAt:              f.Timestamp(),
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"id": "bridge1",
+					"name": "bridge1",
+					"url": "https://external.adapter",
+					"confirmations": 1,
+					"outgoingToken": "outgoingToken",
+					"minimumContractPayment": "1",
+					"createdAt": "2021-01-01T00:00:00Z",
+					"circuitBreaker": {
+						"state": "OPEN",
+						"consecutiveFailures": 1,
+						"healthScore": 0.9
+					}
 				}
 			}`,
 		},
@@ -321,6 +369,7 @@ func Test_UpdateBridge(t *testing.T) {
 						}
 					}).
 					Return(nil)
+				f.App.On("BridgeCircuitBreakers").Return(bridges.NewCircuitBreakers(5, 30*time.Second))
 			},
 			query:     mutation,
 			variables: variables,
@@ -422,6 +471,7 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobIDsWithBridge", mock.Anything, name.String()).Return([]int32{}, nil)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.App.On("BridgeCircuitBreakers").Return(bridges.NewCircuitBreakers(5, 30*time.Second))
 			},
 			query:     mutation,
 			variables: variables,
@@ -2,9 +2,11 @@ package resolver
 
 import (
 	"context"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
@@ -101,6 +103,24 @@ func (r *JobResolver) Spec() *SpecResolver {
 	return NewSpec(r.j)
 }
 
+// Costs resolves the gas spent by the job's transactions since the given time,
+// or in the last week if omitted.
+func (r *JobResolver) Costs(ctx context.Context, args struct {
+	Since *graphql.Time
+}) ([]*JobCostResolver, error) {
+	since := time.Now().Add(-txmgr.DefaultTxCostsWindow)
+	if args.Since != nil {
+		since = args.Since.Time
+	}
+
+	costs, err := r.app.TxCostORM().JobTxCostsSince(ctx, r.j.ID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobCosts(costs), nil
+}
+
 // Runs fetches the runs for a Job.
 func (r *JobResolver) Runs(ctx context.Context, args struct {
 	Offset *int32
@@ -0,0 +1,61 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// JobCostResolver resolves the gas spent by a job from a single key on a single chain.
+type JobCostResolver struct {
+	cost txmgr.TxCost
+}
+
+func NewJobCost(cost txmgr.TxCost) *JobCostResolver {
+	return &JobCostResolver{cost: cost}
+}
+
+func NewJobCosts(costs []txmgr.TxCost) []*JobCostResolver {
+	resolvers := []*JobCostResolver{}
+	for _, c := range costs {
+		resolvers = append(resolvers, NewJobCost(c))
+	}
+
+	return resolvers
+}
+
+// EVMChainID resolves the chain the transactions were sent on.
+func (r *JobCostResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.cost.EVMChainID.String())
+}
+
+// FromAddress resolves the key which sent the transactions.
+func (r *JobCostResolver) FromAddress() string {
+	return r.cost.FromAddress.Hex()
+}
+
+// TxCount resolves the number of confirmed transactions.
+func (r *JobCostResolver) TxCount() int32 {
+	return int32(r.cost.TxCount)
+}
+
+// GasUsed resolves the total gas used.
+func (r *JobCostResolver) GasUsed() string {
+	return stringutils.FromInt64(r.cost.GasUsed)
+}
+
+// GasFee resolves the execution fee in wei.
+func (r *JobCostResolver) GasFee() string {
+	return r.cost.GasFee.ToInt().String()
+}
+
+// L1Fee resolves the L1 data availability fee in wei.
+func (r *JobCostResolver) L1Fee() string {
+	return r.cost.L1Fee.ToInt().String()
+}
+
+// TotalFee resolves the sum of the execution and L1 fees in wei.
+func (r *JobCostResolver) TotalFee() string {
+	return r.cost.TotalFee().ToInt().String()
+}
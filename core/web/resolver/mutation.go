@@ -109,7 +109,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		"bridgeURL":                    bta.URL,
 	})
 
-	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
+	return NewCreateBridgePayload(*bt, bta.IncomingToken, r.App), nil
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
@@ -491,7 +491,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	orm := r.App.BridgeORM()
 	bridge, err := orm.FindBridge(ctx, taskType)
 	if errors.Is(err, sql.ErrNoRows) {
-		return NewUpdateBridgePayload(nil, err), nil
+		return NewUpdateBridgePayload(nil, r.App, err), nil
 	}
 	if err != nil {
 		return nil, err
@@ -505,6 +505,8 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := orm.UpdateBridgeType(ctx, &bridge, btr); err != nil {
 		return nil, err
 	}
+	// the bridge may now point to a different adapter
+	r.App.BridgeCircuitBreakers().Reset(bridge.Name)
 
 	r.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
 		"bridgeName":                   bridge.Name,
@@ -513,7 +515,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		"bridgeURL":                    bridge.URL,
 	})
 
-	return NewUpdateBridgePayload(&bridge, nil), nil
+	return NewUpdateBridgePayload(&bridge, r.App, nil), nil
 }
 
 type updateFeedsManagerInput struct {
@@ -660,14 +662,14 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
 	if err != nil {
-		return NewDeleteBridgePayload(nil, err), nil
+		return NewDeleteBridgePayload(nil, r.App, err), nil
 	}
 
 	orm := r.App.BridgeORM()
 	bt, err := orm.FindBridge(ctx, taskType)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewDeleteBridgePayload(nil, err), nil
+			return NewDeleteBridgePayload(nil, r.App, err), nil
 		}
 
 		return nil, err
@@ -678,15 +680,16 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 	if len(jobsUsingBridge) > 0 {
-		return NewDeleteBridgePayload(nil, fmt.Errorf("bridge has jobs associated with it")), nil
+		return NewDeleteBridgePayload(nil, r.App, fmt.Errorf("bridge has jobs associated with it")), nil
 	}
 
 	if err = orm.DeleteBridgeType(ctx, &bt); err != nil {
 		return nil, err
 	}
+	r.App.BridgeCircuitBreakers().Reset(bt.Name)
 
 	r.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": bt.Name})
-	return NewDeleteBridgePayload(&bt, nil), nil
+	return NewDeleteBridgePayload(&bt, r.App, nil), nil
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
@@ -34,13 +34,13 @@ func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*B
 	bridge, err := r.App.BridgeORM().FindBridge(ctx, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewBridgePayload(bridge, err), nil
+			return NewBridgePayload(bridge, r.App, err), nil
 		}
 
 		return nil, err
 	}
 
-	return NewBridgePayload(bridge, nil), nil
+	return NewBridgePayload(bridge, r.App, nil), nil
 }
 
 // Bridges retrieves a paginated list of bridges.
@@ -60,7 +60,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	return NewBridgesPayload(brdgs, int32(count)), nil
+	return NewBridgesPayload(brdgs, int32(count), r.App), nil
 }
 
 // Chain retrieves a chain by id.
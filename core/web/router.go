@@ -286,6 +286,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxID/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxID/bump", auth.RequiresAdminRole(txs.Bump))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -293,6 +295,14 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		bfc := BackfillController{app}
+		authv2.GET("/backfill_jobs", bfc.Index)
+		authv2.GET("/backfill_jobs/:ID", bfc.Show)
+		authv2.POST("/backfill_jobs", auth.RequiresRunRole(bfc.Create))
+		lpc := LogPollerController{app}
+		authv2.GET("/logs_stats", lpc.LogsStats)
+		smc := SafeModeController{app}
+		authv2.POST("/safe_mode/acknowledge", auth.RequiresAdminRole(smc.Acknowledge))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -373,11 +383,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
+		jcc := JobCostsController{app}
+		authv2.GET("/job_costs", jcc.Index)
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		// PipelineSimulationsController
+		psimc := PipelineSimulationsController{app}
+		authv2.POST("/pipeline/simulations", auth.RequiresEditRole(psimc.Create))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
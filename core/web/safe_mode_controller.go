@@ -0,0 +1,41 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// SafeModeController lets operators resume a chain paused in safe mode after a finality violation.
+type SafeModeController struct {
+	App chainlink.Application
+}
+
+// Acknowledge acknowledges the finality violations of the chain, which leaves safe mode and resumes sending
+// transactions. It returns the acknowledged violations.
+// Example:
+//
+//	"<application>/v2/safe_mode/acknowledge"
+func (smc *SafeModeController) Acknowledge(c *gin.Context) {
+	chain, err := getChain(smc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	violations, err := smc.App.AcknowledgeSafeMode(c.Request.Context(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReorgEventResources(violations), "reorg_events")
+}
@@ -0,0 +1,43 @@
+package web_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestSafeModeController_Acknowledge(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	ec := setupEthClientForControllerTests(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg, cltest.DefaultP2PKey, ec)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	t.Run("unknown chain", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/safe_mode/acknowledge?evmChainID=1", bytes.NewBufferString("{}"))
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "chain id does not match any local chains")
+	})
+
+	t.Run("no finality violations", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/safe_mode/acknowledge", bytes.NewBufferString("{}"))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var violations []presenters.ReorgEventResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &violations))
+		assert.Empty(t, violations)
+	})
+}